@@ -8,9 +8,12 @@ import (
 	"sni/cmd/sni/config"
 	"sni/cmd/sni/logging"
 	"sni/cmd/sni/tray"
+	"sni/snes"
 	"sni/snes/drivers/emunw"
+	"sni/snes/services/auth"
 	"sni/snes/services/grpcimpl"
 	"sni/snes/services/usb2snes"
+	"time"
 )
 
 import _ "net/http/pprof"
@@ -20,6 +23,7 @@ import (
 	"sni/snes/drivers/fxpakpro"
 	"sni/snes/drivers/luabridge"
 	"sni/snes/drivers/mock"
+	"sni/snes/drivers/replay"
 	"sni/snes/drivers/retroarch"
 )
 
@@ -65,6 +69,7 @@ func main() {
 
 	// load configuration:
 	config.Load()
+	auth.Init()
 
 	// explicitly initialize all the drivers:
 	fxpakpro.DriverInit()
@@ -72,9 +77,15 @@ func main() {
 	luabridge.DriverInit()
 	retroarch.DriverInit()
 	mock.DriverInit()
+	replay.DriverInit()
+
+	// detect devices periodically and publish device events:
+	snes.StartDeviceDetector(time.Second * 2)
 
 	// start the servers:
 	grpcimpl.StartGrpcServer()
+	grpcimpl.StartWebServer()
+	grpcimpl.StartMetricsServer()
 	usb2snes.StartHttpServer()
 
 	// start up a systray:
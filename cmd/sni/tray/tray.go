@@ -1,6 +1,7 @@
 package tray
 
 import (
+	"context"
 	"fmt"
 	"github.com/alttpo/observable"
 	"github.com/getlantern/systray"
@@ -14,7 +15,6 @@ import (
 	"sni/snes"
 	"strings"
 	"sync"
-	"time"
 )
 
 const maxItems = 10
@@ -296,24 +296,23 @@ func trayStart() {
 		systray.Quit()
 	}
 
-	// refresh device list periodically:
+	// keep device list in sync with the device detector:
 	go func() {
-		refreshPeriod := time.Tick(time.Second * 2)
-		for range refreshPeriod {
-			RefreshDeviceList()
+		descriptors, sub := snes.SubscribeDeviceEvents()
+		defer sub.Close()
+
+		UpdateDeviceList(descriptors)
+		for {
+			_, err := sub.Next(context.Background())
+			if err != nil {
+				return
+			}
+			UpdateDeviceList(snes.DetectedDevices())
 		}
 	}()
 }
 
 func RefreshDeviceList() {
-	descriptors := make([]snes.DeviceDescriptor, 0, 10)
-	for _, named := range snes.Drivers() {
-		d, err := named.Driver.Detect()
-		if err != nil {
-			continue
-		}
-
-		descriptors = append(descriptors, d...)
-	}
-	UpdateDeviceList(descriptors)
+	// the device list is updated from the resulting device events:
+	_, _ = snes.DetectDevices()
 }
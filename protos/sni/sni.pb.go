@@ -92,6 +92,12 @@ const (
 	MemoryMapping_HiROM   MemoryMapping = 1
 	MemoryMapping_LoROM   MemoryMapping = 2
 	MemoryMapping_ExHiROM MemoryMapping = 3 // (48-64Mbit)
+	MemoryMapping_BSX     MemoryMapping = 4 // Satellaview memory pack programs
+	MemoryMapping_SA1     MemoryMapping = 5
+	MemoryMapping_ExLoROM MemoryMapping = 6 // (48-64Mbit)
+	MemoryMapping_SuperFX MemoryMapping = 7
+	MemoryMapping_SDD1    MemoryMapping = 8
+	MemoryMapping_SPC7110 MemoryMapping = 9
 )
 
 // Enum value maps for MemoryMapping.
@@ -101,12 +107,24 @@ var (
 		1: "HiROM",
 		2: "LoROM",
 		3: "ExHiROM",
+		4: "BSX",
+		5: "SA1",
+		6: "ExLoROM",
+		7: "SuperFX",
+		8: "SDD1",
+		9: "SPC7110",
 	}
 	MemoryMapping_value = map[string]int32{
 		"Unknown": 0,
 		"HiROM":   1,
 		"LoROM":   2,
 		"ExHiROM": 3,
+		"BSX":     4,
+		"SA1":     5,
+		"ExLoROM": 6,
+		"SuperFX": 7,
+		"SDD1":    8,
+		"SPC7110": 9,
 	}
 )
 
@@ -149,14 +167,14 @@ const (
 	DeviceCapability_PauseUnpauseEmulation DeviceCapability = 5
 	DeviceCapability_PauseToggleEmulation  DeviceCapability = 6
 	DeviceCapability_ResetToMenu           DeviceCapability = 7
-	// TODO: query ROM name
-	DeviceCapability_ReadDirectory DeviceCapability = 10
-	DeviceCapability_MakeDirectory DeviceCapability = 11
-	DeviceCapability_RemoveFile    DeviceCapability = 12
-	DeviceCapability_RenameFile    DeviceCapability = 13
-	DeviceCapability_PutFile       DeviceCapability = 14
-	DeviceCapability_GetFile       DeviceCapability = 15
-	DeviceCapability_BootFile      DeviceCapability = 16
+	DeviceCapability_FetchFields           DeviceCapability = 8
+	DeviceCapability_ReadDirectory         DeviceCapability = 10
+	DeviceCapability_MakeDirectory         DeviceCapability = 11
+	DeviceCapability_RemoveFile            DeviceCapability = 12
+	DeviceCapability_RenameFile            DeviceCapability = 13
+	DeviceCapability_PutFile               DeviceCapability = 14
+	DeviceCapability_GetFile               DeviceCapability = 15
+	DeviceCapability_BootFile              DeviceCapability = 16
 )
 
 // Enum value maps for DeviceCapability.
@@ -170,6 +188,7 @@ var (
 		5:  "PauseUnpauseEmulation",
 		6:  "PauseToggleEmulation",
 		7:  "ResetToMenu",
+		8:  "FetchFields",
 		10: "ReadDirectory",
 		11: "MakeDirectory",
 		12: "RemoveFile",
@@ -187,6 +206,7 @@ var (
 		"PauseUnpauseEmulation": 5,
 		"PauseToggleEmulation":  6,
 		"ResetToMenu":           7,
+		"FetchFields":           8,
 		"ReadDirectory":         10,
 		"MakeDirectory":         11,
 		"RemoveFile":            12,
@@ -224,6 +244,114 @@ func (DeviceCapability) EnumDescriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{2}
 }
 
+// device information fields:
+type Field int32
+
+const (
+	Field_DeviceName    Field = 0
+	Field_DeviceVersion Field = 1
+	Field_DeviceStatus  Field = 2
+	Field_CoreName      Field = 3
+	Field_RomFileName   Field = 4
+	Field_RomCRC32      Field = 5
+)
+
+// Enum value maps for Field.
+var (
+	Field_name = map[int32]string{
+		0: "DeviceName",
+		1: "DeviceVersion",
+		2: "DeviceStatus",
+		3: "CoreName",
+		4: "RomFileName",
+		5: "RomCRC32",
+	}
+	Field_value = map[string]int32{
+		"DeviceName":    0,
+		"DeviceVersion": 1,
+		"DeviceStatus":  2,
+		"CoreName":      3,
+		"RomFileName":   4,
+		"RomCRC32":      5,
+	}
+)
+
+func (x Field) Enum() *Field {
+	p := new(Field)
+	*p = x
+	return p
+}
+
+func (x Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_sni_proto_enumTypes[3].Descriptor()
+}
+
+func (Field) Type() protoreflect.EnumType {
+	return &file_sni_proto_enumTypes[3]
+}
+
+func (x Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Field.Descriptor instead.
+func (Field) EnumDescriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{3}
+}
+
+type DeviceEventType int32
+
+const (
+	DeviceEventType_DeviceAdded   DeviceEventType = 0
+	DeviceEventType_DeviceRemoved DeviceEventType = 1
+	DeviceEventType_DeviceChanged DeviceEventType = 2
+)
+
+// Enum value maps for DeviceEventType.
+var (
+	DeviceEventType_name = map[int32]string{
+		0: "DeviceAdded",
+		1: "DeviceRemoved",
+		2: "DeviceChanged",
+	}
+	DeviceEventType_value = map[string]int32{
+		"DeviceAdded":   0,
+		"DeviceRemoved": 1,
+		"DeviceChanged": 2,
+	}
+)
+
+func (x DeviceEventType) Enum() *DeviceEventType {
+	p := new(DeviceEventType)
+	*p = x
+	return p
+}
+
+func (x DeviceEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeviceEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sni_proto_enumTypes[4].Descriptor()
+}
+
+func (DeviceEventType) Type() protoreflect.EnumType {
+	return &file_sni_proto_enumTypes[4]
+}
+
+func (x DeviceEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeviceEventType.Descriptor instead.
+func (DeviceEventType) EnumDescriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{4}
+}
+
 type DirEntryType int32
 
 const (
@@ -254,11 +382,11 @@ func (x DirEntryType) String() string {
 }
 
 func (DirEntryType) Descriptor() protoreflect.EnumDescriptor {
-	return file_sni_proto_enumTypes[3].Descriptor()
+	return file_sni_proto_enumTypes[5].Descriptor()
 }
 
 func (DirEntryType) Type() protoreflect.EnumType {
-	return &file_sni_proto_enumTypes[3]
+	return &file_sni_proto_enumTypes[5]
 }
 
 func (x DirEntryType) Number() protoreflect.EnumNumber {
@@ -267,7 +395,7 @@ func (x DirEntryType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DirEntryType.Descriptor instead.
 func (DirEntryType) EnumDescriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{3}
+	return file_sni_proto_rawDescGZIP(), []int{5}
 }
 
 type DevicesRequest struct {
@@ -365,16 +493,17 @@ func (x *DevicesResponse) GetDevices() []*DevicesResponse_Device {
 	return nil
 }
 
-type ResetSystemRequest struct {
+type WatchDevicesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// optional list of device kind filters
+	Kinds []string `protobuf:"bytes,1,rep,name=kinds,proto3" json:"kinds,omitempty"`
 }
 
-func (x *ResetSystemRequest) Reset() {
-	*x = ResetSystemRequest{}
+func (x *WatchDevicesRequest) Reset() {
+	*x = WatchDevicesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -382,13 +511,13 @@ func (x *ResetSystemRequest) Reset() {
 	}
 }
 
-func (x *ResetSystemRequest) String() string {
+func (x *WatchDevicesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetSystemRequest) ProtoMessage() {}
+func (*WatchDevicesRequest) ProtoMessage() {}
 
-func (x *ResetSystemRequest) ProtoReflect() protoreflect.Message {
+func (x *WatchDevicesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -400,28 +529,28 @@ func (x *ResetSystemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetSystemRequest.ProtoReflect.Descriptor instead.
-func (*ResetSystemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchDevicesRequest.ProtoReflect.Descriptor instead.
+func (*WatchDevicesRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ResetSystemRequest) GetUri() string {
+func (x *WatchDevicesRequest) GetKinds() []string {
 	if x != nil {
-		return x.Uri
+		return x.Kinds
 	}
-	return ""
+	return nil
 }
 
-type ResetSystemResponse struct {
+type WatchDevicesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Events []*WatchDevicesResponse_Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *ResetSystemResponse) Reset() {
-	*x = ResetSystemResponse{}
+func (x *WatchDevicesResponse) Reset() {
+	*x = WatchDevicesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -429,13 +558,13 @@ func (x *ResetSystemResponse) Reset() {
 	}
 }
 
-func (x *ResetSystemResponse) String() string {
+func (x *WatchDevicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetSystemResponse) ProtoMessage() {}
+func (*WatchDevicesResponse) ProtoMessage() {}
 
-func (x *ResetSystemResponse) ProtoReflect() protoreflect.Message {
+func (x *WatchDevicesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -447,28 +576,30 @@ func (x *ResetSystemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetSystemResponse.ProtoReflect.Descriptor instead.
-func (*ResetSystemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchDevicesResponse.ProtoReflect.Descriptor instead.
+func (*WatchDevicesResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ResetSystemResponse) GetUri() string {
+func (x *WatchDevicesResponse) GetEvents() []*WatchDevicesResponse_Event {
 	if x != nil {
-		return x.Uri
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-type ResetToMenuRequest struct {
+type AcquireLeaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// requested duration of the lease; defaults to 1000 and is limited to 60000
+	DurationMilliseconds uint32 `protobuf:"varint,2,opt,name=durationMilliseconds,proto3" json:"durationMilliseconds,omitempty"`
 }
 
-func (x *ResetToMenuRequest) Reset() {
-	*x = ResetToMenuRequest{}
+func (x *AcquireLeaseRequest) Reset() {
+	*x = AcquireLeaseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -476,13 +607,13 @@ func (x *ResetToMenuRequest) Reset() {
 	}
 }
 
-func (x *ResetToMenuRequest) String() string {
+func (x *AcquireLeaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetToMenuRequest) ProtoMessage() {}
+func (*AcquireLeaseRequest) ProtoMessage() {}
 
-func (x *ResetToMenuRequest) ProtoReflect() protoreflect.Message {
+func (x *AcquireLeaseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -494,28 +625,38 @@ func (x *ResetToMenuRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetToMenuRequest.ProtoReflect.Descriptor instead.
-func (*ResetToMenuRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcquireLeaseRequest.ProtoReflect.Descriptor instead.
+func (*AcquireLeaseRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ResetToMenuRequest) GetUri() string {
+func (x *AcquireLeaseRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-type ResetToMenuResponse struct {
+func (x *AcquireLeaseRequest) GetDurationMilliseconds() uint32 {
+	if x != nil {
+		return x.DurationMilliseconds
+	}
+	return 0
+}
+
+type AcquireLeaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Uri     string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	LeaseId string `protobuf:"bytes,2,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	// granted duration of the lease
+	DurationMilliseconds uint32 `protobuf:"varint,3,opt,name=durationMilliseconds,proto3" json:"durationMilliseconds,omitempty"`
 }
 
-func (x *ResetToMenuResponse) Reset() {
-	*x = ResetToMenuResponse{}
+func (x *AcquireLeaseResponse) Reset() {
+	*x = AcquireLeaseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -523,13 +664,13 @@ func (x *ResetToMenuResponse) Reset() {
 	}
 }
 
-func (x *ResetToMenuResponse) String() string {
+func (x *AcquireLeaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetToMenuResponse) ProtoMessage() {}
+func (*AcquireLeaseResponse) ProtoMessage() {}
 
-func (x *ResetToMenuResponse) ProtoReflect() protoreflect.Message {
+func (x *AcquireLeaseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -541,30 +682,45 @@ func (x *ResetToMenuResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetToMenuResponse.ProtoReflect.Descriptor instead.
-func (*ResetToMenuResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcquireLeaseResponse.ProtoReflect.Descriptor instead.
+func (*AcquireLeaseResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ResetToMenuResponse) GetUri() string {
+func (x *AcquireLeaseResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-type PauseEmulationRequest struct {
+func (x *AcquireLeaseResponse) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+func (x *AcquireLeaseResponse) GetDurationMilliseconds() uint32 {
+	if x != nil {
+		return x.DurationMilliseconds
+	}
+	return 0
+}
+
+type RenewLeaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// true to pause emulation, false to unpause
-	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	Uri     string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	LeaseId string `protobuf:"bytes,2,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	// requested duration of the lease from now; defaults to 1000 and is limited to 60000
+	DurationMilliseconds uint32 `protobuf:"varint,3,opt,name=durationMilliseconds,proto3" json:"durationMilliseconds,omitempty"`
 }
 
-func (x *PauseEmulationRequest) Reset() {
-	*x = PauseEmulationRequest{}
+func (x *RenewLeaseRequest) Reset() {
+	*x = RenewLeaseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -572,13 +728,13 @@ func (x *PauseEmulationRequest) Reset() {
 	}
 }
 
-func (x *PauseEmulationRequest) String() string {
+func (x *RenewLeaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PauseEmulationRequest) ProtoMessage() {}
+func (*RenewLeaseRequest) ProtoMessage() {}
 
-func (x *PauseEmulationRequest) ProtoReflect() protoreflect.Message {
+func (x *RenewLeaseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -590,36 +746,45 @@ func (x *PauseEmulationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PauseEmulationRequest.ProtoReflect.Descriptor instead.
-func (*PauseEmulationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenewLeaseRequest.ProtoReflect.Descriptor instead.
+func (*RenewLeaseRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PauseEmulationRequest) GetUri() string {
+func (x *RenewLeaseRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *PauseEmulationRequest) GetPaused() bool {
+func (x *RenewLeaseRequest) GetLeaseId() string {
 	if x != nil {
-		return x.Paused
+		return x.LeaseId
 	}
-	return false
+	return ""
 }
 
-type PauseEmulationResponse struct {
+func (x *RenewLeaseRequest) GetDurationMilliseconds() uint32 {
+	if x != nil {
+		return x.DurationMilliseconds
+	}
+	return 0
+}
+
+type RenewLeaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri    string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Paused bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	Uri     string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	LeaseId string `protobuf:"bytes,2,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
+	// granted duration of the lease
+	DurationMilliseconds uint32 `protobuf:"varint,3,opt,name=durationMilliseconds,proto3" json:"durationMilliseconds,omitempty"`
 }
 
-func (x *PauseEmulationResponse) Reset() {
-	*x = PauseEmulationResponse{}
+func (x *RenewLeaseResponse) Reset() {
+	*x = RenewLeaseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -627,13 +792,13 @@ func (x *PauseEmulationResponse) Reset() {
 	}
 }
 
-func (x *PauseEmulationResponse) String() string {
+func (x *RenewLeaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PauseEmulationResponse) ProtoMessage() {}
+func (*RenewLeaseResponse) ProtoMessage() {}
 
-func (x *PauseEmulationResponse) ProtoReflect() protoreflect.Message {
+func (x *RenewLeaseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -645,35 +810,43 @@ func (x *PauseEmulationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PauseEmulationResponse.ProtoReflect.Descriptor instead.
-func (*PauseEmulationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenewLeaseResponse.ProtoReflect.Descriptor instead.
+func (*RenewLeaseResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PauseEmulationResponse) GetUri() string {
+func (x *RenewLeaseResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *PauseEmulationResponse) GetPaused() bool {
+func (x *RenewLeaseResponse) GetLeaseId() string {
 	if x != nil {
-		return x.Paused
+		return x.LeaseId
 	}
-	return false
+	return ""
 }
 
-type PauseToggleEmulationRequest struct {
+func (x *RenewLeaseResponse) GetDurationMilliseconds() uint32 {
+	if x != nil {
+		return x.DurationMilliseconds
+	}
+	return 0
+}
+
+type ReleaseLeaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Uri     string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	LeaseId string `protobuf:"bytes,2,opt,name=leaseId,proto3" json:"leaseId,omitempty"`
 }
 
-func (x *PauseToggleEmulationRequest) Reset() {
-	*x = PauseToggleEmulationRequest{}
+func (x *ReleaseLeaseRequest) Reset() {
+	*x = ReleaseLeaseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -681,13 +854,13 @@ func (x *PauseToggleEmulationRequest) Reset() {
 	}
 }
 
-func (x *PauseToggleEmulationRequest) String() string {
+func (x *ReleaseLeaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PauseToggleEmulationRequest) ProtoMessage() {}
+func (*ReleaseLeaseRequest) ProtoMessage() {}
 
-func (x *PauseToggleEmulationRequest) ProtoReflect() protoreflect.Message {
+func (x *ReleaseLeaseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -699,19 +872,26 @@ func (x *PauseToggleEmulationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PauseToggleEmulationRequest.ProtoReflect.Descriptor instead.
-func (*PauseToggleEmulationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReleaseLeaseRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseLeaseRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PauseToggleEmulationRequest) GetUri() string {
+func (x *ReleaseLeaseRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-type PauseToggleEmulationResponse struct {
+func (x *ReleaseLeaseRequest) GetLeaseId() string {
+	if x != nil {
+		return x.LeaseId
+	}
+	return ""
+}
+
+type ReleaseLeaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -719,8 +899,8 @@ type PauseToggleEmulationResponse struct {
 	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *PauseToggleEmulationResponse) Reset() {
-	*x = PauseToggleEmulationResponse{}
+func (x *ReleaseLeaseResponse) Reset() {
+	*x = ReleaseLeaseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -728,13 +908,13 @@ func (x *PauseToggleEmulationResponse) Reset() {
 	}
 }
 
-func (x *PauseToggleEmulationResponse) String() string {
+func (x *ReleaseLeaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PauseToggleEmulationResponse) ProtoMessage() {}
+func (*ReleaseLeaseResponse) ProtoMessage() {}
 
-func (x *PauseToggleEmulationResponse) ProtoReflect() protoreflect.Message {
+func (x *ReleaseLeaseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -746,33 +926,28 @@ func (x *PauseToggleEmulationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PauseToggleEmulationResponse.ProtoReflect.Descriptor instead.
-func (*PauseToggleEmulationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReleaseLeaseResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseLeaseResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PauseToggleEmulationResponse) GetUri() string {
+func (x *ReleaseLeaseResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-type DetectMemoryMappingRequest struct {
+type ResetSystemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// optional fallback value to set in case detection fails for some reason
-	FallbackMemoryMapping *MemoryMapping `protobuf:"varint,2,opt,name=fallbackMemoryMapping,proto3,enum=MemoryMapping,oneof" json:"fallbackMemoryMapping,omitempty"`
-	// optional ROM header (from bus address $00:FFB0, at least $30 bytes long) to use for detection
-	// if not provided, the header will be read from the device
-	RomHeader00FFB0 []byte `protobuf:"bytes,3,opt,name=romHeader00FFB0,proto3,oneof" json:"romHeader00FFB0,omitempty"`
 }
 
-func (x *DetectMemoryMappingRequest) Reset() {
-	*x = DetectMemoryMappingRequest{}
+func (x *ResetSystemRequest) Reset() {
+	*x = ResetSystemRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -780,13 +955,13 @@ func (x *DetectMemoryMappingRequest) Reset() {
 	}
 }
 
-func (x *DetectMemoryMappingRequest) String() string {
+func (x *ResetSystemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetectMemoryMappingRequest) ProtoMessage() {}
+func (*ResetSystemRequest) ProtoMessage() {}
 
-func (x *DetectMemoryMappingRequest) ProtoReflect() protoreflect.Message {
+func (x *ResetSystemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -798,48 +973,28 @@ func (x *DetectMemoryMappingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetectMemoryMappingRequest.ProtoReflect.Descriptor instead.
-func (*DetectMemoryMappingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetSystemRequest.ProtoReflect.Descriptor instead.
+func (*ResetSystemRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DetectMemoryMappingRequest) GetUri() string {
+func (x *ResetSystemRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *DetectMemoryMappingRequest) GetFallbackMemoryMapping() MemoryMapping {
-	if x != nil && x.FallbackMemoryMapping != nil {
-		return *x.FallbackMemoryMapping
-	}
-	return MemoryMapping_Unknown
-}
-
-func (x *DetectMemoryMappingRequest) GetRomHeader00FFB0() []byte {
-	if x != nil {
-		return x.RomHeader00FFB0
-	}
-	return nil
-}
-
-type DetectMemoryMappingResponse struct {
+type ResetSystemResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// the memory mapping mode detected
-	MemoryMapping MemoryMapping `protobuf:"varint,2,opt,name=memoryMapping,proto3,enum=MemoryMapping" json:"memoryMapping,omitempty"`
-	// true if confident we detected a mapping; false if using a fallback or default value
-	Confidence bool `protobuf:"varint,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
-	// the ROM header read from $00:FFB0, length is $50 bytes if server reads it, otherwise length of `romHeader00FFB0` from request
-	RomHeader00FFB0 []byte `protobuf:"bytes,4,opt,name=romHeader00FFB0,proto3" json:"romHeader00FFB0,omitempty"`
 }
 
-func (x *DetectMemoryMappingResponse) Reset() {
-	*x = DetectMemoryMappingResponse{}
+func (x *ResetSystemResponse) Reset() {
+	*x = ResetSystemResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -847,13 +1002,13 @@ func (x *DetectMemoryMappingResponse) Reset() {
 	}
 }
 
-func (x *DetectMemoryMappingResponse) String() string {
+func (x *ResetSystemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetectMemoryMappingResponse) ProtoMessage() {}
+func (*ResetSystemResponse) ProtoMessage() {}
 
-func (x *DetectMemoryMappingResponse) ProtoReflect() protoreflect.Message {
+func (x *ResetSystemResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -865,52 +1020,28 @@ func (x *DetectMemoryMappingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetectMemoryMappingResponse.ProtoReflect.Descriptor instead.
-func (*DetectMemoryMappingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetSystemResponse.ProtoReflect.Descriptor instead.
+func (*ResetSystemResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DetectMemoryMappingResponse) GetUri() string {
+func (x *ResetSystemResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *DetectMemoryMappingResponse) GetMemoryMapping() MemoryMapping {
-	if x != nil {
-		return x.MemoryMapping
-	}
-	return MemoryMapping_Unknown
+type ResetToMenuRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *DetectMemoryMappingResponse) GetConfidence() bool {
-	if x != nil {
-		return x.Confidence
-	}
-	return false
-}
-
-func (x *DetectMemoryMappingResponse) GetRomHeader00FFB0() []byte {
-	if x != nil {
-		return x.RomHeader00FFB0
-	}
-	return nil
-}
-
-type ReadMemoryRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
-	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
-	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
-	Size                 uint32        `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-}
-
-func (x *ReadMemoryRequest) Reset() {
-	*x = ReadMemoryRequest{}
+func (x *ResetToMenuRequest) Reset() {
+	*x = ResetToMenuRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -918,13 +1049,13 @@ func (x *ReadMemoryRequest) Reset() {
 	}
 }
 
-func (x *ReadMemoryRequest) String() string {
+func (x *ResetToMenuRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadMemoryRequest) ProtoMessage() {}
+func (*ResetToMenuRequest) ProtoMessage() {}
 
-func (x *ReadMemoryRequest) ProtoReflect() protoreflect.Message {
+func (x *ResetToMenuRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -936,55 +1067,28 @@ func (x *ReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadMemoryRequest.ProtoReflect.Descriptor instead.
-func (*ReadMemoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetToMenuRequest.ProtoReflect.Descriptor instead.
+func (*ResetToMenuRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ReadMemoryRequest) GetRequestAddress() uint32 {
-	if x != nil {
-		return x.RequestAddress
-	}
-	return 0
-}
-
-func (x *ReadMemoryRequest) GetRequestAddressSpace() AddressSpace {
-	if x != nil {
-		return x.RequestAddressSpace
-	}
-	return AddressSpace_FxPakPro
-}
-
-func (x *ReadMemoryRequest) GetRequestMemoryMapping() MemoryMapping {
-	if x != nil {
-		return x.RequestMemoryMapping
-	}
-	return MemoryMapping_Unknown
-}
-
-func (x *ReadMemoryRequest) GetSize() uint32 {
+func (x *ResetToMenuRequest) GetUri() string {
 	if x != nil {
-		return x.Size
+		return x.Uri
 	}
-	return 0
+	return ""
 }
 
-type ReadMemoryResponse struct {
+type ResetToMenuResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
-	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
-	RequestMemoryMapping MemoryMapping `protobuf:"varint,6,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
-	// the address sent to the device and its space
-	DeviceAddress      uint32       `protobuf:"varint,3,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
-	DeviceAddressSpace AddressSpace `protobuf:"varint,4,opt,name=deviceAddressSpace,proto3,enum=AddressSpace" json:"deviceAddressSpace,omitempty"`
-	Data               []byte       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *ReadMemoryResponse) Reset() {
-	*x = ReadMemoryResponse{}
+func (x *ResetToMenuResponse) Reset() {
+	*x = ResetToMenuResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -992,13 +1096,13 @@ func (x *ReadMemoryResponse) Reset() {
 	}
 }
 
-func (x *ReadMemoryResponse) String() string {
+func (x *ResetToMenuResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadMemoryResponse) ProtoMessage() {}
+func (*ResetToMenuResponse) ProtoMessage() {}
 
-func (x *ReadMemoryResponse) ProtoReflect() protoreflect.Message {
+func (x *ResetToMenuResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1010,66 +1114,30 @@ func (x *ReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadMemoryResponse.ProtoReflect.Descriptor instead.
-func (*ReadMemoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetToMenuResponse.ProtoReflect.Descriptor instead.
+func (*ResetToMenuResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ReadMemoryResponse) GetRequestAddress() uint32 {
-	if x != nil {
-		return x.RequestAddress
-	}
-	return 0
-}
-
-func (x *ReadMemoryResponse) GetRequestAddressSpace() AddressSpace {
-	if x != nil {
-		return x.RequestAddressSpace
-	}
-	return AddressSpace_FxPakPro
-}
-
-func (x *ReadMemoryResponse) GetRequestMemoryMapping() MemoryMapping {
-	if x != nil {
-		return x.RequestMemoryMapping
-	}
-	return MemoryMapping_Unknown
-}
-
-func (x *ReadMemoryResponse) GetDeviceAddress() uint32 {
-	if x != nil {
-		return x.DeviceAddress
-	}
-	return 0
-}
-
-func (x *ReadMemoryResponse) GetDeviceAddressSpace() AddressSpace {
-	if x != nil {
-		return x.DeviceAddressSpace
-	}
-	return AddressSpace_FxPakPro
-}
-
-func (x *ReadMemoryResponse) GetData() []byte {
+func (x *ResetToMenuResponse) GetUri() string {
 	if x != nil {
-		return x.Data
+		return x.Uri
 	}
-	return nil
+	return ""
 }
 
-type WriteMemoryRequest struct {
+type PauseEmulationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
-	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
-	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
-	Data                 []byte        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// true to pause emulation, false to unpause
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
-func (x *WriteMemoryRequest) Reset() {
-	*x = WriteMemoryRequest{}
+func (x *PauseEmulationRequest) Reset() {
+	*x = PauseEmulationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1077,13 +1145,13 @@ func (x *WriteMemoryRequest) Reset() {
 	}
 }
 
-func (x *WriteMemoryRequest) String() string {
+func (x *PauseEmulationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteMemoryRequest) ProtoMessage() {}
+func (*PauseEmulationRequest) ProtoMessage() {}
 
-func (x *WriteMemoryRequest) ProtoReflect() protoreflect.Message {
+func (x *PauseEmulationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1095,54 +1163,36 @@ func (x *WriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteMemoryRequest.ProtoReflect.Descriptor instead.
-func (*WriteMemoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PauseEmulationRequest.ProtoReflect.Descriptor instead.
+func (*PauseEmulationRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *WriteMemoryRequest) GetRequestAddress() uint32 {
-	if x != nil {
-		return x.RequestAddress
-	}
-	return 0
-}
-
-func (x *WriteMemoryRequest) GetRequestAddressSpace() AddressSpace {
-	if x != nil {
-		return x.RequestAddressSpace
-	}
-	return AddressSpace_FxPakPro
-}
-
-func (x *WriteMemoryRequest) GetRequestMemoryMapping() MemoryMapping {
+func (x *PauseEmulationRequest) GetUri() string {
 	if x != nil {
-		return x.RequestMemoryMapping
+		return x.Uri
 	}
-	return MemoryMapping_Unknown
+	return ""
 }
 
-func (x *WriteMemoryRequest) GetData() []byte {
+func (x *PauseEmulationRequest) GetPaused() bool {
 	if x != nil {
-		return x.Data
+		return x.Paused
 	}
-	return nil
+	return false
 }
 
-type WriteMemoryResponse struct {
+type PauseEmulationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
-	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
-	RequestMemoryMapping MemoryMapping `protobuf:"varint,6,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
-	DeviceAddress        uint32        `protobuf:"varint,3,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
-	DeviceAddressSpace   AddressSpace  `protobuf:"varint,4,opt,name=deviceAddressSpace,proto3,enum=AddressSpace" json:"deviceAddressSpace,omitempty"`
-	Size                 uint32        `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Uri    string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Paused bool   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
-func (x *WriteMemoryResponse) Reset() {
-	*x = WriteMemoryResponse{}
+func (x *PauseEmulationResponse) Reset() {
+	*x = PauseEmulationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1150,13 +1200,13 @@ func (x *WriteMemoryResponse) Reset() {
 	}
 }
 
-func (x *WriteMemoryResponse) String() string {
+func (x *PauseEmulationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteMemoryResponse) ProtoMessage() {}
+func (*PauseEmulationResponse) ProtoMessage() {}
 
-func (x *WriteMemoryResponse) ProtoReflect() protoreflect.Message {
+func (x *PauseEmulationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1168,64 +1218,35 @@ func (x *WriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteMemoryResponse.ProtoReflect.Descriptor instead.
-func (*WriteMemoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PauseEmulationResponse.ProtoReflect.Descriptor instead.
+func (*PauseEmulationResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *WriteMemoryResponse) GetRequestAddress() uint32 {
-	if x != nil {
-		return x.RequestAddress
-	}
-	return 0
-}
-
-func (x *WriteMemoryResponse) GetRequestAddressSpace() AddressSpace {
-	if x != nil {
-		return x.RequestAddressSpace
-	}
-	return AddressSpace_FxPakPro
-}
-
-func (x *WriteMemoryResponse) GetRequestMemoryMapping() MemoryMapping {
-	if x != nil {
-		return x.RequestMemoryMapping
-	}
-	return MemoryMapping_Unknown
-}
-
-func (x *WriteMemoryResponse) GetDeviceAddress() uint32 {
-	if x != nil {
-		return x.DeviceAddress
-	}
-	return 0
-}
-
-func (x *WriteMemoryResponse) GetDeviceAddressSpace() AddressSpace {
+func (x *PauseEmulationResponse) GetUri() string {
 	if x != nil {
-		return x.DeviceAddressSpace
+		return x.Uri
 	}
-	return AddressSpace_FxPakPro
+	return ""
 }
 
-func (x *WriteMemoryResponse) GetSize() uint32 {
+func (x *PauseEmulationResponse) GetPaused() bool {
 	if x != nil {
-		return x.Size
+		return x.Paused
 	}
-	return 0
+	return false
 }
 
-type SingleReadMemoryRequest struct {
+type PauseToggleEmulationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri     string             `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Request *ReadMemoryRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *SingleReadMemoryRequest) Reset() {
-	*x = SingleReadMemoryRequest{}
+func (x *PauseToggleEmulationRequest) Reset() {
+	*x = PauseToggleEmulationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1233,13 +1254,13 @@ func (x *SingleReadMemoryRequest) Reset() {
 	}
 }
 
-func (x *SingleReadMemoryRequest) String() string {
+func (x *PauseToggleEmulationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleReadMemoryRequest) ProtoMessage() {}
+func (*PauseToggleEmulationRequest) ProtoMessage() {}
 
-func (x *SingleReadMemoryRequest) ProtoReflect() protoreflect.Message {
+func (x *PauseToggleEmulationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1251,36 +1272,28 @@ func (x *SingleReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleReadMemoryRequest.ProtoReflect.Descriptor instead.
-func (*SingleReadMemoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PauseToggleEmulationRequest.ProtoReflect.Descriptor instead.
+func (*PauseToggleEmulationRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SingleReadMemoryRequest) GetUri() string {
+func (x *PauseToggleEmulationRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *SingleReadMemoryRequest) GetRequest() *ReadMemoryRequest {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-type SingleReadMemoryResponse struct {
+type PauseToggleEmulationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri      string              `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Response *ReadMemoryResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *SingleReadMemoryResponse) Reset() {
-	*x = SingleReadMemoryResponse{}
+func (x *PauseToggleEmulationResponse) Reset() {
+	*x = PauseToggleEmulationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1288,13 +1301,13 @@ func (x *SingleReadMemoryResponse) Reset() {
 	}
 }
 
-func (x *SingleReadMemoryResponse) String() string {
+func (x *PauseToggleEmulationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleReadMemoryResponse) ProtoMessage() {}
+func (*PauseToggleEmulationResponse) ProtoMessage() {}
 
-func (x *SingleReadMemoryResponse) ProtoReflect() protoreflect.Message {
+func (x *PauseToggleEmulationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1306,36 +1319,32 @@ func (x *SingleReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleReadMemoryResponse.ProtoReflect.Descriptor instead.
-func (*SingleReadMemoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PauseToggleEmulationResponse.ProtoReflect.Descriptor instead.
+func (*PauseToggleEmulationResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SingleReadMemoryResponse) GetUri() string {
+func (x *PauseToggleEmulationResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *SingleReadMemoryResponse) GetResponse() *ReadMemoryResponse {
-	if x != nil {
-		return x.Response
-	}
-	return nil
-}
-
-type SingleWriteMemoryRequest struct {
+type ExecuteASMRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri     string              `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Request *WriteMemoryRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// 65816 machine code of the routine to execute once; it is called with JSL and must return with RTL
+	Code []byte `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// optional data placed in memory immediately after the routine
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *SingleWriteMemoryRequest) Reset() {
-	*x = SingleWriteMemoryRequest{}
+func (x *ExecuteASMRequest) Reset() {
+	*x = ExecuteASMRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1343,13 +1352,13 @@ func (x *SingleWriteMemoryRequest) Reset() {
 	}
 }
 
-func (x *SingleWriteMemoryRequest) String() string {
+func (x *ExecuteASMRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleWriteMemoryRequest) ProtoMessage() {}
+func (*ExecuteASMRequest) ProtoMessage() {}
 
-func (x *SingleWriteMemoryRequest) ProtoReflect() protoreflect.Message {
+func (x *ExecuteASMRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1361,36 +1370,42 @@ func (x *SingleWriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleWriteMemoryRequest.ProtoReflect.Descriptor instead.
-func (*SingleWriteMemoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteASMRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteASMRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SingleWriteMemoryRequest) GetUri() string {
+func (x *ExecuteASMRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *SingleWriteMemoryRequest) GetRequest() *WriteMemoryRequest {
+func (x *ExecuteASMRequest) GetCode() []byte {
 	if x != nil {
-		return x.Request
+		return x.Code
 	}
 	return nil
 }
 
-type SingleWriteMemoryResponse struct {
+func (x *ExecuteASMRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExecuteASMResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri      string               `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Response *WriteMemoryResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *SingleWriteMemoryResponse) Reset() {
-	*x = SingleWriteMemoryResponse{}
+func (x *ExecuteASMResponse) Reset() {
+	*x = ExecuteASMResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1398,13 +1413,13 @@ func (x *SingleWriteMemoryResponse) Reset() {
 	}
 }
 
-func (x *SingleWriteMemoryResponse) String() string {
+func (x *ExecuteASMResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleWriteMemoryResponse) ProtoMessage() {}
+func (*ExecuteASMResponse) ProtoMessage() {}
 
-func (x *SingleWriteMemoryResponse) ProtoReflect() protoreflect.Message {
+func (x *ExecuteASMResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1416,36 +1431,33 @@ func (x *SingleWriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleWriteMemoryResponse.ProtoReflect.Descriptor instead.
-func (*SingleWriteMemoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteASMResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteASMResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SingleWriteMemoryResponse) GetUri() string {
+func (x *ExecuteASMResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *SingleWriteMemoryResponse) GetResponse() *WriteMemoryResponse {
-	if x != nil {
-		return x.Response
-	}
-	return nil
-}
-
-type MultiReadMemoryRequest struct {
+type DetectMemoryMappingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri      string               `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Requests []*ReadMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// optional fallback value to set in case detection fails for some reason
+	FallbackMemoryMapping *MemoryMapping `protobuf:"varint,2,opt,name=fallbackMemoryMapping,proto3,enum=MemoryMapping,oneof" json:"fallbackMemoryMapping,omitempty"`
+	// optional ROM header (from bus address $00:FFB0, at least $30 bytes long) to use for detection
+	// if not provided, the header will be read from the device
+	RomHeader00FFB0 []byte `protobuf:"bytes,3,opt,name=romHeader00FFB0,proto3,oneof" json:"romHeader00FFB0,omitempty"`
 }
 
-func (x *MultiReadMemoryRequest) Reset() {
-	*x = MultiReadMemoryRequest{}
+func (x *DetectMemoryMappingRequest) Reset() {
+	*x = DetectMemoryMappingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1453,13 +1465,13 @@ func (x *MultiReadMemoryRequest) Reset() {
 	}
 }
 
-func (x *MultiReadMemoryRequest) String() string {
+func (x *DetectMemoryMappingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiReadMemoryRequest) ProtoMessage() {}
+func (*DetectMemoryMappingRequest) ProtoMessage() {}
 
-func (x *MultiReadMemoryRequest) ProtoReflect() protoreflect.Message {
+func (x *DetectMemoryMappingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1471,36 +1483,48 @@ func (x *MultiReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiReadMemoryRequest.ProtoReflect.Descriptor instead.
-func (*MultiReadMemoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DetectMemoryMappingRequest.ProtoReflect.Descriptor instead.
+func (*DetectMemoryMappingRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MultiReadMemoryRequest) GetUri() string {
+func (x *DetectMemoryMappingRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *MultiReadMemoryRequest) GetRequests() []*ReadMemoryRequest {
+func (x *DetectMemoryMappingRequest) GetFallbackMemoryMapping() MemoryMapping {
+	if x != nil && x.FallbackMemoryMapping != nil {
+		return *x.FallbackMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *DetectMemoryMappingRequest) GetRomHeader00FFB0() []byte {
 	if x != nil {
-		return x.Requests
+		return x.RomHeader00FFB0
 	}
 	return nil
 }
 
-type MultiReadMemoryResponse struct {
+type DetectMemoryMappingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri       string                `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Responses []*ReadMemoryResponse `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// the memory mapping mode detected
+	MemoryMapping MemoryMapping `protobuf:"varint,2,opt,name=memoryMapping,proto3,enum=MemoryMapping" json:"memoryMapping,omitempty"`
+	// true if confident we detected a mapping; false if using a fallback or default value
+	Confidence bool `protobuf:"varint,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// the ROM header read from $00:FFB0, length is $50 bytes if server reads it, otherwise length of `romHeader00FFB0` from request
+	RomHeader00FFB0 []byte `protobuf:"bytes,4,opt,name=romHeader00FFB0,proto3" json:"romHeader00FFB0,omitempty"`
 }
 
-func (x *MultiReadMemoryResponse) Reset() {
-	*x = MultiReadMemoryResponse{}
+func (x *DetectMemoryMappingResponse) Reset() {
+	*x = DetectMemoryMappingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1508,13 +1532,13 @@ func (x *MultiReadMemoryResponse) Reset() {
 	}
 }
 
-func (x *MultiReadMemoryResponse) String() string {
+func (x *DetectMemoryMappingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiReadMemoryResponse) ProtoMessage() {}
+func (*DetectMemoryMappingResponse) ProtoMessage() {}
 
-func (x *MultiReadMemoryResponse) ProtoReflect() protoreflect.Message {
+func (x *DetectMemoryMappingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1526,36 +1550,52 @@ func (x *MultiReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiReadMemoryResponse.ProtoReflect.Descriptor instead.
-func (*MultiReadMemoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DetectMemoryMappingResponse.ProtoReflect.Descriptor instead.
+func (*DetectMemoryMappingResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *MultiReadMemoryResponse) GetUri() string {
+func (x *DetectMemoryMappingResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *MultiReadMemoryResponse) GetResponses() []*ReadMemoryResponse {
+func (x *DetectMemoryMappingResponse) GetMemoryMapping() MemoryMapping {
 	if x != nil {
-		return x.Responses
+		return x.MemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *DetectMemoryMappingResponse) GetConfidence() bool {
+	if x != nil {
+		return x.Confidence
+	}
+	return false
+}
+
+func (x *DetectMemoryMappingResponse) GetRomHeader00FFB0() []byte {
+	if x != nil {
+		return x.RomHeader00FFB0
 	}
 	return nil
 }
 
-type MultiWriteMemoryRequest struct {
+type ReadMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri      string                `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Requests []*WriteMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	Size                 uint32        `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *MultiWriteMemoryRequest) Reset() {
-	*x = MultiWriteMemoryRequest{}
+func (x *ReadMemoryRequest) Reset() {
+	*x = ReadMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1563,13 +1603,13 @@ func (x *MultiWriteMemoryRequest) Reset() {
 	}
 }
 
-func (x *MultiWriteMemoryRequest) String() string {
+func (x *ReadMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiWriteMemoryRequest) ProtoMessage() {}
+func (*ReadMemoryRequest) ProtoMessage() {}
 
-func (x *MultiWriteMemoryRequest) ProtoReflect() protoreflect.Message {
+func (x *ReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1581,36 +1621,55 @@ func (x *MultiWriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiWriteMemoryRequest.ProtoReflect.Descriptor instead.
-func (*MultiWriteMemoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadMemoryRequest.ProtoReflect.Descriptor instead.
+func (*ReadMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *MultiWriteMemoryRequest) GetUri() string {
+func (x *ReadMemoryRequest) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Uri
+		return x.RequestAddress
 	}
-	return ""
+	return 0
 }
 
-func (x *MultiWriteMemoryRequest) GetRequests() []*WriteMemoryRequest {
+func (x *ReadMemoryRequest) GetRequestAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Requests
+		return x.RequestAddressSpace
 	}
-	return nil
+	return AddressSpace_FxPakPro
 }
 
-type MultiWriteMemoryResponse struct {
+func (x *ReadMemoryRequest) GetRequestMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.RequestMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *ReadMemoryRequest) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ReadMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri       string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Responses []*WriteMemoryResponse `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,6,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	// the address sent to the device and its space
+	DeviceAddress      uint32       `protobuf:"varint,3,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
+	DeviceAddressSpace AddressSpace `protobuf:"varint,4,opt,name=deviceAddressSpace,proto3,enum=AddressSpace" json:"deviceAddressSpace,omitempty"`
+	Data               []byte       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *MultiWriteMemoryResponse) Reset() {
-	*x = MultiWriteMemoryResponse{}
+func (x *ReadMemoryResponse) Reset() {
+	*x = ReadMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1618,13 +1677,13 @@ func (x *MultiWriteMemoryResponse) Reset() {
 	}
 }
 
-func (x *MultiWriteMemoryResponse) String() string {
+func (x *ReadMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiWriteMemoryResponse) ProtoMessage() {}
+func (*ReadMemoryResponse) ProtoMessage() {}
 
-func (x *MultiWriteMemoryResponse) ProtoReflect() protoreflect.Message {
+func (x *ReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1636,36 +1695,66 @@ func (x *MultiWriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiWriteMemoryResponse.ProtoReflect.Descriptor instead.
-func (*MultiWriteMemoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadMemoryResponse.ProtoReflect.Descriptor instead.
+func (*ReadMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *MultiWriteMemoryResponse) GetUri() string {
+func (x *ReadMemoryResponse) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Uri
+		return x.RequestAddress
 	}
-	return ""
+	return 0
 }
 
-func (x *MultiWriteMemoryResponse) GetResponses() []*WriteMemoryResponse {
+func (x *ReadMemoryResponse) GetRequestAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Responses
+		return x.RequestAddressSpace
 	}
-	return nil
+	return AddressSpace_FxPakPro
 }
 
-type ReadDirectoryRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+func (x *ReadMemoryResponse) GetRequestMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.RequestMemoryMapping
+	}
+	return MemoryMapping_Unknown
 }
 
-func (x *ReadDirectoryRequest) Reset() {
-	*x = ReadDirectoryRequest{}
+func (x *ReadMemoryResponse) GetDeviceAddress() uint32 {
+	if x != nil {
+		return x.DeviceAddress
+	}
+	return 0
+}
+
+func (x *ReadMemoryResponse) GetDeviceAddressSpace() AddressSpace {
+	if x != nil {
+		return x.DeviceAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+func (x *ReadMemoryResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type WriteMemoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	Data                 []byte        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *WriteMemoryRequest) Reset() {
+	*x = WriteMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1673,13 +1762,13 @@ func (x *ReadDirectoryRequest) Reset() {
 	}
 }
 
-func (x *ReadDirectoryRequest) String() string {
+func (x *WriteMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadDirectoryRequest) ProtoMessage() {}
+func (*WriteMemoryRequest) ProtoMessage() {}
 
-func (x *ReadDirectoryRequest) ProtoReflect() protoreflect.Message {
+func (x *WriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1691,36 +1780,54 @@ func (x *ReadDirectoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadDirectoryRequest.ProtoReflect.Descriptor instead.
-func (*ReadDirectoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WriteMemoryRequest.ProtoReflect.Descriptor instead.
+func (*WriteMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ReadDirectoryRequest) GetUri() string {
+func (x *WriteMemoryRequest) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Uri
+		return x.RequestAddress
 	}
-	return ""
+	return 0
 }
 
-func (x *ReadDirectoryRequest) GetPath() string {
+func (x *WriteMemoryRequest) GetRequestAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Path
+		return x.RequestAddressSpace
 	}
-	return ""
+	return AddressSpace_FxPakPro
 }
 
-type DirEntry struct {
+func (x *WriteMemoryRequest) GetRequestMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.RequestMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *WriteMemoryRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type WriteMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type DirEntryType `protobuf:"varint,2,opt,name=type,proto3,enum=DirEntryType" json:"type,omitempty"`
+	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,6,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	DeviceAddress        uint32        `protobuf:"varint,3,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
+	DeviceAddressSpace   AddressSpace  `protobuf:"varint,4,opt,name=deviceAddressSpace,proto3,enum=AddressSpace" json:"deviceAddressSpace,omitempty"`
+	Size                 uint32        `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *DirEntry) Reset() {
-	*x = DirEntry{}
+func (x *WriteMemoryResponse) Reset() {
+	*x = WriteMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1728,13 +1835,13 @@ func (x *DirEntry) Reset() {
 	}
 }
 
-func (x *DirEntry) String() string {
+func (x *WriteMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DirEntry) ProtoMessage() {}
+func (*WriteMemoryResponse) ProtoMessage() {}
 
-func (x *DirEntry) ProtoReflect() protoreflect.Message {
+func (x *WriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1746,37 +1853,64 @@ func (x *DirEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DirEntry.ProtoReflect.Descriptor instead.
-func (*DirEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use WriteMemoryResponse.ProtoReflect.Descriptor instead.
+func (*WriteMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DirEntry) GetName() string {
+func (x *WriteMemoryResponse) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Name
+		return x.RequestAddress
 	}
-	return ""
+	return 0
 }
 
-func (x *DirEntry) GetType() DirEntryType {
+func (x *WriteMemoryResponse) GetRequestAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Type
+		return x.RequestAddressSpace
 	}
-	return DirEntryType_Directory
+	return AddressSpace_FxPakPro
 }
 
-type ReadDirectoryResponse struct {
+func (x *WriteMemoryResponse) GetRequestMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.RequestMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *WriteMemoryResponse) GetDeviceAddress() uint32 {
+	if x != nil {
+		return x.DeviceAddress
+	}
+	return 0
+}
+
+func (x *WriteMemoryResponse) GetDeviceAddressSpace() AddressSpace {
+	if x != nil {
+		return x.DeviceAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+func (x *WriteMemoryResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SingleReadMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri     string      `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path    string      `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Entries []*DirEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	Uri     string             `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Request *ReadMemoryRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *ReadDirectoryResponse) Reset() {
-	*x = ReadDirectoryResponse{}
+func (x *SingleReadMemoryRequest) Reset() {
+	*x = SingleReadMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1784,13 +1918,13 @@ func (x *ReadDirectoryResponse) Reset() {
 	}
 }
 
-func (x *ReadDirectoryResponse) String() string {
+func (x *SingleReadMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadDirectoryResponse) ProtoMessage() {}
+func (*SingleReadMemoryRequest) ProtoMessage() {}
 
-func (x *ReadDirectoryResponse) ProtoReflect() protoreflect.Message {
+func (x *SingleReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1802,43 +1936,36 @@ func (x *ReadDirectoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadDirectoryResponse.ProtoReflect.Descriptor instead.
-func (*ReadDirectoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SingleReadMemoryRequest.ProtoReflect.Descriptor instead.
+func (*SingleReadMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ReadDirectoryResponse) GetUri() string {
+func (x *SingleReadMemoryRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *ReadDirectoryResponse) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *ReadDirectoryResponse) GetEntries() []*DirEntry {
+func (x *SingleReadMemoryRequest) GetRequest() *ReadMemoryRequest {
 	if x != nil {
-		return x.Entries
+		return x.Request
 	}
 	return nil
 }
 
-type MakeDirectoryRequest struct {
+type SingleReadMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri      string              `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Response *ReadMemoryResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *MakeDirectoryRequest) Reset() {
-	*x = MakeDirectoryRequest{}
+func (x *SingleReadMemoryResponse) Reset() {
+	*x = SingleReadMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1846,13 +1973,13 @@ func (x *MakeDirectoryRequest) Reset() {
 	}
 }
 
-func (x *MakeDirectoryRequest) String() string {
+func (x *SingleReadMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakeDirectoryRequest) ProtoMessage() {}
+func (*SingleReadMemoryResponse) ProtoMessage() {}
 
-func (x *MakeDirectoryRequest) ProtoReflect() protoreflect.Message {
+func (x *SingleReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1864,36 +1991,36 @@ func (x *MakeDirectoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakeDirectoryRequest.ProtoReflect.Descriptor instead.
-func (*MakeDirectoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SingleReadMemoryResponse.ProtoReflect.Descriptor instead.
+func (*SingleReadMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *MakeDirectoryRequest) GetUri() string {
+func (x *SingleReadMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *MakeDirectoryRequest) GetPath() string {
+func (x *SingleReadMemoryResponse) GetResponse() *ReadMemoryResponse {
 	if x != nil {
-		return x.Path
+		return x.Response
 	}
-	return ""
+	return nil
 }
 
-type MakeDirectoryResponse struct {
+type SingleWriteMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri     string              `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Request *WriteMemoryRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *MakeDirectoryResponse) Reset() {
-	*x = MakeDirectoryResponse{}
+func (x *SingleWriteMemoryRequest) Reset() {
+	*x = SingleWriteMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1901,13 +2028,13 @@ func (x *MakeDirectoryResponse) Reset() {
 	}
 }
 
-func (x *MakeDirectoryResponse) String() string {
+func (x *SingleWriteMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakeDirectoryResponse) ProtoMessage() {}
+func (*SingleWriteMemoryRequest) ProtoMessage() {}
 
-func (x *MakeDirectoryResponse) ProtoReflect() protoreflect.Message {
+func (x *SingleWriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1919,36 +2046,36 @@ func (x *MakeDirectoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakeDirectoryResponse.ProtoReflect.Descriptor instead.
-func (*MakeDirectoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SingleWriteMemoryRequest.ProtoReflect.Descriptor instead.
+func (*SingleWriteMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *MakeDirectoryResponse) GetUri() string {
+func (x *SingleWriteMemoryRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *MakeDirectoryResponse) GetPath() string {
+func (x *SingleWriteMemoryRequest) GetRequest() *WriteMemoryRequest {
 	if x != nil {
-		return x.Path
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-type RemoveFileRequest struct {
+type SingleWriteMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri      string               `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Response *WriteMemoryResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *RemoveFileRequest) Reset() {
-	*x = RemoveFileRequest{}
+func (x *SingleWriteMemoryResponse) Reset() {
+	*x = SingleWriteMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1956,13 +2083,13 @@ func (x *RemoveFileRequest) Reset() {
 	}
 }
 
-func (x *RemoveFileRequest) String() string {
+func (x *SingleWriteMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFileRequest) ProtoMessage() {}
+func (*SingleWriteMemoryResponse) ProtoMessage() {}
 
-func (x *RemoveFileRequest) ProtoReflect() protoreflect.Message {
+func (x *SingleWriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1974,36 +2101,36 @@ func (x *RemoveFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFileRequest.ProtoReflect.Descriptor instead.
-func (*RemoveFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SingleWriteMemoryResponse.ProtoReflect.Descriptor instead.
+func (*SingleWriteMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *RemoveFileRequest) GetUri() string {
+func (x *SingleWriteMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *RemoveFileRequest) GetPath() string {
+func (x *SingleWriteMemoryResponse) GetResponse() *WriteMemoryResponse {
 	if x != nil {
-		return x.Path
+		return x.Response
 	}
-	return ""
+	return nil
 }
 
-type RemoveFileResponse struct {
+type MultiReadMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri      string               `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Requests []*ReadMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *RemoveFileResponse) Reset() {
-	*x = RemoveFileResponse{}
+func (x *MultiReadMemoryRequest) Reset() {
+	*x = MultiReadMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2011,13 +2138,13 @@ func (x *RemoveFileResponse) Reset() {
 	}
 }
 
-func (x *RemoveFileResponse) String() string {
+func (x *MultiReadMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFileResponse) ProtoMessage() {}
+func (*MultiReadMemoryRequest) ProtoMessage() {}
 
-func (x *RemoveFileResponse) ProtoReflect() protoreflect.Message {
+func (x *MultiReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2029,37 +2156,36 @@ func (x *RemoveFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFileResponse.ProtoReflect.Descriptor instead.
-func (*RemoveFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MultiReadMemoryRequest.ProtoReflect.Descriptor instead.
+func (*MultiReadMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RemoveFileResponse) GetUri() string {
+func (x *MultiReadMemoryRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *RemoveFileResponse) GetPath() string {
+func (x *MultiReadMemoryRequest) GetRequests() []*ReadMemoryRequest {
 	if x != nil {
-		return x.Path
+		return x.Requests
 	}
-	return ""
+	return nil
 }
 
-type RenameFileRequest struct {
+type MultiReadMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+	Uri       string                `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Responses []*ReadMemoryResponse `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
 }
 
-func (x *RenameFileRequest) Reset() {
-	*x = RenameFileRequest{}
+func (x *MultiReadMemoryResponse) Reset() {
+	*x = MultiReadMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2067,13 +2193,13 @@ func (x *RenameFileRequest) Reset() {
 	}
 }
 
-func (x *RenameFileRequest) String() string {
+func (x *MultiReadMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameFileRequest) ProtoMessage() {}
+func (*MultiReadMemoryResponse) ProtoMessage() {}
 
-func (x *RenameFileRequest) ProtoReflect() protoreflect.Message {
+func (x *MultiReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2085,44 +2211,36 @@ func (x *RenameFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameFileRequest.ProtoReflect.Descriptor instead.
-func (*RenameFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MultiReadMemoryResponse.ProtoReflect.Descriptor instead.
+func (*MultiReadMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *RenameFileRequest) GetUri() string {
+func (x *MultiReadMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *RenameFileRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *RenameFileRequest) GetNewFilename() string {
+func (x *MultiReadMemoryResponse) GetResponses() []*ReadMemoryResponse {
 	if x != nil {
-		return x.NewFilename
+		return x.Responses
 	}
-	return ""
+	return nil
 }
 
-type RenameFileResponse struct {
+type MultiWriteMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+	Uri      string                `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Requests []*WriteMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *RenameFileResponse) Reset() {
-	*x = RenameFileResponse{}
+func (x *MultiWriteMemoryRequest) Reset() {
+	*x = MultiWriteMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2130,13 +2248,13 @@ func (x *RenameFileResponse) Reset() {
 	}
 }
 
-func (x *RenameFileResponse) String() string {
+func (x *MultiWriteMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameFileResponse) ProtoMessage() {}
+func (*MultiWriteMemoryRequest) ProtoMessage() {}
 
-func (x *RenameFileResponse) ProtoReflect() protoreflect.Message {
+func (x *MultiWriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2148,44 +2266,36 @@ func (x *RenameFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameFileResponse.ProtoReflect.Descriptor instead.
-func (*RenameFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MultiWriteMemoryRequest.ProtoReflect.Descriptor instead.
+func (*MultiWriteMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *RenameFileResponse) GetUri() string {
+func (x *MultiWriteMemoryRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *RenameFileResponse) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *RenameFileResponse) GetNewFilename() string {
+func (x *MultiWriteMemoryRequest) GetRequests() []*WriteMemoryRequest {
 	if x != nil {
-		return x.NewFilename
+		return x.Requests
 	}
-	return ""
+	return nil
 }
 
-type PutFileRequest struct {
+type MultiWriteMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Uri       string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Responses []*WriteMemoryResponse `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
 }
 
-func (x *PutFileRequest) Reset() {
-	*x = PutFileRequest{}
+func (x *MultiWriteMemoryResponse) Reset() {
+	*x = MultiWriteMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2193,13 +2303,13 @@ func (x *PutFileRequest) Reset() {
 	}
 }
 
-func (x *PutFileRequest) String() string {
+func (x *MultiWriteMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutFileRequest) ProtoMessage() {}
+func (*MultiWriteMemoryResponse) ProtoMessage() {}
 
-func (x *PutFileRequest) ProtoReflect() protoreflect.Message {
+func (x *MultiWriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2211,44 +2321,38 @@ func (x *PutFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutFileRequest.ProtoReflect.Descriptor instead.
-func (*PutFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MultiWriteMemoryResponse.ProtoReflect.Descriptor instead.
+func (*MultiWriteMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *PutFileRequest) GetUri() string {
+func (x *MultiWriteMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *PutFileRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *PutFileRequest) GetData() []byte {
+func (x *MultiWriteMemoryResponse) GetResponses() []*WriteMemoryResponse {
 	if x != nil {
-		return x.Data
+		return x.Responses
 	}
 	return nil
 }
 
-type PutFileResponse struct {
+type WatchMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Uri      string               `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Requests []*ReadMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	// number of SNES frames (~16.64ms each) between polls of the device; 0 is treated as 1:
+	IntervalFrames uint32 `protobuf:"varint,3,opt,name=intervalFrames,proto3" json:"intervalFrames,omitempty"`
 }
 
-func (x *PutFileResponse) Reset() {
-	*x = PutFileResponse{}
+func (x *WatchMemoryRequest) Reset() {
+	*x = WatchMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2256,13 +2360,13 @@ func (x *PutFileResponse) Reset() {
 	}
 }
 
-func (x *PutFileResponse) String() string {
+func (x *WatchMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutFileResponse) ProtoMessage() {}
+func (*WatchMemoryRequest) ProtoMessage() {}
 
-func (x *PutFileResponse) ProtoReflect() protoreflect.Message {
+func (x *WatchMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2274,43 +2378,45 @@ func (x *PutFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutFileResponse.ProtoReflect.Descriptor instead.
-func (*PutFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchMemoryRequest.ProtoReflect.Descriptor instead.
+func (*WatchMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *PutFileResponse) GetUri() string {
+func (x *WatchMemoryRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *PutFileResponse) GetPath() string {
+func (x *WatchMemoryRequest) GetRequests() []*ReadMemoryRequest {
 	if x != nil {
-		return x.Path
+		return x.Requests
 	}
-	return ""
+	return nil
 }
 
-func (x *PutFileResponse) GetSize() uint32 {
+func (x *WatchMemoryRequest) GetIntervalFrames() uint32 {
 	if x != nil {
-		return x.Size
+		return x.IntervalFrames
 	}
 	return 0
 }
 
-type GetFileRequest struct {
+type MemoryDiff struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// byte offset of the changed span relative to the start of the watched segment
+	Offset uint32 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// new contents of the changed span
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *GetFileRequest) Reset() {
-	*x = GetFileRequest{}
+func (x *MemoryDiff) Reset() {
+	*x = MemoryDiff{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2318,13 +2424,13 @@ func (x *GetFileRequest) Reset() {
 	}
 }
 
-func (x *GetFileRequest) String() string {
+func (x *MemoryDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileRequest) ProtoMessage() {}
+func (*MemoryDiff) ProtoMessage() {}
 
-func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+func (x *MemoryDiff) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2336,38 +2442,44 @@ func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
-func (*GetFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MemoryDiff.ProtoReflect.Descriptor instead.
+func (*MemoryDiff) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetFileRequest) GetUri() string {
+func (x *MemoryDiff) GetOffset() uint32 {
 	if x != nil {
-		return x.Uri
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFileRequest) GetPath() string {
+func (x *MemoryDiff) GetData() []byte {
 	if x != nil {
-		return x.Path
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type GetFileResponse struct {
+type WatchedMemoryChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// index into WatchMemoryRequest.requests of the segment that changed
+	Index                uint32        `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	RequestAddress       uint32        `protobuf:"varint,2,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,3,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	// the address sent to the device and its space
+	DeviceAddress      uint32       `protobuf:"varint,5,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
+	DeviceAddressSpace AddressSpace `protobuf:"varint,6,opt,name=deviceAddressSpace,proto3,enum=AddressSpace" json:"deviceAddressSpace,omitempty"`
+	// changed spans within the segment; the first response for a segment contains its entire contents
+	Diffs []*MemoryDiff `protobuf:"bytes,7,rep,name=diffs,proto3" json:"diffs,omitempty"`
 }
 
-func (x *GetFileResponse) Reset() {
-	*x = GetFileResponse{}
+func (x *WatchedMemoryChange) Reset() {
+	*x = WatchedMemoryChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2375,13 +2487,13 @@ func (x *GetFileResponse) Reset() {
 	}
 }
 
-func (x *GetFileResponse) String() string {
+func (x *WatchedMemoryChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileResponse) ProtoMessage() {}
+func (*WatchedMemoryChange) ProtoMessage() {}
 
-func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
+func (x *WatchedMemoryChange) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2393,50 +2505,71 @@ func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileResponse.ProtoReflect.Descriptor instead.
-func (*GetFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchedMemoryChange.ProtoReflect.Descriptor instead.
+func (*WatchedMemoryChange) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetFileResponse) GetUri() string {
+func (x *WatchedMemoryChange) GetIndex() uint32 {
 	if x != nil {
-		return x.Uri
+		return x.Index
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFileResponse) GetPath() string {
+func (x *WatchedMemoryChange) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Path
+		return x.RequestAddress
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFileResponse) GetSize() uint32 {
+func (x *WatchedMemoryChange) GetRequestAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Size
+		return x.RequestAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+func (x *WatchedMemoryChange) GetRequestMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.RequestMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *WatchedMemoryChange) GetDeviceAddress() uint32 {
+	if x != nil {
+		return x.DeviceAddress
 	}
 	return 0
 }
 
-func (x *GetFileResponse) GetData() []byte {
+func (x *WatchedMemoryChange) GetDeviceAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Data
+		return x.DeviceAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+func (x *WatchedMemoryChange) GetDiffs() []*MemoryDiff {
+	if x != nil {
+		return x.Diffs
 	}
 	return nil
 }
 
-type BootFileRequest struct {
+type WatchMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri     string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Changes []*WatchedMemoryChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (x *BootFileRequest) Reset() {
-	*x = BootFileRequest{}
+func (x *WatchMemoryResponse) Reset() {
+	*x = WatchMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2444,13 +2577,13 @@ func (x *BootFileRequest) Reset() {
 	}
 }
 
-func (x *BootFileRequest) String() string {
+func (x *WatchMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BootFileRequest) ProtoMessage() {}
+func (*WatchMemoryResponse) ProtoMessage() {}
 
-func (x *BootFileRequest) ProtoReflect() protoreflect.Message {
+func (x *WatchMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2462,36 +2595,36 @@ func (x *BootFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BootFileRequest.ProtoReflect.Descriptor instead.
-func (*BootFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchMemoryResponse.ProtoReflect.Descriptor instead.
+func (*WatchMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *BootFileRequest) GetUri() string {
+func (x *WatchMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *BootFileRequest) GetPath() string {
+func (x *WatchMemoryResponse) GetChanges() []*WatchedMemoryChange {
 	if x != nil {
-		return x.Path
+		return x.Changes
 	}
-	return ""
+	return nil
 }
 
-type BootFileResponse struct {
+type FieldsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri    string  `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Fields []Field `protobuf:"varint,2,rep,packed,name=fields,proto3,enum=Field" json:"fields,omitempty"`
 }
 
-func (x *BootFileResponse) Reset() {
-	*x = BootFileResponse{}
+func (x *FieldsRequest) Reset() {
+	*x = FieldsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2499,13 +2632,13 @@ func (x *BootFileResponse) Reset() {
 	}
 }
 
-func (x *BootFileResponse) String() string {
+func (x *FieldsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BootFileResponse) ProtoMessage() {}
+func (*FieldsRequest) ProtoMessage() {}
 
-func (x *BootFileResponse) ProtoReflect() protoreflect.Message {
+func (x *FieldsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2517,49 +2650,37 @@ func (x *BootFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BootFileResponse.ProtoReflect.Descriptor instead.
-func (*BootFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FieldsRequest.ProtoReflect.Descriptor instead.
+func (*FieldsRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *BootFileResponse) GetUri() string {
+func (x *FieldsRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *BootFileResponse) GetPath() string {
+func (x *FieldsRequest) GetFields() []Field {
 	if x != nil {
-		return x.Path
+		return x.Fields
 	}
-	return ""
+	return nil
 }
 
-type DevicesResponse_Device struct {
+type FieldsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// URI that describes exactly how to connect to the device, e.g.:
-	// RetroArch:  "ra://127.0.0.1:55355"
-	// FX Pak Pro: "fxpakpro://./dev/cu.usbmodemDEMO000000001" (MacOS)
-	//             "fxpakpro://./COM4"                         (Windows)
-	//             "fxpakpro://./dev/ttyACM0"                  (Linux)
-	// uri is used as the unique identifier of the device for clients to refer to
 	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// friendly display name of the device
-	DisplayName string `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
-	// device kind, e.g. "fxpakpro", "retroarch", "lua"
-	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
-	// all device capabilities:
-	Capabilities []DeviceCapability `protobuf:"varint,4,rep,packed,name=capabilities,proto3,enum=DeviceCapability" json:"capabilities,omitempty"`
-	// default address space for the device:
-	DefaultAddressSpace AddressSpace `protobuf:"varint,5,opt,name=defaultAddressSpace,proto3,enum=AddressSpace" json:"defaultAddressSpace,omitempty"`
+	// one value per requested field in the same order as requested
+	Values []*FieldsResponse_Value `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
 }
 
-func (x *DevicesResponse_Device) Reset() {
-	*x = DevicesResponse_Device{}
+func (x *FieldsResponse) Reset() {
+	*x = FieldsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2567,13 +2688,13 @@ func (x *DevicesResponse_Device) Reset() {
 	}
 }
 
-func (x *DevicesResponse_Device) String() string {
+func (x *FieldsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DevicesResponse_Device) ProtoMessage() {}
+func (*FieldsResponse) ProtoMessage() {}
 
-func (x *DevicesResponse_Device) ProtoReflect() protoreflect.Message {
+func (x *FieldsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2585,553 +2706,3621 @@ func (x *DevicesResponse_Device) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DevicesResponse_Device.ProtoReflect.Descriptor instead.
-func (*DevicesResponse_Device) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use FieldsResponse.ProtoReflect.Descriptor instead.
+func (*FieldsResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *DevicesResponse_Device) GetUri() string {
+func (x *FieldsResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *DevicesResponse_Device) GetDisplayName() string {
+func (x *FieldsResponse) GetValues() []*FieldsResponse_Value {
 	if x != nil {
-		return x.DisplayName
+		return x.Values
 	}
-	return ""
+	return nil
 }
 
-func (x *DevicesResponse_Device) GetKind() string {
+type MemoryCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,3,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	// expected memory contents
+	Expected []byte `protobuf:"bytes,4,opt,name=expected,proto3" json:"expected,omitempty"`
+	// optional mask of the bits to compare; must be the same length as expected if present
+	Mask []byte `protobuf:"bytes,5,opt,name=mask,proto3" json:"mask,omitempty"`
+}
+
+func (x *MemoryCondition) Reset() {
+	*x = MemoryCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryCondition) ProtoMessage() {}
+
+func (x *MemoryCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryCondition.ProtoReflect.Descriptor instead.
+func (*MemoryCondition) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MemoryCondition) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Kind
+		return x.RequestAddress
 	}
-	return ""
+	return 0
 }
 
-func (x *DevicesResponse_Device) GetCapabilities() []DeviceCapability {
+func (x *MemoryCondition) GetRequestAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Capabilities
+		return x.RequestAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+func (x *MemoryCondition) GetRequestMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.RequestMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *MemoryCondition) GetExpected() []byte {
+	if x != nil {
+		return x.Expected
 	}
 	return nil
 }
 
-func (x *DevicesResponse_Device) GetDefaultAddressSpace() AddressSpace {
+func (x *MemoryCondition) GetMask() []byte {
 	if x != nil {
-		return x.DefaultAddressSpace
+		return x.Mask
 	}
-	return AddressSpace_FxPakPro
+	return nil
 }
 
-var File_sni_proto protoreflect.FileDescriptor
+type ConditionalWriteMemoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_sni_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x73, 0x6e, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x0e, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x69,
-	0x6e, 0x64, 0x73, 0x22, 0x8f, 0x02, 0x0a, 0x0f, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0xc8, 0x01, 0x0a, 0x06, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x35, 0x0a,
-	0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x22, 0x26, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x27, 0x0a,
-	0x13, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x26, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54,
-	0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x27,
-	0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x41, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65,
-	0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x16, 0x50, 0x61,
-	0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x2f,
-	0x0a, 0x1b, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22,
-	0x30, 0x0a, 0x1c, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d,
-	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
-	0x69, 0x22, 0xd6, 0x01, 0x0a, 0x1a, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x12, 0x49, 0x0a, 0x15, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x48, 0x00, 0x52, 0x15, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a,
-	0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16,
-	0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
-	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x6f, 0x6d, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0xaf, 0x01, 0x0a, 0x1b, 0x44,
-	0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
-	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x34, 0x0a, 0x0d,
-	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30,
-	0x30, 0x46, 0x46, 0x42, 0x30, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x72, 0x6f, 0x6d,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0xd4, 0x01, 0x0a,
-	0x11, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x22, 0xba, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70,
-	0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a,
-	0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70,
-	0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0xd5, 0x01, 0x0a, 0x12, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
-	0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
-	0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
-	0x70, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbb, 0x02, 0x0a, 0x13, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a,
-	0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x12,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
-	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x59, 0x0a, 0x17, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
-	0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x69, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x5d, 0x0a, 0x18, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
-	0x2f, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x5b, 0x0a, 0x18, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2d,
-	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5f, 0x0a,
-	0x19, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
-	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x30, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a,
-	0x0a, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x5e, 0x0a, 0x17, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x61,
-	0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x17, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x57, 0x72, 0x69, 0x74,
-	0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x60, 0x0a, 0x18, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x57, 0x72, 0x69, 0x74,
-	0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x14, 0x52, 0x65,
-	0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x41, 0x0a, 0x08, 0x44, 0x69, 0x72, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x62, 0x0a, 0x15, 0x52,
-	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x07, 0x65, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x44, 0x69,
-	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22,
-	0x3c, 0x0a, 0x14, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3d, 0x0a,
-	0x15, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x39, 0x0a, 0x11,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3a, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x22, 0x5b, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20,
-	0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x5c, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b,
-	0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4a,
-	0x0a, 0x0e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x0f, 0x50, 0x75,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
-	0x5f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x37, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x38, 0x0a, 0x10, 0x42, 0x6f, 0x6f,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x2a, 0x33, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70,
-	0x61, 0x63, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x78, 0x50, 0x61, 0x6b, 0x50, 0x72, 0x6f, 0x10,
-	0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x6e, 0x65, 0x73, 0x41, 0x42, 0x75, 0x73, 0x10, 0x01, 0x12,
-	0x07, 0x0a, 0x03, 0x52, 0x61, 0x77, 0x10, 0x02, 0x2a, 0x3f, 0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b,
-	0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x69, 0x52, 0x4f, 0x4d, 0x10,
-	0x01, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x6f, 0x52, 0x4f, 0x4d, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
-	0x45, 0x78, 0x48, 0x69, 0x52, 0x4f, 0x4d, 0x10, 0x03, 0x2a, 0x92, 0x02, 0x0a, 0x10, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x08,
-	0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x72, 0x69, 0x74,
-	0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x41, 0x53, 0x4d, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x61,
-	0x75, 0x73, 0x65, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12,
-	0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x10, 0x07,
-	0x12, 0x11, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x10, 0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x10, 0x0b, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x46, 0x69, 0x6c, 0x65, 0x10, 0x0c, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x46, 0x69, 0x6c, 0x65, 0x10, 0x0d, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x10, 0x0e, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x0f,
-	0x12, 0x0c, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x10, 0x2a, 0x27,
-	0x0a, 0x0c, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d,
-	0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10, 0x00, 0x12, 0x08, 0x0a,
-	0x04, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x01, 0x32, 0x3d, 0x0a, 0x07, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x12, 0x0f, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xaa, 0x02, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65,
-	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52,
-	0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d,
-	0x65, 0x6e, 0x75, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e,
-	0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x4a, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65,
-	0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x50, 0x61, 0x75, 0x73,
-	0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14,
-	0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67,
-	0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
-	0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x32, 0x81, 0x04, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x12, 0x4c, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44,
-	0x65, 0x74, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64,
-	0x12, 0x18, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x53, 0x69, 0x6e,
-	0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x53, 0x69, 0x6e, 0x67, 0x6c,
-	0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x40, 0x0a, 0x09, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61,
-	0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x43, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12,
-	0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x48, 0x0a,
-	0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x32, 0x9b, 0x03, 0x0a, 0x10, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x40, 0x0a, 0x0d,
-	0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
-	0x0a, 0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12,
-	0x15, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12,
-	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65,
-	0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e,
-	0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
-	0x2e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e,
-	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
-	0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10,
-	0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x11, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x22, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x74, 0x70, 0x6f, 0x2f, 0x73, 0x6e, 0x69, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x6e, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	Uri        string                `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Conditions []*MemoryCondition    `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Writes     []*WriteMemoryRequest `protobuf:"bytes,3,rep,name=writes,proto3" json:"writes,omitempty"`
 }
 
-var (
-	file_sni_proto_rawDescOnce sync.Once
-	file_sni_proto_rawDescData = file_sni_proto_rawDesc
-)
+func (x *ConditionalWriteMemoryRequest) Reset() {
+	*x = ConditionalWriteMemoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_sni_proto_rawDescGZIP() []byte {
-	file_sni_proto_rawDescOnce.Do(func() {
-		file_sni_proto_rawDescData = protoimpl.X.CompressGZIP(file_sni_proto_rawDescData)
-	})
-	return file_sni_proto_rawDescData
+func (x *ConditionalWriteMemoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_sni_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_sni_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
-var file_sni_proto_goTypes = []interface{}{
-	(AddressSpace)(0),                    // 0: AddressSpace
-	(MemoryMapping)(0),                   // 1: MemoryMapping
-	(DeviceCapability)(0),                // 2: DeviceCapability
-	(DirEntryType)(0),                    // 3: DirEntryType
-	(*DevicesRequest)(nil),               // 4: DevicesRequest
-	(*DevicesResponse)(nil),              // 5: DevicesResponse
-	(*ResetSystemRequest)(nil),           // 6: ResetSystemRequest
-	(*ResetSystemResponse)(nil),          // 7: ResetSystemResponse
-	(*ResetToMenuRequest)(nil),           // 8: ResetToMenuRequest
-	(*ResetToMenuResponse)(nil),          // 9: ResetToMenuResponse
-	(*PauseEmulationRequest)(nil),        // 10: PauseEmulationRequest
-	(*PauseEmulationResponse)(nil),       // 11: PauseEmulationResponse
-	(*PauseToggleEmulationRequest)(nil),  // 12: PauseToggleEmulationRequest
-	(*PauseToggleEmulationResponse)(nil), // 13: PauseToggleEmulationResponse
-	(*DetectMemoryMappingRequest)(nil),   // 14: DetectMemoryMappingRequest
-	(*DetectMemoryMappingResponse)(nil),  // 15: DetectMemoryMappingResponse
-	(*ReadMemoryRequest)(nil),            // 16: ReadMemoryRequest
-	(*ReadMemoryResponse)(nil),           // 17: ReadMemoryResponse
-	(*WriteMemoryRequest)(nil),           // 18: WriteMemoryRequest
-	(*WriteMemoryResponse)(nil),          // 19: WriteMemoryResponse
-	(*SingleReadMemoryRequest)(nil),      // 20: SingleReadMemoryRequest
-	(*SingleReadMemoryResponse)(nil),     // 21: SingleReadMemoryResponse
-	(*SingleWriteMemoryRequest)(nil),     // 22: SingleWriteMemoryRequest
-	(*SingleWriteMemoryResponse)(nil),    // 23: SingleWriteMemoryResponse
-	(*MultiReadMemoryRequest)(nil),       // 24: MultiReadMemoryRequest
-	(*MultiReadMemoryResponse)(nil),      // 25: MultiReadMemoryResponse
-	(*MultiWriteMemoryRequest)(nil),      // 26: MultiWriteMemoryRequest
-	(*MultiWriteMemoryResponse)(nil),     // 27: MultiWriteMemoryResponse
-	(*ReadDirectoryRequest)(nil),         // 28: ReadDirectoryRequest
-	(*DirEntry)(nil),                     // 29: DirEntry
-	(*ReadDirectoryResponse)(nil),        // 30: ReadDirectoryResponse
-	(*MakeDirectoryRequest)(nil),         // 31: MakeDirectoryRequest
-	(*MakeDirectoryResponse)(nil),        // 32: MakeDirectoryResponse
-	(*RemoveFileRequest)(nil),            // 33: RemoveFileRequest
-	(*RemoveFileResponse)(nil),           // 34: RemoveFileResponse
-	(*RenameFileRequest)(nil),            // 35: RenameFileRequest
-	(*RenameFileResponse)(nil),           // 36: RenameFileResponse
-	(*PutFileRequest)(nil),               // 37: PutFileRequest
-	(*PutFileResponse)(nil),              // 38: PutFileResponse
-	(*GetFileRequest)(nil),               // 39: GetFileRequest
-	(*GetFileResponse)(nil),              // 40: GetFileResponse
-	(*BootFileRequest)(nil),              // 41: BootFileRequest
-	(*BootFileResponse)(nil),             // 42: BootFileResponse
-	(*DevicesResponse_Device)(nil),       // 43: DevicesResponse.Device
+func (*ConditionalWriteMemoryRequest) ProtoMessage() {}
+
+func (x *ConditionalWriteMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_sni_proto_depIdxs = []int32{
-	43, // 0: DevicesResponse.devices:type_name -> DevicesResponse.Device
-	1,  // 1: DetectMemoryMappingRequest.fallbackMemoryMapping:type_name -> MemoryMapping
-	1,  // 2: DetectMemoryMappingResponse.memoryMapping:type_name -> MemoryMapping
-	0,  // 3: ReadMemoryRequest.requestAddressSpace:type_name -> AddressSpace
-	1,  // 4: ReadMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 5: ReadMemoryResponse.requestAddressSpace:type_name -> AddressSpace
-	1,  // 6: ReadMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 7: ReadMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
-	0,  // 8: WriteMemoryRequest.requestAddressSpace:type_name -> AddressSpace
-	1,  // 9: WriteMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 10: WriteMemoryResponse.requestAddressSpace:type_name -> AddressSpace
-	1,  // 11: WriteMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 12: WriteMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
-	16, // 13: SingleReadMemoryRequest.request:type_name -> ReadMemoryRequest
-	17, // 14: SingleReadMemoryResponse.response:type_name -> ReadMemoryResponse
-	18, // 15: SingleWriteMemoryRequest.request:type_name -> WriteMemoryRequest
-	19, // 16: SingleWriteMemoryResponse.response:type_name -> WriteMemoryResponse
-	16, // 17: MultiReadMemoryRequest.requests:type_name -> ReadMemoryRequest
-	17, // 18: MultiReadMemoryResponse.responses:type_name -> ReadMemoryResponse
-	18, // 19: MultiWriteMemoryRequest.requests:type_name -> WriteMemoryRequest
-	19, // 20: MultiWriteMemoryResponse.responses:type_name -> WriteMemoryResponse
-	3,  // 21: DirEntry.type:type_name -> DirEntryType
-	29, // 22: ReadDirectoryResponse.entries:type_name -> DirEntry
-	2,  // 23: DevicesResponse.Device.capabilities:type_name -> DeviceCapability
-	0,  // 24: DevicesResponse.Device.defaultAddressSpace:type_name -> AddressSpace
-	4,  // 25: Devices.ListDevices:input_type -> DevicesRequest
-	6,  // 26: DeviceControl.ResetSystem:input_type -> ResetSystemRequest
-	8,  // 27: DeviceControl.ResetToMenu:input_type -> ResetToMenuRequest
-	10, // 28: DeviceControl.PauseUnpauseEmulation:input_type -> PauseEmulationRequest
-	12, // 29: DeviceControl.PauseToggleEmulation:input_type -> PauseToggleEmulationRequest
-	14, // 30: DeviceMemory.MappingDetect:input_type -> DetectMemoryMappingRequest
-	20, // 31: DeviceMemory.SingleRead:input_type -> SingleReadMemoryRequest
-	22, // 32: DeviceMemory.SingleWrite:input_type -> SingleWriteMemoryRequest
-	24, // 33: DeviceMemory.MultiRead:input_type -> MultiReadMemoryRequest
-	26, // 34: DeviceMemory.MultiWrite:input_type -> MultiWriteMemoryRequest
-	24, // 35: DeviceMemory.StreamRead:input_type -> MultiReadMemoryRequest
-	26, // 36: DeviceMemory.StreamWrite:input_type -> MultiWriteMemoryRequest
-	28, // 37: DeviceFilesystem.ReadDirectory:input_type -> ReadDirectoryRequest
-	31, // 38: DeviceFilesystem.MakeDirectory:input_type -> MakeDirectoryRequest
-	33, // 39: DeviceFilesystem.RemoveFile:input_type -> RemoveFileRequest
-	35, // 40: DeviceFilesystem.RenameFile:input_type -> RenameFileRequest
-	37, // 41: DeviceFilesystem.PutFile:input_type -> PutFileRequest
-	39, // 42: DeviceFilesystem.GetFile:input_type -> GetFileRequest
-	41, // 43: DeviceFilesystem.BootFile:input_type -> BootFileRequest
-	5,  // 44: Devices.ListDevices:output_type -> DevicesResponse
-	7,  // 45: DeviceControl.ResetSystem:output_type -> ResetSystemResponse
-	9,  // 46: DeviceControl.ResetToMenu:output_type -> ResetToMenuResponse
-	11, // 47: DeviceControl.PauseUnpauseEmulation:output_type -> PauseEmulationResponse
-	13, // 48: DeviceControl.PauseToggleEmulation:output_type -> PauseToggleEmulationResponse
-	15, // 49: DeviceMemory.MappingDetect:output_type -> DetectMemoryMappingResponse
-	21, // 50: DeviceMemory.SingleRead:output_type -> SingleReadMemoryResponse
-	23, // 51: DeviceMemory.SingleWrite:output_type -> SingleWriteMemoryResponse
-	25, // 52: DeviceMemory.MultiRead:output_type -> MultiReadMemoryResponse
-	27, // 53: DeviceMemory.MultiWrite:output_type -> MultiWriteMemoryResponse
-	25, // 54: DeviceMemory.StreamRead:output_type -> MultiReadMemoryResponse
-	27, // 55: DeviceMemory.StreamWrite:output_type -> MultiWriteMemoryResponse
-	30, // 56: DeviceFilesystem.ReadDirectory:output_type -> ReadDirectoryResponse
-	32, // 57: DeviceFilesystem.MakeDirectory:output_type -> MakeDirectoryResponse
-	34, // 58: DeviceFilesystem.RemoveFile:output_type -> RemoveFileResponse
-	36, // 59: DeviceFilesystem.RenameFile:output_type -> RenameFileResponse
-	38, // 60: DeviceFilesystem.PutFile:output_type -> PutFileResponse
-	40, // 61: DeviceFilesystem.GetFile:output_type -> GetFileResponse
-	42, // 62: DeviceFilesystem.BootFile:output_type -> BootFileResponse
-	44, // [44:63] is the sub-list for method output_type
-	25, // [25:44] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+
+// Deprecated: Use ConditionalWriteMemoryRequest.ProtoReflect.Descriptor instead.
+func (*ConditionalWriteMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{41}
 }
 
-func init() { file_sni_proto_init() }
-func file_sni_proto_init() {
-	if File_sni_proto != nil {
-		return
+func (x *ConditionalWriteMemoryRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_sni_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DevicesRequest); i {
+	return ""
+}
+
+func (x *ConditionalWriteMemoryRequest) GetConditions() []*MemoryCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *ConditionalWriteMemoryRequest) GetWrites() []*WriteMemoryRequest {
+	if x != nil {
+		return x.Writes
+	}
+	return nil
+}
+
+type ConditionalWriteMemoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// true if all conditions matched and the writes were performed
+	Written bool `protobuf:"varint,2,opt,name=written,proto3" json:"written,omitempty"`
+	// indices into ConditionalWriteMemoryRequest.conditions of the conditions that did not match
+	FailedConditions []uint32 `protobuf:"varint,3,rep,packed,name=failedConditions,proto3" json:"failedConditions,omitempty"`
+	// responses for the writes performed, if any
+	Responses []*WriteMemoryResponse `protobuf:"bytes,4,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (x *ConditionalWriteMemoryResponse) Reset() {
+	*x = ConditionalWriteMemoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConditionalWriteMemoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConditionalWriteMemoryResponse) ProtoMessage() {}
+
+func (x *ConditionalWriteMemoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConditionalWriteMemoryResponse.ProtoReflect.Descriptor instead.
+func (*ConditionalWriteMemoryResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ConditionalWriteMemoryResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ConditionalWriteMemoryResponse) GetWritten() bool {
+	if x != nil {
+		return x.Written
+	}
+	return false
+}
+
+func (x *ConditionalWriteMemoryResponse) GetFailedConditions() []uint32 {
+	if x != nil {
+		return x.FailedConditions
+	}
+	return nil
+}
+
+func (x *ConditionalWriteMemoryResponse) GetResponses() []*WriteMemoryResponse {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+type PatchMemoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri                  string        `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,3,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	// IPS patch whose record offsets are addresses in requestAddressSpace
+	Ips []byte `protobuf:"bytes,4,opt,name=ips,proto3" json:"ips,omitempty"`
+}
+
+func (x *PatchMemoryRequest) Reset() {
+	*x = PatchMemoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchMemoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchMemoryRequest) ProtoMessage() {}
+
+func (x *PatchMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchMemoryRequest.ProtoReflect.Descriptor instead.
+func (*PatchMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PatchMemoryRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PatchMemoryRequest) GetRequestAddressSpace() AddressSpace {
+	if x != nil {
+		return x.RequestAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+func (x *PatchMemoryRequest) GetRequestMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.RequestMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *PatchMemoryRequest) GetIps() []byte {
+	if x != nil {
+		return x.Ips
+	}
+	return nil
+}
+
+type PatchMemoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// one response per IPS record written
+	Responses []*WriteMemoryResponse `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (x *PatchMemoryResponse) Reset() {
+	*x = PatchMemoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchMemoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchMemoryResponse) ProtoMessage() {}
+
+func (x *PatchMemoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchMemoryResponse.ProtoReflect.Descriptor instead.
+func (*PatchMemoryResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PatchMemoryResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PatchMemoryResponse) GetResponses() []*WriteMemoryResponse {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+type ValidateROMRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// device to read the ROM back from; not needed if data is given
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// ROM file contents to validate, with or without a copier header
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// memory mapping to read the ROM back from the device with; detected if not set
+	MemoryMapping *MemoryMapping `protobuf:"varint,3,opt,name=memoryMapping,proto3,enum=MemoryMapping,oneof" json:"memoryMapping,omitempty"`
+	// size of the ROM to read back from the device; required with `fix`. Defaults to the ROM size declared in the
+	// header, less any blank or mirrored tail if the ROM is not valid at that size
+	Size uint32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// rewrite the header's checksum and complement; the fixed file is returned in `data` or the fix is written back
+	// to the device's memory
+	Fix bool `protobuf:"varint,5,opt,name=fix,proto3" json:"fix,omitempty"`
+}
+
+func (x *ValidateROMRequest) Reset() {
+	*x = ValidateROMRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateROMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateROMRequest) ProtoMessage() {}
+
+func (x *ValidateROMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateROMRequest.ProtoReflect.Descriptor instead.
+func (*ValidateROMRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ValidateROMRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ValidateROMRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ValidateROMRequest) GetMemoryMapping() MemoryMapping {
+	if x != nil && x.MemoryMapping != nil {
+		return *x.MemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *ValidateROMRequest) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ValidateROMRequest) GetFix() bool {
+	if x != nil {
+		return x.Fix
+	}
+	return false
+}
+
+type ValidateROMResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// memory mapping whose header location scored best
+	MemoryMapping MemoryMapping `protobuf:"varint,2,opt,name=memoryMapping,proto3,enum=MemoryMapping" json:"memoryMapping,omitempty"`
+	// offset of the header (starting at $FFB0) in the ROM contents
+	HeaderOffset uint32 `protobuf:"varint,3,opt,name=headerOffset,proto3" json:"headerOffset,omitempty"`
+	// true if the ROM file has a copier header
+	CopierHeader bool `protobuf:"varint,4,opt,name=copierHeader,proto3" json:"copierHeader,omitempty"`
+	// size of the ROM contents excluding any copier header
+	Size uint32 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// checksum and complement read from the header before any fix
+	HeaderChecksum   uint32 `protobuf:"varint,6,opt,name=headerChecksum,proto3" json:"headerChecksum,omitempty"`
+	HeaderComplement uint32 `protobuf:"varint,7,opt,name=headerComplement,proto3" json:"headerComplement,omitempty"`
+	// checksum computed over the ROM contents
+	ComputedChecksum uint32 `protobuf:"varint,8,opt,name=computedChecksum,proto3" json:"computedChecksum,omitempty"`
+	// true if the header's checksum and complement matched the computed checksum
+	Valid bool `protobuf:"varint,9,opt,name=valid,proto3" json:"valid,omitempty"`
+	// fixed ROM file contents if `fix` was requested for uploaded data
+	Data []byte `protobuf:"bytes,10,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ValidateROMResponse) Reset() {
+	*x = ValidateROMResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateROMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateROMResponse) ProtoMessage() {}
+
+func (x *ValidateROMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateROMResponse.ProtoReflect.Descriptor instead.
+func (*ValidateROMResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ValidateROMResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ValidateROMResponse) GetMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.MemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *ValidateROMResponse) GetHeaderOffset() uint32 {
+	if x != nil {
+		return x.HeaderOffset
+	}
+	return 0
+}
+
+func (x *ValidateROMResponse) GetCopierHeader() bool {
+	if x != nil {
+		return x.CopierHeader
+	}
+	return false
+}
+
+func (x *ValidateROMResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ValidateROMResponse) GetHeaderChecksum() uint32 {
+	if x != nil {
+		return x.HeaderChecksum
+	}
+	return 0
+}
+
+func (x *ValidateROMResponse) GetHeaderComplement() uint32 {
+	if x != nil {
+		return x.HeaderComplement
+	}
+	return 0
+}
+
+func (x *ValidateROMResponse) GetComputedChecksum() uint32 {
+	if x != nil {
+		return x.ComputedChecksum
+	}
+	return 0
+}
+
+func (x *ValidateROMResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateROMResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ReadDirectoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *ReadDirectoryRequest) Reset() {
+	*x = ReadDirectoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDirectoryRequest) ProtoMessage() {}
+
+func (x *ReadDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*ReadDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReadDirectoryRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ReadDirectoryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type DirEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type DirEntryType `protobuf:"varint,2,opt,name=type,proto3,enum=DirEntryType" json:"type,omitempty"`
+}
+
+func (x *DirEntry) Reset() {
+	*x = DirEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DirEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirEntry) ProtoMessage() {}
+
+func (x *DirEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirEntry.ProtoReflect.Descriptor instead.
+func (*DirEntry) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DirEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DirEntry) GetType() DirEntryType {
+	if x != nil {
+		return x.Type
+	}
+	return DirEntryType_Directory
+}
+
+type ReadDirectoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri     string      `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path    string      `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Entries []*DirEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ReadDirectoryResponse) Reset() {
+	*x = ReadDirectoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDirectoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDirectoryResponse) ProtoMessage() {}
+
+func (x *ReadDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*ReadDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ReadDirectoryResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ReadDirectoryResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ReadDirectoryResponse) GetEntries() []*DirEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type MakeDirectoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *MakeDirectoryRequest) Reset() {
+	*x = MakeDirectoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MakeDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeDirectoryRequest) ProtoMessage() {}
+
+func (x *MakeDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*MakeDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MakeDirectoryRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *MakeDirectoryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type MakeDirectoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *MakeDirectoryResponse) Reset() {
+	*x = MakeDirectoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MakeDirectoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeDirectoryResponse) ProtoMessage() {}
+
+func (x *MakeDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*MakeDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MakeDirectoryResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *MakeDirectoryResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RemoveFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *RemoveFileRequest) Reset() {
+	*x = RemoveFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFileRequest) ProtoMessage() {}
+
+func (x *RemoveFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFileRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RemoveFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RemoveFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RemoveFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *RemoveFileResponse) Reset() {
+	*x = RemoveFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFileResponse) ProtoMessage() {}
+
+func (x *RemoveFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFileResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RemoveFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RemoveFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RenameFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+}
+
+func (x *RenameFileRequest) Reset() {
+	*x = RenameFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameFileRequest) ProtoMessage() {}
+
+func (x *RenameFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameFileRequest.ProtoReflect.Descriptor instead.
+func (*RenameFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RenameFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RenameFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RenameFileRequest) GetNewFilename() string {
+	if x != nil {
+		return x.NewFilename
+	}
+	return ""
+}
+
+type RenameFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+}
+
+func (x *RenameFileResponse) Reset() {
+	*x = RenameFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameFileResponse) ProtoMessage() {}
+
+func (x *RenameFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameFileResponse.ProtoReflect.Descriptor instead.
+func (*RenameFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RenameFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RenameFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RenameFileResponse) GetNewFilename() string {
+	if x != nil {
+		return x.NewFilename
+	}
+	return ""
+}
+
+type PutFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *PutFileRequest) Reset() {
+	*x = PutFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutFileRequest) ProtoMessage() {}
+
+func (x *PutFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutFileRequest.ProtoReflect.Descriptor instead.
+func (*PutFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PutFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PutFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PutFileRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PutFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *PutFileResponse) Reset() {
+	*x = PutFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutFileResponse) ProtoMessage() {}
+
+func (x *PutFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutFileResponse.ProtoReflect.Descriptor instead.
+func (*PutFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PutFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PutFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PutFileResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type PatchAndPutFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// unpatched file contents, e.g. the original ROM
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// IPS, BPS or UPS patches applied to data in order; each format is detected from its header
+	Patches [][]byte `protobuf:"bytes,4,rep,name=patches,proto3" json:"patches,omitempty"`
+}
+
+func (x *PatchAndPutFileRequest) Reset() {
+	*x = PatchAndPutFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchAndPutFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchAndPutFileRequest) ProtoMessage() {}
+
+func (x *PatchAndPutFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchAndPutFileRequest.ProtoReflect.Descriptor instead.
+func (*PatchAndPutFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PatchAndPutFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PatchAndPutFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PatchAndPutFileRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PatchAndPutFileRequest) GetPatches() [][]byte {
+	if x != nil {
+		return x.Patches
+	}
+	return nil
+}
+
+type PatchAndPutFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// size of the patched file
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// CRC32 of the patched file
+	Crc32 uint32 `protobuf:"varint,4,opt,name=crc32,proto3" json:"crc32,omitempty"`
+}
+
+func (x *PatchAndPutFileResponse) Reset() {
+	*x = PatchAndPutFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchAndPutFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchAndPutFileResponse) ProtoMessage() {}
+
+func (x *PatchAndPutFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchAndPutFileResponse.ProtoReflect.Descriptor instead.
+func (*PatchAndPutFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PatchAndPutFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PatchAndPutFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PatchAndPutFileResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PatchAndPutFileResponse) GetCrc32() uint32 {
+	if x != nil {
+		return x.Crc32
+	}
+	return 0
+}
+
+type GetFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *GetFileRequest) Reset() {
+	*x = GetFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileRequest) ProtoMessage() {}
+
+func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *GetFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetFileResponse) Reset() {
+	*x = GetFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileResponse) ProtoMessage() {}
+
+func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileResponse.ProtoReflect.Descriptor instead.
+func (*GetFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *GetFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetFileResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetFileResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PutFileStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uri, path, and size are only read from the first request:
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// total size of the file in bytes:
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// next chunk of file data:
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *PutFileStreamRequest) Reset() {
+	*x = PutFileStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutFileStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutFileStreamRequest) ProtoMessage() {}
+
+func (x *PutFileStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutFileStreamRequest.ProtoReflect.Descriptor instead.
+func (*PutFileStreamRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PutFileStreamRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PutFileStreamRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PutFileStreamRequest) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PutFileStreamRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PutFileStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// total size of the file in bytes:
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// number of bytes sent to the device so far; the last response is sent once the upload completes:
+	Sent uint32 `protobuf:"varint,4,opt,name=sent,proto3" json:"sent,omitempty"`
+}
+
+func (x *PutFileStreamResponse) Reset() {
+	*x = PutFileStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutFileStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutFileStreamResponse) ProtoMessage() {}
+
+func (x *PutFileStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutFileStreamResponse.ProtoReflect.Descriptor instead.
+func (*PutFileStreamResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PutFileStreamResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PutFileStreamResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PutFileStreamResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PutFileStreamResponse) GetSent() uint32 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+type GetFileStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *GetFileStreamRequest) Reset() {
+	*x = GetFileStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileStreamRequest) ProtoMessage() {}
+
+func (x *GetFileStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetFileStreamRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetFileStreamRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *GetFileStreamRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetFileStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// total size of the file in bytes; the first response only sets size:
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// number of bytes received so far including this chunk:
+	Received uint32 `protobuf:"varint,4,opt,name=received,proto3" json:"received,omitempty"`
+	// next chunk of file data:
+	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetFileStreamResponse) Reset() {
+	*x = GetFileStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileStreamResponse) ProtoMessage() {}
+
+func (x *GetFileStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileStreamResponse.ProtoReflect.Descriptor instead.
+func (*GetFileStreamResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetFileStreamResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *GetFileStreamResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetFileStreamResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetFileStreamResponse) GetReceived() uint32 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *GetFileStreamResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BootFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *BootFileRequest) Reset() {
+	*x = BootFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootFileRequest) ProtoMessage() {}
+
+func (x *BootFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootFileRequest.ProtoReflect.Descriptor instead.
+func (*BootFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *BootFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *BootFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type BootFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *BootFileResponse) Reset() {
+	*x = BootFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootFileResponse) ProtoMessage() {}
+
+func (x *BootFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootFileResponse.ProtoReflect.Descriptor instead.
+func (*BootFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *BootFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *BootFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ServerInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ServerInfoRequest) Reset() {
+	*x = ServerInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfoRequest) ProtoMessage() {}
+
+func (x *ServerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfoRequest.ProtoReflect.Descriptor instead.
+func (*ServerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{68}
+}
+
+type ServerInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version *ServerInfoResponse_AppVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// registered drivers in display order:
+	Drivers []*ServerInfoResponse_Driver `protobuf:"bytes,2,rep,name=drivers,proto3" json:"drivers,omitempty"`
+	// enabled listeners:
+	Listeners []*ServerInfoResponse_Listener `protobuf:"bytes,3,rep,name=listeners,proto3" json:"listeners,omitempty"`
+	// supported features; clients should ignore features they do not recognize. Current features are:
+	//   "health", "leases", "watch_devices", "watch_memory", "conditional_write", "file_streams", "patch",
+	//   "validate_rom", "grpc_web", "rest_json", "metrics", "auth", "tls"
+	Features []string `protobuf:"bytes,4,rep,name=features,proto3" json:"features,omitempty"`
+}
+
+func (x *ServerInfoResponse) Reset() {
+	*x = ServerInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfoResponse) ProtoMessage() {}
+
+func (x *ServerInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfoResponse.ProtoReflect.Descriptor instead.
+func (*ServerInfoResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ServerInfoResponse) GetVersion() *ServerInfoResponse_AppVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *ServerInfoResponse) GetDrivers() []*ServerInfoResponse_Driver {
+	if x != nil {
+		return x.Drivers
+	}
+	return nil
+}
+
+func (x *ServerInfoResponse) GetListeners() []*ServerInfoResponse_Listener {
+	if x != nil {
+		return x.Listeners
+	}
+	return nil
+}
+
+func (x *ServerInfoResponse) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type DevicesResponse_Device struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URI that describes exactly how to connect to the device, e.g.:
+	// RetroArch:  "ra://127.0.0.1:55355"
+	// FX Pak Pro: "fxpakpro://./dev/cu.usbmodemDEMO000000001" (MacOS)
+	//             "fxpakpro://./COM4"                         (Windows)
+	//             "fxpakpro://./dev/ttyACM0"                  (Linux)
+	// uri is used as the unique identifier of the device for clients to refer to
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// friendly display name of the device
+	DisplayName string `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	// device kind, e.g. "fxpakpro", "retroarch", "lua"
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// all device capabilities:
+	Capabilities []DeviceCapability `protobuf:"varint,4,rep,packed,name=capabilities,proto3,enum=DeviceCapability" json:"capabilities,omitempty"`
+	// default address space for the device:
+	DefaultAddressSpace AddressSpace `protobuf:"varint,5,opt,name=defaultAddressSpace,proto3,enum=AddressSpace" json:"defaultAddressSpace,omitempty"`
+}
+
+func (x *DevicesResponse_Device) Reset() {
+	*x = DevicesResponse_Device{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DevicesResponse_Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DevicesResponse_Device) ProtoMessage() {}
+
+func (x *DevicesResponse_Device) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DevicesResponse_Device.ProtoReflect.Descriptor instead.
+func (*DevicesResponse_Device) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *DevicesResponse_Device) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *DevicesResponse_Device) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *DevicesResponse_Device) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DevicesResponse_Device) GetCapabilities() []DeviceCapability {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *DevicesResponse_Device) GetDefaultAddressSpace() AddressSpace {
+	if x != nil {
+		return x.DefaultAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+type WatchDevicesResponse_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   DeviceEventType         `protobuf:"varint,1,opt,name=type,proto3,enum=DeviceEventType" json:"type,omitempty"`
+	Device *DevicesResponse_Device `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+}
+
+func (x *WatchDevicesResponse_Event) Reset() {
+	*x = WatchDevicesResponse_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchDevicesResponse_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDevicesResponse_Event) ProtoMessage() {}
+
+func (x *WatchDevicesResponse_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDevicesResponse_Event.ProtoReflect.Descriptor instead.
+func (*WatchDevicesResponse_Event) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *WatchDevicesResponse_Event) GetType() DeviceEventType {
+	if x != nil {
+		return x.Type
+	}
+	return DeviceEventType_DeviceAdded
+}
+
+func (x *WatchDevicesResponse_Event) GetDevice() *DevicesResponse_Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+type FieldsResponse_Value struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field Field  `protobuf:"varint,1,opt,name=field,proto3,enum=Field" json:"field,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// set if the device cannot supply a value for the field
+	Unsupported bool `protobuf:"varint,3,opt,name=unsupported,proto3" json:"unsupported,omitempty"`
+}
+
+func (x *FieldsResponse_Value) Reset() {
+	*x = FieldsResponse_Value{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldsResponse_Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldsResponse_Value) ProtoMessage() {}
+
+func (x *FieldsResponse_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldsResponse_Value.ProtoReflect.Descriptor instead.
+func (*FieldsResponse_Value) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{39, 0}
+}
+
+func (x *FieldsResponse_Value) GetField() Field {
+	if x != nil {
+		return x.Field
+	}
+	return Field_DeviceName
+}
+
+func (x *FieldsResponse_Value) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FieldsResponse_Value) GetUnsupported() bool {
+	if x != nil {
+		return x.Unsupported
+	}
+	return false
+}
+
+type ServerInfoResponse_AppVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Commit  string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Date    string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	BuiltBy string `protobuf:"bytes,4,opt,name=builtBy,proto3" json:"builtBy,omitempty"`
+}
+
+func (x *ServerInfoResponse_AppVersion) Reset() {
+	*x = ServerInfoResponse_AppVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerInfoResponse_AppVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfoResponse_AppVersion) ProtoMessage() {}
+
+func (x *ServerInfoResponse_AppVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfoResponse_AppVersion.ProtoReflect.Descriptor instead.
+func (*ServerInfoResponse_AppVersion) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{69, 0}
+}
+
+func (x *ServerInfoResponse_AppVersion) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse_AppVersion) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse_AppVersion) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse_AppVersion) GetBuiltBy() string {
+	if x != nil {
+		return x.BuiltBy
+	}
+	return ""
+}
+
+type ServerInfoResponse_Driver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name the driver is registered by; this is the URI scheme of its devices, e.g. "fxpakpro", "ra"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// device kind, e.g. "fxpakpro", "retroarch", "lua"
+	Kind               string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	DisplayName        string `protobuf:"bytes,3,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	DisplayDescription string `protobuf:"bytes,4,opt,name=displayDescription,proto3" json:"displayDescription,omitempty"`
+	DisplayOrder       int32  `protobuf:"varint,5,opt,name=displayOrder,proto3" json:"displayOrder,omitempty"`
+}
+
+func (x *ServerInfoResponse_Driver) Reset() {
+	*x = ServerInfoResponse_Driver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerInfoResponse_Driver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfoResponse_Driver) ProtoMessage() {}
+
+func (x *ServerInfoResponse_Driver) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfoResponse_Driver.ProtoReflect.Descriptor instead.
+func (*ServerInfoResponse_Driver) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{69, 1}
+}
+
+func (x *ServerInfoResponse_Driver) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse_Driver) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse_Driver) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse_Driver) GetDisplayDescription() string {
+	if x != nil {
+		return x.DisplayDescription
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse_Driver) GetDisplayOrder() int32 {
+	if x != nil {
+		return x.DisplayOrder
+	}
+	return 0
+}
+
+type ServerInfoResponse_Listener struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// protocol served, e.g. "grpc", "grpcweb", "metrics", "usb2snes"
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// host:port listened on
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// whether connections must use TLS
+	Tls bool `protobuf:"varint,3,opt,name=tls,proto3" json:"tls,omitempty"`
+}
+
+func (x *ServerInfoResponse_Listener) Reset() {
+	*x = ServerInfoResponse_Listener{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerInfoResponse_Listener) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfoResponse_Listener) ProtoMessage() {}
+
+func (x *ServerInfoResponse_Listener) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfoResponse_Listener.ProtoReflect.Descriptor instead.
+func (*ServerInfoResponse_Listener) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{69, 2}
+}
+
+func (x *ServerInfoResponse_Listener) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse_Listener) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse_Listener) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+var File_sni_proto protoreflect.FileDescriptor
+
+var file_sni_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x73, 0x6e, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x0e, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x69,
+	0x6e, 0x64, 0x73, 0x22, 0x8f, 0x02, 0x0a, 0x0f, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0xc8, 0x01, 0x0a, 0x06, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x35, 0x0a,
+	0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x22, 0x2b, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x69, 0x6e,
+	0x64, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x1a, 0x5e, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x2f, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x22, 0x5b, 0x0a, 0x13, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x32, 0x0a, 0x14, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x76, 0x0a,
+	0x14, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49,
+	0x64, 0x12, 0x32, 0x0a, 0x14, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x14, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x73, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x18, 0x0a, 0x07,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x74, 0x0a, 0x12, 0x52, 0x65,
+	0x6e, 0x65, 0x77, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x14,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x41, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x49, 0x64, 0x22, 0x28, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x26, 0x0a,
+	0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x27, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x26,
+	0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x27, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54,
+	0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22,
+	0x41, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x22, 0x42, 0x0a, 0x16, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x2f, 0x0a, 0x1b, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x30, 0x0a, 0x1c, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x4d, 0x0a, 0x11, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x41, 0x53, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x26, 0x0a, 0x12, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x41, 0x53, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x22, 0xd6, 0x01, 0x0a, 0x1a, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x49, 0x0a, 0x15, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x48, 0x00, 0x52, 0x15, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f,
+	0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f,
+	0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0xaf, 0x01, 0x0a, 0x1b, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x34, 0x0a, 0x0d, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30,
+	0x46, 0x46, 0x42, 0x30, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x72, 0x6f, 0x6d, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0xd4, 0x01, 0x0a, 0x11,
+	0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0xba, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x12,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xd5, 0x01, 0x0a, 0x12, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f,
+	0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbb, 0x02, 0x0a, 0x13, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x3d, 0x0a, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x12, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x59, 0x0a, 0x17, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52,
+	0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x5d, 0x0a, 0x18, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2f,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x5b, 0x0a, 0x18, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2d, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5f, 0x0a, 0x19,
+	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x30, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x0a,
+	0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x5e, 0x0a, 0x17, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x17, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x60, 0x0a, 0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x7e, 0x0a, 0x12, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x0a, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x44, 0x69, 0x66, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0xe0, 0x02, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x24,
+	0x0a, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x44, 0x69, 0x66, 0x66, 0x52,
+	0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x22, 0x57, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
+	0x2e, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22,
+	0x41, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x12, 0x1e, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x5d, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1c, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x73, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0xee, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0x90, 0x01, 0x0a, 0x1d, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x06,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x1e, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x18,
+	0x0a, 0x07, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x12, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x69, 0x70, 0x73, 0x22, 0x5b, 0x0a, 0x13, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x4f, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x39, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03,
+	0x66, 0x69, 0x78, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x22, 0xe3, 0x02, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x4f, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
+	0x34, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x70,
+	0x69, 0x65, 0x72, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x72, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
+	0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
+	0x6d, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3c, 0x0a, 0x14, 0x52,
+	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x41, 0x0a, 0x08, 0x44, 0x69, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x62, 0x0a, 0x15,
+	0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x44,
+	0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x3c, 0x0a, 0x14, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3d,
+	0x0a, 0x15, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x39, 0x0a,
+	0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3a, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x22, 0x5b, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x5c, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a,
+	0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x4a, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x0f, 0x50,
+	0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x6c, 0x0a, 0x16, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x41, 0x6e, 0x64, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x69, 0x0a, 0x17, 0x50, 0x61, 0x74, 0x63, 0x68, 0x41,
+	0x6e, 0x64, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x72, 0x63, 0x33, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x72, 0x63, 0x33,
+	0x32, 0x22, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x5f, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x64, 0x0a, 0x14, 0x50, 0x75,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x65, 0x0a, 0x15, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x22, 0x3c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x81, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x37, 0x0a, 0x0f, 0x42, 0x6f, 0x6f,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x22, 0x38, 0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x13, 0x0a, 0x11,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xc7, 0x04, 0x0a, 0x12, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41,
+	0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52,
+	0x07, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x1a, 0x6c, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x42, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x42, 0x79, 0x1a, 0xa6,
+	0x01, 0x0a, 0x06, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x52, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6c, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x2a, 0x33, 0x0a, 0x0c, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x46,
+	0x78, 0x50, 0x61, 0x6b, 0x50, 0x72, 0x6f, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x6e, 0x65,
+	0x73, 0x41, 0x42, 0x75, 0x73, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x61, 0x77, 0x10, 0x02,
+	0x2a, 0x82, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x48, 0x69, 0x52, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x6f,
+	0x52, 0x4f, 0x4d, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x78, 0x48, 0x69, 0x52, 0x4f, 0x4d,
+	0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x53, 0x58, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x53,
+	0x41, 0x31, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x78, 0x4c, 0x6f, 0x52, 0x4f, 0x4d, 0x10,
+	0x06, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x75, 0x70, 0x65, 0x72, 0x46, 0x58, 0x10, 0x07, 0x12, 0x08,
+	0x0a, 0x04, 0x53, 0x44, 0x44, 0x31, 0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x50, 0x43, 0x37,
+	0x31, 0x31, 0x30, 0x10, 0x09, 0x2a, 0xa3, 0x02, 0x0a, 0x10, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f,
+	0x6e, 0x65, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x41, 0x53, 0x4d, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x55,
+	0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10,
+	0x05, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x10, 0x08, 0x12, 0x11, 0x0a,
+	0x0d, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10, 0x0a,
+	0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x10, 0x0b, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x10, 0x0c, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x10, 0x0d, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x0e,
+	0x12, 0x0b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x0f, 0x12, 0x0c, 0x0a,
+	0x08, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x10, 0x2a, 0x69, 0x0a, 0x05, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x6f, 0x72,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x6f, 0x6d, 0x46, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x6f, 0x6d, 0x43,
+	0x52, 0x43, 0x33, 0x32, 0x10, 0x05, 0x2a, 0x48, 0x0a, 0x0f, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x65, 0x64, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x10, 0x01, 0x12, 0x11, 0x0a,
+	0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x10, 0x02,
+	0x2a, 0x27, 0x0a, 0x0c, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0d, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x01, 0x32, 0x7e, 0x0a, 0x07, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x32, 0xc4, 0x01, 0x0a, 0x0b, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x41, 0x63, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x14, 0x2e, 0x41, 0x63, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x65,
+	0x77, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x12, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6e,
+	0x65, 0x77, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x12, 0x14, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x32, 0xe3, 0x02, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a,
+	0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x13, 0x2e,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x15, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a,
+	0x0a, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x53, 0x4d, 0x12, 0x12, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x53, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x53, 0x4d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x8e, 0x06, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x4c, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52,
+	0x65, 0x61, 0x64, 0x12, 0x18, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x53, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x53, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x12,
+	0x17, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x12, 0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52,
+	0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01,
+	0x12, 0x48, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12,
+	0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x0b, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x13, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x55, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3a, 0x0a, 0x0b, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x13,
+	0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x4f, 0x4d, 0x12, 0x13, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x4f, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x4f, 0x4d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x3e, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a, 0x0b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x0e, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xed, 0x04, 0x0a, 0x10, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x40, 0x0a, 0x0d,
+	0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x15, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e,
+	0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x50, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x50, 0x75,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6e, 0x64,
+	0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6e, 0x64, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x50, 0x75,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x15, 0x2e, 0x50, 0x75,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01,
+	0x12, 0x42, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x10, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x41, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x37, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x12, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x22, 0x5a, 0x20, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x74, 0x70, 0x6f, 0x2f,
+	0x73, 0x6e, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x6e, 0x69, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sni_proto_rawDescOnce sync.Once
+	file_sni_proto_rawDescData = file_sni_proto_rawDesc
+)
+
+func file_sni_proto_rawDescGZIP() []byte {
+	file_sni_proto_rawDescOnce.Do(func() {
+		file_sni_proto_rawDescData = protoimpl.X.CompressGZIP(file_sni_proto_rawDescData)
+	})
+	return file_sni_proto_rawDescData
+}
+
+var file_sni_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_sni_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
+var file_sni_proto_goTypes = []interface{}{
+	(AddressSpace)(0),                      // 0: AddressSpace
+	(MemoryMapping)(0),                     // 1: MemoryMapping
+	(DeviceCapability)(0),                  // 2: DeviceCapability
+	(Field)(0),                             // 3: Field
+	(DeviceEventType)(0),                   // 4: DeviceEventType
+	(DirEntryType)(0),                      // 5: DirEntryType
+	(*DevicesRequest)(nil),                 // 6: DevicesRequest
+	(*DevicesResponse)(nil),                // 7: DevicesResponse
+	(*WatchDevicesRequest)(nil),            // 8: WatchDevicesRequest
+	(*WatchDevicesResponse)(nil),           // 9: WatchDevicesResponse
+	(*AcquireLeaseRequest)(nil),            // 10: AcquireLeaseRequest
+	(*AcquireLeaseResponse)(nil),           // 11: AcquireLeaseResponse
+	(*RenewLeaseRequest)(nil),              // 12: RenewLeaseRequest
+	(*RenewLeaseResponse)(nil),             // 13: RenewLeaseResponse
+	(*ReleaseLeaseRequest)(nil),            // 14: ReleaseLeaseRequest
+	(*ReleaseLeaseResponse)(nil),           // 15: ReleaseLeaseResponse
+	(*ResetSystemRequest)(nil),             // 16: ResetSystemRequest
+	(*ResetSystemResponse)(nil),            // 17: ResetSystemResponse
+	(*ResetToMenuRequest)(nil),             // 18: ResetToMenuRequest
+	(*ResetToMenuResponse)(nil),            // 19: ResetToMenuResponse
+	(*PauseEmulationRequest)(nil),          // 20: PauseEmulationRequest
+	(*PauseEmulationResponse)(nil),         // 21: PauseEmulationResponse
+	(*PauseToggleEmulationRequest)(nil),    // 22: PauseToggleEmulationRequest
+	(*PauseToggleEmulationResponse)(nil),   // 23: PauseToggleEmulationResponse
+	(*ExecuteASMRequest)(nil),              // 24: ExecuteASMRequest
+	(*ExecuteASMResponse)(nil),             // 25: ExecuteASMResponse
+	(*DetectMemoryMappingRequest)(nil),     // 26: DetectMemoryMappingRequest
+	(*DetectMemoryMappingResponse)(nil),    // 27: DetectMemoryMappingResponse
+	(*ReadMemoryRequest)(nil),              // 28: ReadMemoryRequest
+	(*ReadMemoryResponse)(nil),             // 29: ReadMemoryResponse
+	(*WriteMemoryRequest)(nil),             // 30: WriteMemoryRequest
+	(*WriteMemoryResponse)(nil),            // 31: WriteMemoryResponse
+	(*SingleReadMemoryRequest)(nil),        // 32: SingleReadMemoryRequest
+	(*SingleReadMemoryResponse)(nil),       // 33: SingleReadMemoryResponse
+	(*SingleWriteMemoryRequest)(nil),       // 34: SingleWriteMemoryRequest
+	(*SingleWriteMemoryResponse)(nil),      // 35: SingleWriteMemoryResponse
+	(*MultiReadMemoryRequest)(nil),         // 36: MultiReadMemoryRequest
+	(*MultiReadMemoryResponse)(nil),        // 37: MultiReadMemoryResponse
+	(*MultiWriteMemoryRequest)(nil),        // 38: MultiWriteMemoryRequest
+	(*MultiWriteMemoryResponse)(nil),       // 39: MultiWriteMemoryResponse
+	(*WatchMemoryRequest)(nil),             // 40: WatchMemoryRequest
+	(*MemoryDiff)(nil),                     // 41: MemoryDiff
+	(*WatchedMemoryChange)(nil),            // 42: WatchedMemoryChange
+	(*WatchMemoryResponse)(nil),            // 43: WatchMemoryResponse
+	(*FieldsRequest)(nil),                  // 44: FieldsRequest
+	(*FieldsResponse)(nil),                 // 45: FieldsResponse
+	(*MemoryCondition)(nil),                // 46: MemoryCondition
+	(*ConditionalWriteMemoryRequest)(nil),  // 47: ConditionalWriteMemoryRequest
+	(*ConditionalWriteMemoryResponse)(nil), // 48: ConditionalWriteMemoryResponse
+	(*PatchMemoryRequest)(nil),             // 49: PatchMemoryRequest
+	(*PatchMemoryResponse)(nil),            // 50: PatchMemoryResponse
+	(*ValidateROMRequest)(nil),             // 51: ValidateROMRequest
+	(*ValidateROMResponse)(nil),            // 52: ValidateROMResponse
+	(*ReadDirectoryRequest)(nil),           // 53: ReadDirectoryRequest
+	(*DirEntry)(nil),                       // 54: DirEntry
+	(*ReadDirectoryResponse)(nil),          // 55: ReadDirectoryResponse
+	(*MakeDirectoryRequest)(nil),           // 56: MakeDirectoryRequest
+	(*MakeDirectoryResponse)(nil),          // 57: MakeDirectoryResponse
+	(*RemoveFileRequest)(nil),              // 58: RemoveFileRequest
+	(*RemoveFileResponse)(nil),             // 59: RemoveFileResponse
+	(*RenameFileRequest)(nil),              // 60: RenameFileRequest
+	(*RenameFileResponse)(nil),             // 61: RenameFileResponse
+	(*PutFileRequest)(nil),                 // 62: PutFileRequest
+	(*PutFileResponse)(nil),                // 63: PutFileResponse
+	(*PatchAndPutFileRequest)(nil),         // 64: PatchAndPutFileRequest
+	(*PatchAndPutFileResponse)(nil),        // 65: PatchAndPutFileResponse
+	(*GetFileRequest)(nil),                 // 66: GetFileRequest
+	(*GetFileResponse)(nil),                // 67: GetFileResponse
+	(*PutFileStreamRequest)(nil),           // 68: PutFileStreamRequest
+	(*PutFileStreamResponse)(nil),          // 69: PutFileStreamResponse
+	(*GetFileStreamRequest)(nil),           // 70: GetFileStreamRequest
+	(*GetFileStreamResponse)(nil),          // 71: GetFileStreamResponse
+	(*BootFileRequest)(nil),                // 72: BootFileRequest
+	(*BootFileResponse)(nil),               // 73: BootFileResponse
+	(*ServerInfoRequest)(nil),              // 74: ServerInfoRequest
+	(*ServerInfoResponse)(nil),             // 75: ServerInfoResponse
+	(*DevicesResponse_Device)(nil),         // 76: DevicesResponse.Device
+	(*WatchDevicesResponse_Event)(nil),     // 77: WatchDevicesResponse.Event
+	(*FieldsResponse_Value)(nil),           // 78: FieldsResponse.Value
+	(*ServerInfoResponse_AppVersion)(nil),  // 79: ServerInfoResponse.AppVersion
+	(*ServerInfoResponse_Driver)(nil),      // 80: ServerInfoResponse.Driver
+	(*ServerInfoResponse_Listener)(nil),    // 81: ServerInfoResponse.Listener
+}
+var file_sni_proto_depIdxs = []int32{
+	76, // 0: DevicesResponse.devices:type_name -> DevicesResponse.Device
+	77, // 1: WatchDevicesResponse.events:type_name -> WatchDevicesResponse.Event
+	1,  // 2: DetectMemoryMappingRequest.fallbackMemoryMapping:type_name -> MemoryMapping
+	1,  // 3: DetectMemoryMappingResponse.memoryMapping:type_name -> MemoryMapping
+	0,  // 4: ReadMemoryRequest.requestAddressSpace:type_name -> AddressSpace
+	1,  // 5: ReadMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
+	0,  // 6: ReadMemoryResponse.requestAddressSpace:type_name -> AddressSpace
+	1,  // 7: ReadMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
+	0,  // 8: ReadMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
+	0,  // 9: WriteMemoryRequest.requestAddressSpace:type_name -> AddressSpace
+	1,  // 10: WriteMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
+	0,  // 11: WriteMemoryResponse.requestAddressSpace:type_name -> AddressSpace
+	1,  // 12: WriteMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
+	0,  // 13: WriteMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
+	28, // 14: SingleReadMemoryRequest.request:type_name -> ReadMemoryRequest
+	29, // 15: SingleReadMemoryResponse.response:type_name -> ReadMemoryResponse
+	30, // 16: SingleWriteMemoryRequest.request:type_name -> WriteMemoryRequest
+	31, // 17: SingleWriteMemoryResponse.response:type_name -> WriteMemoryResponse
+	28, // 18: MultiReadMemoryRequest.requests:type_name -> ReadMemoryRequest
+	29, // 19: MultiReadMemoryResponse.responses:type_name -> ReadMemoryResponse
+	30, // 20: MultiWriteMemoryRequest.requests:type_name -> WriteMemoryRequest
+	31, // 21: MultiWriteMemoryResponse.responses:type_name -> WriteMemoryResponse
+	28, // 22: WatchMemoryRequest.requests:type_name -> ReadMemoryRequest
+	0,  // 23: WatchedMemoryChange.requestAddressSpace:type_name -> AddressSpace
+	1,  // 24: WatchedMemoryChange.requestMemoryMapping:type_name -> MemoryMapping
+	0,  // 25: WatchedMemoryChange.deviceAddressSpace:type_name -> AddressSpace
+	41, // 26: WatchedMemoryChange.diffs:type_name -> MemoryDiff
+	42, // 27: WatchMemoryResponse.changes:type_name -> WatchedMemoryChange
+	3,  // 28: FieldsRequest.fields:type_name -> Field
+	78, // 29: FieldsResponse.values:type_name -> FieldsResponse.Value
+	0,  // 30: MemoryCondition.requestAddressSpace:type_name -> AddressSpace
+	1,  // 31: MemoryCondition.requestMemoryMapping:type_name -> MemoryMapping
+	46, // 32: ConditionalWriteMemoryRequest.conditions:type_name -> MemoryCondition
+	30, // 33: ConditionalWriteMemoryRequest.writes:type_name -> WriteMemoryRequest
+	31, // 34: ConditionalWriteMemoryResponse.responses:type_name -> WriteMemoryResponse
+	0,  // 35: PatchMemoryRequest.requestAddressSpace:type_name -> AddressSpace
+	1,  // 36: PatchMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
+	31, // 37: PatchMemoryResponse.responses:type_name -> WriteMemoryResponse
+	1,  // 38: ValidateROMRequest.memoryMapping:type_name -> MemoryMapping
+	1,  // 39: ValidateROMResponse.memoryMapping:type_name -> MemoryMapping
+	5,  // 40: DirEntry.type:type_name -> DirEntryType
+	54, // 41: ReadDirectoryResponse.entries:type_name -> DirEntry
+	79, // 42: ServerInfoResponse.version:type_name -> ServerInfoResponse.AppVersion
+	80, // 43: ServerInfoResponse.drivers:type_name -> ServerInfoResponse.Driver
+	81, // 44: ServerInfoResponse.listeners:type_name -> ServerInfoResponse.Listener
+	2,  // 45: DevicesResponse.Device.capabilities:type_name -> DeviceCapability
+	0,  // 46: DevicesResponse.Device.defaultAddressSpace:type_name -> AddressSpace
+	4,  // 47: WatchDevicesResponse.Event.type:type_name -> DeviceEventType
+	76, // 48: WatchDevicesResponse.Event.device:type_name -> DevicesResponse.Device
+	3,  // 49: FieldsResponse.Value.field:type_name -> Field
+	6,  // 50: Devices.ListDevices:input_type -> DevicesRequest
+	8,  // 51: Devices.WatchDevices:input_type -> WatchDevicesRequest
+	10, // 52: DeviceLease.AcquireLease:input_type -> AcquireLeaseRequest
+	12, // 53: DeviceLease.RenewLease:input_type -> RenewLeaseRequest
+	14, // 54: DeviceLease.ReleaseLease:input_type -> ReleaseLeaseRequest
+	16, // 55: DeviceControl.ResetSystem:input_type -> ResetSystemRequest
+	18, // 56: DeviceControl.ResetToMenu:input_type -> ResetToMenuRequest
+	20, // 57: DeviceControl.PauseUnpauseEmulation:input_type -> PauseEmulationRequest
+	22, // 58: DeviceControl.PauseToggleEmulation:input_type -> PauseToggleEmulationRequest
+	24, // 59: DeviceControl.ExecuteASM:input_type -> ExecuteASMRequest
+	26, // 60: DeviceMemory.MappingDetect:input_type -> DetectMemoryMappingRequest
+	32, // 61: DeviceMemory.SingleRead:input_type -> SingleReadMemoryRequest
+	34, // 62: DeviceMemory.SingleWrite:input_type -> SingleWriteMemoryRequest
+	36, // 63: DeviceMemory.MultiRead:input_type -> MultiReadMemoryRequest
+	38, // 64: DeviceMemory.MultiWrite:input_type -> MultiWriteMemoryRequest
+	36, // 65: DeviceMemory.StreamRead:input_type -> MultiReadMemoryRequest
+	38, // 66: DeviceMemory.StreamWrite:input_type -> MultiWriteMemoryRequest
+	40, // 67: DeviceMemory.WatchMemory:input_type -> WatchMemoryRequest
+	47, // 68: DeviceMemory.ConditionalWrite:input_type -> ConditionalWriteMemoryRequest
+	49, // 69: DeviceMemory.PatchMemory:input_type -> PatchMemoryRequest
+	51, // 70: DeviceMemory.ValidateROM:input_type -> ValidateROMRequest
+	44, // 71: DeviceInfo.FetchFields:input_type -> FieldsRequest
+	53, // 72: DeviceFilesystem.ReadDirectory:input_type -> ReadDirectoryRequest
+	56, // 73: DeviceFilesystem.MakeDirectory:input_type -> MakeDirectoryRequest
+	58, // 74: DeviceFilesystem.RemoveFile:input_type -> RemoveFileRequest
+	60, // 75: DeviceFilesystem.RenameFile:input_type -> RenameFileRequest
+	62, // 76: DeviceFilesystem.PutFile:input_type -> PutFileRequest
+	66, // 77: DeviceFilesystem.GetFile:input_type -> GetFileRequest
+	64, // 78: DeviceFilesystem.PatchAndPutFile:input_type -> PatchAndPutFileRequest
+	68, // 79: DeviceFilesystem.PutFileStream:input_type -> PutFileStreamRequest
+	70, // 80: DeviceFilesystem.GetFileStream:input_type -> GetFileStreamRequest
+	72, // 81: DeviceFilesystem.BootFile:input_type -> BootFileRequest
+	74, // 82: Server.ServerInfo:input_type -> ServerInfoRequest
+	7,  // 83: Devices.ListDevices:output_type -> DevicesResponse
+	9,  // 84: Devices.WatchDevices:output_type -> WatchDevicesResponse
+	11, // 85: DeviceLease.AcquireLease:output_type -> AcquireLeaseResponse
+	13, // 86: DeviceLease.RenewLease:output_type -> RenewLeaseResponse
+	15, // 87: DeviceLease.ReleaseLease:output_type -> ReleaseLeaseResponse
+	17, // 88: DeviceControl.ResetSystem:output_type -> ResetSystemResponse
+	19, // 89: DeviceControl.ResetToMenu:output_type -> ResetToMenuResponse
+	21, // 90: DeviceControl.PauseUnpauseEmulation:output_type -> PauseEmulationResponse
+	23, // 91: DeviceControl.PauseToggleEmulation:output_type -> PauseToggleEmulationResponse
+	25, // 92: DeviceControl.ExecuteASM:output_type -> ExecuteASMResponse
+	27, // 93: DeviceMemory.MappingDetect:output_type -> DetectMemoryMappingResponse
+	33, // 94: DeviceMemory.SingleRead:output_type -> SingleReadMemoryResponse
+	35, // 95: DeviceMemory.SingleWrite:output_type -> SingleWriteMemoryResponse
+	37, // 96: DeviceMemory.MultiRead:output_type -> MultiReadMemoryResponse
+	39, // 97: DeviceMemory.MultiWrite:output_type -> MultiWriteMemoryResponse
+	37, // 98: DeviceMemory.StreamRead:output_type -> MultiReadMemoryResponse
+	39, // 99: DeviceMemory.StreamWrite:output_type -> MultiWriteMemoryResponse
+	43, // 100: DeviceMemory.WatchMemory:output_type -> WatchMemoryResponse
+	48, // 101: DeviceMemory.ConditionalWrite:output_type -> ConditionalWriteMemoryResponse
+	50, // 102: DeviceMemory.PatchMemory:output_type -> PatchMemoryResponse
+	52, // 103: DeviceMemory.ValidateROM:output_type -> ValidateROMResponse
+	45, // 104: DeviceInfo.FetchFields:output_type -> FieldsResponse
+	55, // 105: DeviceFilesystem.ReadDirectory:output_type -> ReadDirectoryResponse
+	57, // 106: DeviceFilesystem.MakeDirectory:output_type -> MakeDirectoryResponse
+	59, // 107: DeviceFilesystem.RemoveFile:output_type -> RemoveFileResponse
+	61, // 108: DeviceFilesystem.RenameFile:output_type -> RenameFileResponse
+	63, // 109: DeviceFilesystem.PutFile:output_type -> PutFileResponse
+	67, // 110: DeviceFilesystem.GetFile:output_type -> GetFileResponse
+	65, // 111: DeviceFilesystem.PatchAndPutFile:output_type -> PatchAndPutFileResponse
+	69, // 112: DeviceFilesystem.PutFileStream:output_type -> PutFileStreamResponse
+	71, // 113: DeviceFilesystem.GetFileStream:output_type -> GetFileStreamResponse
+	73, // 114: DeviceFilesystem.BootFile:output_type -> BootFileResponse
+	75, // 115: Server.ServerInfo:output_type -> ServerInfoResponse
+	83, // [83:116] is the sub-list for method output_type
+	50, // [50:83] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
+}
+
+func init() { file_sni_proto_init() }
+func file_sni_proto_init() {
+	if File_sni_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sni_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DevicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchDevicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchDevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireLeaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewLeaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseLeaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetSystemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetSystemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetToMenuRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetToMenuResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseEmulationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseEmulationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseToggleEmulationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseToggleEmulationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteASMRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteASMResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectMemoryMappingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3142,8 +6331,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DevicesResponse); i {
+		file_sni_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectMemoryMappingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3154,8 +6343,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetSystemRequest); i {
+		file_sni_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadMemoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3166,8 +6355,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetSystemResponse); i {
+		file_sni_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadMemoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3178,8 +6367,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetToMenuRequest); i {
+		file_sni_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteMemoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3190,8 +6379,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetToMenuResponse); i {
+		file_sni_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteMemoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3202,8 +6391,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseEmulationRequest); i {
+		file_sni_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleReadMemoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3214,8 +6403,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseEmulationResponse); i {
+		file_sni_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleReadMemoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3226,8 +6415,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseToggleEmulationRequest); i {
+		file_sni_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleWriteMemoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3238,8 +6427,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseToggleEmulationResponse); i {
+		file_sni_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleWriteMemoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3250,8 +6439,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetectMemoryMappingRequest); i {
+		file_sni_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiReadMemoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3262,8 +6451,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetectMemoryMappingResponse); i {
+		file_sni_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiReadMemoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3274,8 +6463,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadMemoryRequest); i {
+		file_sni_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiWriteMemoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3286,8 +6475,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadMemoryResponse); i {
+		file_sni_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiWriteMemoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3298,8 +6487,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteMemoryRequest); i {
+		file_sni_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchMemoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3310,8 +6499,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteMemoryResponse); i {
+		file_sni_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryDiff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3322,8 +6511,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleReadMemoryRequest); i {
+		file_sni_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchedMemoryChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3334,8 +6523,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleReadMemoryResponse); i {
+		file_sni_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchMemoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3346,8 +6535,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleWriteMemoryRequest); i {
+		file_sni_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3358,8 +6547,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleWriteMemoryResponse); i {
+		file_sni_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3370,8 +6559,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiReadMemoryRequest); i {
+		file_sni_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryCondition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3382,8 +6571,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiReadMemoryResponse); i {
+		file_sni_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConditionalWriteMemoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3394,8 +6583,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiWriteMemoryRequest); i {
+		file_sni_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConditionalWriteMemoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3406,8 +6595,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiWriteMemoryResponse); i {
+		file_sni_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchMemoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3418,7 +6607,43 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateROMRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateROMResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReadDirectoryRequest); i {
 			case 0:
 				return &v.state
@@ -3430,7 +6655,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DirEntry); i {
 			case 0:
 				return &v.state
@@ -3442,7 +6667,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReadDirectoryResponse); i {
 			case 0:
 				return &v.state
@@ -3454,7 +6679,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MakeDirectoryRequest); i {
 			case 0:
 				return &v.state
@@ -3466,7 +6691,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MakeDirectoryResponse); i {
 			case 0:
 				return &v.state
@@ -3478,7 +6703,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveFileRequest); i {
 			case 0:
 				return &v.state
@@ -3490,7 +6715,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveFileResponse); i {
 			case 0:
 				return &v.state
@@ -3502,7 +6727,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RenameFileRequest); i {
 			case 0:
 				return &v.state
@@ -3514,7 +6739,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RenameFileResponse); i {
 			case 0:
 				return &v.state
@@ -3526,7 +6751,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PutFileRequest); i {
 			case 0:
 				return &v.state
@@ -3538,7 +6763,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PutFileResponse); i {
 			case 0:
 				return &v.state
@@ -3550,7 +6775,31 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchAndPutFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchAndPutFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetFileRequest); i {
 			case 0:
 				return &v.state
@@ -3562,7 +6811,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetFileResponse); i {
 			case 0:
 				return &v.state
@@ -3574,7 +6823,55 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutFileStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutFileStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BootFileRequest); i {
 			case 0:
 				return &v.state
@@ -3586,7 +6883,7 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BootFileResponse); i {
 			case 0:
 				return &v.state
@@ -3598,7 +6895,31 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DevicesResponse_Device); i {
 			case 0:
 				return &v.state
@@ -3610,17 +6931,78 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
+		file_sni_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchDevicesResponse_Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldsResponse_Value); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerInfoResponse_AppVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerInfoResponse_Driver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerInfoResponse_Listener); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_sni_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[45].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sni_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   40,
+			NumEnums:      6,
+			NumMessages:   76,
 			NumExtensions: 0,
-			NumServices:   4,
+			NumServices:   7,
 		},
 		GoTypes:           file_sni_proto_goTypes,
 		DependencyIndexes: file_sni_proto_depIdxs,
@@ -20,6 +20,8 @@ const _ = grpc.SupportPackageIsVersion7
 type DevicesClient interface {
 	// detect and list devices currently connected to the system:
 	ListDevices(ctx context.Context, in *DevicesRequest, opts ...grpc.CallOption) (*DevicesResponse, error)
+	// stream events as devices are connected, disconnected, or changed; the currently known devices are sent first:
+	WatchDevices(ctx context.Context, in *WatchDevicesRequest, opts ...grpc.CallOption) (Devices_WatchDevicesClient, error)
 }
 
 type devicesClient struct {
@@ -39,12 +41,46 @@ func (c *devicesClient) ListDevices(ctx context.Context, in *DevicesRequest, opt
 	return out, nil
 }
 
+func (c *devicesClient) WatchDevices(ctx context.Context, in *WatchDevicesRequest, opts ...grpc.CallOption) (Devices_WatchDevicesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Devices_ServiceDesc.Streams[0], "/Devices/WatchDevices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &devicesWatchDevicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Devices_WatchDevicesClient interface {
+	Recv() (*WatchDevicesResponse, error)
+	grpc.ClientStream
+}
+
+type devicesWatchDevicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *devicesWatchDevicesClient) Recv() (*WatchDevicesResponse, error) {
+	m := new(WatchDevicesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DevicesServer is the server API for Devices service.
 // All implementations must embed UnimplementedDevicesServer
 // for forward compatibility
 type DevicesServer interface {
 	// detect and list devices currently connected to the system:
 	ListDevices(context.Context, *DevicesRequest) (*DevicesResponse, error)
+	// stream events as devices are connected, disconnected, or changed; the currently known devices are sent first:
+	WatchDevices(*WatchDevicesRequest, Devices_WatchDevicesServer) error
 	mustEmbedUnimplementedDevicesServer()
 }
 
@@ -55,6 +91,9 @@ type UnimplementedDevicesServer struct {
 func (UnimplementedDevicesServer) ListDevices(context.Context, *DevicesRequest) (*DevicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
 }
+func (UnimplementedDevicesServer) WatchDevices(*WatchDevicesRequest, Devices_WatchDevicesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDevices not implemented")
+}
 func (UnimplementedDevicesServer) mustEmbedUnimplementedDevicesServer() {}
 
 // UnsafeDevicesServer may be embedded to opt out of forward compatibility for this service.
@@ -86,6 +125,27 @@ func _Devices_ListDevices_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Devices_WatchDevices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDevicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DevicesServer).WatchDevices(m, &devicesWatchDevicesServer{stream})
+}
+
+type Devices_WatchDevicesServer interface {
+	Send(*WatchDevicesResponse) error
+	grpc.ServerStream
+}
+
+type devicesWatchDevicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *devicesWatchDevicesServer) Send(m *WatchDevicesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Devices_ServiceDesc is the grpc.ServiceDesc for Devices service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -98,6 +158,170 @@ var Devices_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Devices_ListDevices_Handler,
 		},
 	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDevices",
+			Handler:       _Devices_WatchDevices_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "sni.proto",
+}
+
+// DeviceLeaseClient is the client API for DeviceLease service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeviceLeaseClient interface {
+	AcquireLease(ctx context.Context, in *AcquireLeaseRequest, opts ...grpc.CallOption) (*AcquireLeaseResponse, error)
+	RenewLease(ctx context.Context, in *RenewLeaseRequest, opts ...grpc.CallOption) (*RenewLeaseResponse, error)
+	ReleaseLease(ctx context.Context, in *ReleaseLeaseRequest, opts ...grpc.CallOption) (*ReleaseLeaseResponse, error)
+}
+
+type deviceLeaseClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeviceLeaseClient(cc grpc.ClientConnInterface) DeviceLeaseClient {
+	return &deviceLeaseClient{cc}
+}
+
+func (c *deviceLeaseClient) AcquireLease(ctx context.Context, in *AcquireLeaseRequest, opts ...grpc.CallOption) (*AcquireLeaseResponse, error) {
+	out := new(AcquireLeaseResponse)
+	err := c.cc.Invoke(ctx, "/DeviceLease/AcquireLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceLeaseClient) RenewLease(ctx context.Context, in *RenewLeaseRequest, opts ...grpc.CallOption) (*RenewLeaseResponse, error) {
+	out := new(RenewLeaseResponse)
+	err := c.cc.Invoke(ctx, "/DeviceLease/RenewLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceLeaseClient) ReleaseLease(ctx context.Context, in *ReleaseLeaseRequest, opts ...grpc.CallOption) (*ReleaseLeaseResponse, error) {
+	out := new(ReleaseLeaseResponse)
+	err := c.cc.Invoke(ctx, "/DeviceLease/ReleaseLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceLeaseServer is the server API for DeviceLease service.
+// All implementations must embed UnimplementedDeviceLeaseServer
+// for forward compatibility
+type DeviceLeaseServer interface {
+	AcquireLease(context.Context, *AcquireLeaseRequest) (*AcquireLeaseResponse, error)
+	RenewLease(context.Context, *RenewLeaseRequest) (*RenewLeaseResponse, error)
+	ReleaseLease(context.Context, *ReleaseLeaseRequest) (*ReleaseLeaseResponse, error)
+	mustEmbedUnimplementedDeviceLeaseServer()
+}
+
+// UnimplementedDeviceLeaseServer must be embedded to have forward compatible implementations.
+type UnimplementedDeviceLeaseServer struct {
+}
+
+func (UnimplementedDeviceLeaseServer) AcquireLease(context.Context, *AcquireLeaseRequest) (*AcquireLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireLease not implemented")
+}
+func (UnimplementedDeviceLeaseServer) RenewLease(context.Context, *RenewLeaseRequest) (*RenewLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewLease not implemented")
+}
+func (UnimplementedDeviceLeaseServer) ReleaseLease(context.Context, *ReleaseLeaseRequest) (*ReleaseLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLease not implemented")
+}
+func (UnimplementedDeviceLeaseServer) mustEmbedUnimplementedDeviceLeaseServer() {}
+
+// UnsafeDeviceLeaseServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeviceLeaseServer will
+// result in compilation errors.
+type UnsafeDeviceLeaseServer interface {
+	mustEmbedUnimplementedDeviceLeaseServer()
+}
+
+func RegisterDeviceLeaseServer(s grpc.ServiceRegistrar, srv DeviceLeaseServer) {
+	s.RegisterService(&DeviceLease_ServiceDesc, srv)
+}
+
+func _DeviceLease_AcquireLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceLeaseServer).AcquireLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceLease/AcquireLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceLeaseServer).AcquireLease(ctx, req.(*AcquireLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceLease_RenewLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceLeaseServer).RenewLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceLease/RenewLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceLeaseServer).RenewLease(ctx, req.(*RenewLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceLease_ReleaseLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceLeaseServer).ReleaseLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceLease/ReleaseLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceLeaseServer).ReleaseLease(ctx, req.(*ReleaseLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeviceLease_ServiceDesc is the grpc.ServiceDesc for DeviceLease service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeviceLease_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "DeviceLease",
+	HandlerType: (*DeviceLeaseServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AcquireLease",
+			Handler:    _DeviceLease_AcquireLease_Handler,
+		},
+		{
+			MethodName: "RenewLease",
+			Handler:    _DeviceLease_RenewLease_Handler,
+		},
+		{
+			MethodName: "ReleaseLease",
+			Handler:    _DeviceLease_ReleaseLease_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sni.proto",
 }
@@ -114,6 +338,8 @@ type DeviceControlClient interface {
 	PauseUnpauseEmulation(ctx context.Context, in *PauseEmulationRequest, opts ...grpc.CallOption) (*PauseEmulationResponse, error)
 	// only available if DeviceCapability PauseToggleEmulation is present
 	PauseToggleEmulation(ctx context.Context, in *PauseToggleEmulationRequest, opts ...grpc.CallOption) (*PauseToggleEmulationResponse, error)
+	// only available if DeviceCapability ExecuteASM is present
+	ExecuteASM(ctx context.Context, in *ExecuteASMRequest, opts ...grpc.CallOption) (*ExecuteASMResponse, error)
 }
 
 type deviceControlClient struct {
@@ -160,6 +386,15 @@ func (c *deviceControlClient) PauseToggleEmulation(ctx context.Context, in *Paus
 	return out, nil
 }
 
+func (c *deviceControlClient) ExecuteASM(ctx context.Context, in *ExecuteASMRequest, opts ...grpc.CallOption) (*ExecuteASMResponse, error) {
+	out := new(ExecuteASMResponse)
+	err := c.cc.Invoke(ctx, "/DeviceControl/ExecuteASM", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceControlServer is the server API for DeviceControl service.
 // All implementations must embed UnimplementedDeviceControlServer
 // for forward compatibility
@@ -172,6 +407,8 @@ type DeviceControlServer interface {
 	PauseUnpauseEmulation(context.Context, *PauseEmulationRequest) (*PauseEmulationResponse, error)
 	// only available if DeviceCapability PauseToggleEmulation is present
 	PauseToggleEmulation(context.Context, *PauseToggleEmulationRequest) (*PauseToggleEmulationResponse, error)
+	// only available if DeviceCapability ExecuteASM is present
+	ExecuteASM(context.Context, *ExecuteASMRequest) (*ExecuteASMResponse, error)
 	mustEmbedUnimplementedDeviceControlServer()
 }
 
@@ -191,6 +428,9 @@ func (UnimplementedDeviceControlServer) PauseUnpauseEmulation(context.Context, *
 func (UnimplementedDeviceControlServer) PauseToggleEmulation(context.Context, *PauseToggleEmulationRequest) (*PauseToggleEmulationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PauseToggleEmulation not implemented")
 }
+func (UnimplementedDeviceControlServer) ExecuteASM(context.Context, *ExecuteASMRequest) (*ExecuteASMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteASM not implemented")
+}
 func (UnimplementedDeviceControlServer) mustEmbedUnimplementedDeviceControlServer() {}
 
 // UnsafeDeviceControlServer may be embedded to opt out of forward compatibility for this service.
@@ -276,6 +516,24 @@ func _DeviceControl_PauseToggleEmulation_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceControl_ExecuteASM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteASMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceControlServer).ExecuteASM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceControl/ExecuteASM",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceControlServer).ExecuteASM(ctx, req.(*ExecuteASMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeviceControl_ServiceDesc is the grpc.ServiceDesc for DeviceControl service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -299,6 +557,10 @@ var DeviceControl_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PauseToggleEmulation",
 			Handler:    _DeviceControl_PauseToggleEmulation_Handler,
 		},
+		{
+			MethodName: "ExecuteASM",
+			Handler:    _DeviceControl_ExecuteASM_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sni.proto",
@@ -322,6 +584,16 @@ type DeviceMemoryClient interface {
 	StreamRead(ctx context.Context, opts ...grpc.CallOption) (DeviceMemory_StreamReadClient, error)
 	// stream write multiple memory segments with given data to the given device:
 	StreamWrite(ctx context.Context, opts ...grpc.CallOption) (DeviceMemory_StreamWriteClient, error)
+	// watch multiple memory segments for changes; SNI polls the device every `intervalFrames` frames
+	// and streams back only the segments whose contents changed:
+	WatchMemory(ctx context.Context, in *WatchMemoryRequest, opts ...grpc.CallOption) (DeviceMemory_WatchMemoryClient, error)
+	// compare memory against expected values and perform the writes only if all conditions match:
+	ConditionalWrite(ctx context.Context, in *ConditionalWriteMemoryRequest, opts ...grpc.CallOption) (*ConditionalWriteMemoryResponse, error)
+	// apply an IPS patch directly to memory; each record's offset is an address in the requested address space:
+	PatchMemory(ctx context.Context, in *PatchMemoryRequest, opts ...grpc.CallOption) (*PatchMemoryResponse, error)
+	// validate the ROM checksum against the header's checksum and complement, optionally fixing them; validates the
+	// uploaded ROM file `data` if given, otherwise the ROM is read back from the device's memory:
+	ValidateROM(ctx context.Context, in *ValidateROMRequest, opts ...grpc.CallOption) (*ValidateROMResponse, error)
 }
 
 type deviceMemoryClient struct {
@@ -439,6 +711,65 @@ func (x *deviceMemoryStreamWriteClient) Recv() (*MultiWriteMemoryResponse, error
 	return m, nil
 }
 
+func (c *deviceMemoryClient) WatchMemory(ctx context.Context, in *WatchMemoryRequest, opts ...grpc.CallOption) (DeviceMemory_WatchMemoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DeviceMemory_ServiceDesc.Streams[2], "/DeviceMemory/WatchMemory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceMemoryWatchMemoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DeviceMemory_WatchMemoryClient interface {
+	Recv() (*WatchMemoryResponse, error)
+	grpc.ClientStream
+}
+
+type deviceMemoryWatchMemoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceMemoryWatchMemoryClient) Recv() (*WatchMemoryResponse, error) {
+	m := new(WatchMemoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deviceMemoryClient) ConditionalWrite(ctx context.Context, in *ConditionalWriteMemoryRequest, opts ...grpc.CallOption) (*ConditionalWriteMemoryResponse, error) {
+	out := new(ConditionalWriteMemoryResponse)
+	err := c.cc.Invoke(ctx, "/DeviceMemory/ConditionalWrite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMemoryClient) PatchMemory(ctx context.Context, in *PatchMemoryRequest, opts ...grpc.CallOption) (*PatchMemoryResponse, error) {
+	out := new(PatchMemoryResponse)
+	err := c.cc.Invoke(ctx, "/DeviceMemory/PatchMemory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceMemoryClient) ValidateROM(ctx context.Context, in *ValidateROMRequest, opts ...grpc.CallOption) (*ValidateROMResponse, error) {
+	out := new(ValidateROMResponse)
+	err := c.cc.Invoke(ctx, "/DeviceMemory/ValidateROM", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceMemoryServer is the server API for DeviceMemory service.
 // All implementations must embed UnimplementedDeviceMemoryServer
 // for forward compatibility
@@ -457,6 +788,16 @@ type DeviceMemoryServer interface {
 	StreamRead(DeviceMemory_StreamReadServer) error
 	// stream write multiple memory segments with given data to the given device:
 	StreamWrite(DeviceMemory_StreamWriteServer) error
+	// watch multiple memory segments for changes; SNI polls the device every `intervalFrames` frames
+	// and streams back only the segments whose contents changed:
+	WatchMemory(*WatchMemoryRequest, DeviceMemory_WatchMemoryServer) error
+	// compare memory against expected values and perform the writes only if all conditions match:
+	ConditionalWrite(context.Context, *ConditionalWriteMemoryRequest) (*ConditionalWriteMemoryResponse, error)
+	// apply an IPS patch directly to memory; each record's offset is an address in the requested address space:
+	PatchMemory(context.Context, *PatchMemoryRequest) (*PatchMemoryResponse, error)
+	// validate the ROM checksum against the header's checksum and complement, optionally fixing them; validates the
+	// uploaded ROM file `data` if given, otherwise the ROM is read back from the device's memory:
+	ValidateROM(context.Context, *ValidateROMRequest) (*ValidateROMResponse, error)
 	mustEmbedUnimplementedDeviceMemoryServer()
 }
 
@@ -485,6 +826,18 @@ func (UnimplementedDeviceMemoryServer) StreamRead(DeviceMemory_StreamReadServer)
 func (UnimplementedDeviceMemoryServer) StreamWrite(DeviceMemory_StreamWriteServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamWrite not implemented")
 }
+func (UnimplementedDeviceMemoryServer) WatchMemory(*WatchMemoryRequest, DeviceMemory_WatchMemoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMemory not implemented")
+}
+func (UnimplementedDeviceMemoryServer) ConditionalWrite(context.Context, *ConditionalWriteMemoryRequest) (*ConditionalWriteMemoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConditionalWrite not implemented")
+}
+func (UnimplementedDeviceMemoryServer) PatchMemory(context.Context, *PatchMemoryRequest) (*PatchMemoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchMemory not implemented")
+}
+func (UnimplementedDeviceMemoryServer) ValidateROM(context.Context, *ValidateROMRequest) (*ValidateROMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateROM not implemented")
+}
 func (UnimplementedDeviceMemoryServer) mustEmbedUnimplementedDeviceMemoryServer() {}
 
 // UnsafeDeviceMemoryServer may be embedded to opt out of forward compatibility for this service.
@@ -640,6 +993,81 @@ func (x *deviceMemoryStreamWriteServer) Recv() (*MultiWriteMemoryRequest, error)
 	return m, nil
 }
 
+func _DeviceMemory_WatchMemory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMemoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeviceMemoryServer).WatchMemory(m, &deviceMemoryWatchMemoryServer{stream})
+}
+
+type DeviceMemory_WatchMemoryServer interface {
+	Send(*WatchMemoryResponse) error
+	grpc.ServerStream
+}
+
+type deviceMemoryWatchMemoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceMemoryWatchMemoryServer) Send(m *WatchMemoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DeviceMemory_ConditionalWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConditionalWriteMemoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMemoryServer).ConditionalWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceMemory/ConditionalWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMemoryServer).ConditionalWrite(ctx, req.(*ConditionalWriteMemoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMemory_PatchMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchMemoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMemoryServer).PatchMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceMemory/PatchMemory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMemoryServer).PatchMemory(ctx, req.(*PatchMemoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceMemory_ValidateROM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateROMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMemoryServer).ValidateROM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceMemory/ValidateROM",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMemoryServer).ValidateROM(ctx, req.(*ValidateROMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeviceMemory_ServiceDesc is the grpc.ServiceDesc for DeviceMemory service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -667,6 +1095,18 @@ var DeviceMemory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MultiWrite",
 			Handler:    _DeviceMemory_MultiWrite_Handler,
 		},
+		{
+			MethodName: "ConditionalWrite",
+			Handler:    _DeviceMemory_ConditionalWrite_Handler,
+		},
+		{
+			MethodName: "PatchMemory",
+			Handler:    _DeviceMemory_PatchMemory_Handler,
+		},
+		{
+			MethodName: "ValidateROM",
+			Handler:    _DeviceMemory_ValidateROM_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -681,7 +1121,100 @@ var DeviceMemory_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WatchMemory",
+			Handler:       _DeviceMemory_WatchMemory_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "sni.proto",
+}
+
+// DeviceInfoClient is the client API for DeviceInfo service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeviceInfoClient interface {
+	// only available if DeviceCapability FetchFields is present
+	FetchFields(ctx context.Context, in *FieldsRequest, opts ...grpc.CallOption) (*FieldsResponse, error)
+}
+
+type deviceInfoClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeviceInfoClient(cc grpc.ClientConnInterface) DeviceInfoClient {
+	return &deviceInfoClient{cc}
+}
+
+func (c *deviceInfoClient) FetchFields(ctx context.Context, in *FieldsRequest, opts ...grpc.CallOption) (*FieldsResponse, error) {
+	out := new(FieldsResponse)
+	err := c.cc.Invoke(ctx, "/DeviceInfo/FetchFields", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceInfoServer is the server API for DeviceInfo service.
+// All implementations must embed UnimplementedDeviceInfoServer
+// for forward compatibility
+type DeviceInfoServer interface {
+	// only available if DeviceCapability FetchFields is present
+	FetchFields(context.Context, *FieldsRequest) (*FieldsResponse, error)
+	mustEmbedUnimplementedDeviceInfoServer()
+}
+
+// UnimplementedDeviceInfoServer must be embedded to have forward compatible implementations.
+type UnimplementedDeviceInfoServer struct {
+}
+
+func (UnimplementedDeviceInfoServer) FetchFields(context.Context, *FieldsRequest) (*FieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchFields not implemented")
+}
+func (UnimplementedDeviceInfoServer) mustEmbedUnimplementedDeviceInfoServer() {}
+
+// UnsafeDeviceInfoServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeviceInfoServer will
+// result in compilation errors.
+type UnsafeDeviceInfoServer interface {
+	mustEmbedUnimplementedDeviceInfoServer()
+}
+
+func RegisterDeviceInfoServer(s grpc.ServiceRegistrar, srv DeviceInfoServer) {
+	s.RegisterService(&DeviceInfo_ServiceDesc, srv)
+}
+
+func _DeviceInfo_FetchFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceInfoServer).FetchFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceInfo/FetchFields",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceInfoServer).FetchFields(ctx, req.(*FieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeviceInfo_ServiceDesc is the grpc.ServiceDesc for DeviceInfo service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeviceInfo_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "DeviceInfo",
+	HandlerType: (*DeviceInfoServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FetchFields",
+			Handler:    _DeviceInfo_FetchFields_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "sni.proto",
 }
 
@@ -695,6 +1228,12 @@ type DeviceFilesystemClient interface {
 	RenameFile(ctx context.Context, in *RenameFileRequest, opts ...grpc.CallOption) (*RenameFileResponse, error)
 	PutFile(ctx context.Context, in *PutFileRequest, opts ...grpc.CallOption) (*PutFileResponse, error)
 	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*GetFileResponse, error)
+	// applies IPS, BPS or UPS patches to the file data in order then puts the patched file:
+	PatchAndPutFile(ctx context.Context, in *PatchAndPutFileRequest, opts ...grpc.CallOption) (*PatchAndPutFileResponse, error)
+	// uploads a file in chunks with progress; the first request must set uri, path and size:
+	PutFileStream(ctx context.Context, opts ...grpc.CallOption) (DeviceFilesystem_PutFileStreamClient, error)
+	// downloads a file in chunks with progress:
+	GetFileStream(ctx context.Context, in *GetFileStreamRequest, opts ...grpc.CallOption) (DeviceFilesystem_GetFileStreamClient, error)
 	BootFile(ctx context.Context, in *BootFileRequest, opts ...grpc.CallOption) (*BootFileResponse, error)
 }
 
@@ -760,6 +1299,78 @@ func (c *deviceFilesystemClient) GetFile(ctx context.Context, in *GetFileRequest
 	return out, nil
 }
 
+func (c *deviceFilesystemClient) PatchAndPutFile(ctx context.Context, in *PatchAndPutFileRequest, opts ...grpc.CallOption) (*PatchAndPutFileResponse, error) {
+	out := new(PatchAndPutFileResponse)
+	err := c.cc.Invoke(ctx, "/DeviceFilesystem/PatchAndPutFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceFilesystemClient) PutFileStream(ctx context.Context, opts ...grpc.CallOption) (DeviceFilesystem_PutFileStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DeviceFilesystem_ServiceDesc.Streams[0], "/DeviceFilesystem/PutFileStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceFilesystemPutFileStreamClient{stream}
+	return x, nil
+}
+
+type DeviceFilesystem_PutFileStreamClient interface {
+	Send(*PutFileStreamRequest) error
+	Recv() (*PutFileStreamResponse, error)
+	grpc.ClientStream
+}
+
+type deviceFilesystemPutFileStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceFilesystemPutFileStreamClient) Send(m *PutFileStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *deviceFilesystemPutFileStreamClient) Recv() (*PutFileStreamResponse, error) {
+	m := new(PutFileStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deviceFilesystemClient) GetFileStream(ctx context.Context, in *GetFileStreamRequest, opts ...grpc.CallOption) (DeviceFilesystem_GetFileStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DeviceFilesystem_ServiceDesc.Streams[1], "/DeviceFilesystem/GetFileStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceFilesystemGetFileStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DeviceFilesystem_GetFileStreamClient interface {
+	Recv() (*GetFileStreamResponse, error)
+	grpc.ClientStream
+}
+
+type deviceFilesystemGetFileStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceFilesystemGetFileStreamClient) Recv() (*GetFileStreamResponse, error) {
+	m := new(GetFileStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *deviceFilesystemClient) BootFile(ctx context.Context, in *BootFileRequest, opts ...grpc.CallOption) (*BootFileResponse, error) {
 	out := new(BootFileResponse)
 	err := c.cc.Invoke(ctx, "/DeviceFilesystem/BootFile", in, out, opts...)
@@ -779,6 +1390,12 @@ type DeviceFilesystemServer interface {
 	RenameFile(context.Context, *RenameFileRequest) (*RenameFileResponse, error)
 	PutFile(context.Context, *PutFileRequest) (*PutFileResponse, error)
 	GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error)
+	// applies IPS, BPS or UPS patches to the file data in order then puts the patched file:
+	PatchAndPutFile(context.Context, *PatchAndPutFileRequest) (*PatchAndPutFileResponse, error)
+	// uploads a file in chunks with progress; the first request must set uri, path and size:
+	PutFileStream(DeviceFilesystem_PutFileStreamServer) error
+	// downloads a file in chunks with progress:
+	GetFileStream(*GetFileStreamRequest, DeviceFilesystem_GetFileStreamServer) error
 	BootFile(context.Context, *BootFileRequest) (*BootFileResponse, error)
 	mustEmbedUnimplementedDeviceFilesystemServer()
 }
@@ -805,6 +1422,15 @@ func (UnimplementedDeviceFilesystemServer) PutFile(context.Context, *PutFileRequ
 func (UnimplementedDeviceFilesystemServer) GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFile not implemented")
 }
+func (UnimplementedDeviceFilesystemServer) PatchAndPutFile(context.Context, *PatchAndPutFileRequest) (*PatchAndPutFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchAndPutFile not implemented")
+}
+func (UnimplementedDeviceFilesystemServer) PutFileStream(DeviceFilesystem_PutFileStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PutFileStream not implemented")
+}
+func (UnimplementedDeviceFilesystemServer) GetFileStream(*GetFileStreamRequest, DeviceFilesystem_GetFileStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetFileStream not implemented")
+}
 func (UnimplementedDeviceFilesystemServer) BootFile(context.Context, *BootFileRequest) (*BootFileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BootFile not implemented")
 }
@@ -929,6 +1555,71 @@ func _DeviceFilesystem_GetFile_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceFilesystem_PatchAndPutFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchAndPutFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceFilesystemServer).PatchAndPutFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceFilesystem/PatchAndPutFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceFilesystemServer).PatchAndPutFile(ctx, req.(*PatchAndPutFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceFilesystem_PutFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DeviceFilesystemServer).PutFileStream(&deviceFilesystemPutFileStreamServer{stream})
+}
+
+type DeviceFilesystem_PutFileStreamServer interface {
+	Send(*PutFileStreamResponse) error
+	Recv() (*PutFileStreamRequest, error)
+	grpc.ServerStream
+}
+
+type deviceFilesystemPutFileStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceFilesystemPutFileStreamServer) Send(m *PutFileStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *deviceFilesystemPutFileStreamServer) Recv() (*PutFileStreamRequest, error) {
+	m := new(PutFileStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _DeviceFilesystem_GetFileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFileStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeviceFilesystemServer).GetFileStream(m, &deviceFilesystemGetFileStreamServer{stream})
+}
+
+type DeviceFilesystem_GetFileStreamServer interface {
+	Send(*GetFileStreamResponse) error
+	grpc.ServerStream
+}
+
+type deviceFilesystemGetFileStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceFilesystemGetFileStreamServer) Send(m *GetFileStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _DeviceFilesystem_BootFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BootFileRequest)
 	if err := dec(in); err != nil {
@@ -978,11 +1669,113 @@ var DeviceFilesystem_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFile",
 			Handler:    _DeviceFilesystem_GetFile_Handler,
 		},
+		{
+			MethodName: "PatchAndPutFile",
+			Handler:    _DeviceFilesystem_PatchAndPutFile_Handler,
+		},
 		{
 			MethodName: "BootFile",
 			Handler:    _DeviceFilesystem_BootFile_Handler,
 		},
 	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PutFileStream",
+			Handler:       _DeviceFilesystem_PutFileStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetFileStream",
+			Handler:       _DeviceFilesystem_GetFileStream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "sni.proto",
+}
+
+// ServerClient is the client API for Server service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServerClient interface {
+	ServerInfo(ctx context.Context, in *ServerInfoRequest, opts ...grpc.CallOption) (*ServerInfoResponse, error)
+}
+
+type serverClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServerClient(cc grpc.ClientConnInterface) ServerClient {
+	return &serverClient{cc}
+}
+
+func (c *serverClient) ServerInfo(ctx context.Context, in *ServerInfoRequest, opts ...grpc.CallOption) (*ServerInfoResponse, error) {
+	out := new(ServerInfoResponse)
+	err := c.cc.Invoke(ctx, "/Server/ServerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServerServer is the server API for Server service.
+// All implementations must embed UnimplementedServerServer
+// for forward compatibility
+type ServerServer interface {
+	ServerInfo(context.Context, *ServerInfoRequest) (*ServerInfoResponse, error)
+	mustEmbedUnimplementedServerServer()
+}
+
+// UnimplementedServerServer must be embedded to have forward compatible implementations.
+type UnimplementedServerServer struct {
+}
+
+func (UnimplementedServerServer) ServerInfo(context.Context, *ServerInfoRequest) (*ServerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServerInfo not implemented")
+}
+func (UnimplementedServerServer) mustEmbedUnimplementedServerServer() {}
+
+// UnsafeServerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServerServer will
+// result in compilation errors.
+type UnsafeServerServer interface {
+	mustEmbedUnimplementedServerServer()
+}
+
+func RegisterServerServer(s grpc.ServiceRegistrar, srv ServerServer) {
+	s.RegisterService(&Server_ServiceDesc, srv)
+}
+
+func _Server_ServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServer).ServerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Server/ServerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServer).ServerInfo(ctx, req.(*ServerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Server_ServiceDesc is the grpc.ServiceDesc for Server service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Server_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Server",
+	HandlerType: (*ServerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ServerInfo",
+			Handler:    _Server_ServerInfo_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sni.proto",
 }
@@ -10,16 +10,20 @@ import (
 	"sni/protos/sni"
 	"sni/util"
 	"sni/util/env"
+	"time"
 )
 
 // AutoCloseableDevice is a Device wrapper that ensures that a valid Device instance is always used for every
 // interface method call. On severe errors the Device is closed and reopened when needed again.
+// Methods that modify the device state fail while another client holds a Lease on the device; see CheckLease.
 type AutoCloseableDevice interface {
 	io.Closer
 	DeviceControl
 	DeviceMemory
 	DeviceFilesystem
 	DeviceInfo
+	DeviceExecuteASM
+	DeviceConditionalWrite
 
 	URI() *url.URL
 	DeviceKey() string
@@ -65,6 +69,14 @@ func (a *autoCloseableDevice) ensureOpened(ctx context.Context, use deviceUser)
 	b := a.container
 	deviceKey := a.deviceKey
 
+	// wait for our turn to access the device:
+	var release func()
+	release, err = scheduleDeviceAccess(ctx, uniqueDeviceKey(a))
+	if err != nil {
+		return
+	}
+	defer release()
+
 	var device Device
 	device, err = b.GetOrOpenDevice(deviceKey, a.uri)
 	if err != nil {
@@ -72,6 +84,7 @@ func (a *autoCloseableDevice) ensureOpened(ctx context.Context, use deviceUser)
 	}
 
 	err = use(ctx, device)
+	countDeviceError(a.uri.Scheme, err)
 
 	// Check for fatal error and close device if so:
 	if derr, ok := err.(DeviceError); ok && derr.IsFatal() {
@@ -89,6 +102,11 @@ func (a *autoCloseableDevice) ensureOpened(ctx context.Context, use deviceUser)
 	return
 }
 
+// uniqueDeviceKey identifies a device across all drivers
+func uniqueDeviceKey(device AutoCloseableDevice) string {
+	return device.URI().Scheme + ":" + device.DeviceKey()
+}
+
 func (a *autoCloseableDevice) URI() *url.URL {
 	return a.uri
 }
@@ -114,6 +132,13 @@ func (a *autoCloseableDevice) Close() error {
 }
 
 func (a *autoCloseableDevice) ResetSystem(ctx context.Context) (err error) {
+	if err = CheckLease(ctx, a); err != nil {
+		return
+	}
+
+	tStart := time.Now()
+	defer func() { recordCall(a, tStart, err, RecordedCall{Method: "ResetSystem"}) }()
+
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("ResetSystem() {\n")
@@ -124,10 +149,20 @@ func (a *autoCloseableDevice) ResetSystem(ctx context.Context) (err error) {
 		}
 		return
 	})
+	if c := readCacheFor(a); c != nil {
+		c.InvalidateAll()
+	}
 	return
 }
 
 func (a *autoCloseableDevice) ResetToMenu(ctx context.Context) (err error) {
+	if err = CheckLease(ctx, a); err != nil {
+		return
+	}
+
+	tStart := time.Now()
+	defer func() { recordCall(a, tStart, err, RecordedCall{Method: "ResetToMenu"}) }()
+
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("ResetToMenu() {\n")
@@ -138,10 +173,22 @@ func (a *autoCloseableDevice) ResetToMenu(ctx context.Context) (err error) {
 		}
 		return
 	})
+	if c := readCacheFor(a); c != nil {
+		c.InvalidateAll()
+	}
 	return
 }
 
 func (a *autoCloseableDevice) PauseUnpause(ctx context.Context, pausedState bool) (ok bool, err error) {
+	if err = CheckLease(ctx, a); err != nil {
+		return
+	}
+
+	tStart := time.Now()
+	defer func() {
+		recordCall(a, tStart, err, RecordedCall{Method: "PauseUnpause", PausedState: pausedState, Ok: ok})
+	}()
+
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("PauseUnpause(%#v) {\n", pausedState)
@@ -156,6 +203,13 @@ func (a *autoCloseableDevice) PauseUnpause(ctx context.Context, pausedState bool
 }
 
 func (a *autoCloseableDevice) PauseToggle(ctx context.Context) (err error) {
+	if err = CheckLease(ctx, a); err != nil {
+		return
+	}
+
+	tStart := time.Now()
+	defer func() { recordCall(a, tStart, err, RecordedCall{Method: "PauseToggle"}) }()
+
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("PauseToggle() {\n")
@@ -170,6 +224,9 @@ func (a *autoCloseableDevice) PauseToggle(ctx context.Context) (err error) {
 }
 
 func (a *autoCloseableDevice) DefaultAddressSpace(ctx context.Context) (space sni.AddressSpace, err error) {
+	tStart := time.Now()
+	defer func() { recordCall(a, tStart, err, RecordedCall{Method: "DefaultAddressSpace", AddressSpace: space}) }()
+
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("DefaultAddressSpace() {\n")
@@ -184,6 +241,18 @@ func (a *autoCloseableDevice) DefaultAddressSpace(ctx context.Context) (space sn
 }
 
 func (a *autoCloseableDevice) MultiReadMemory(ctx context.Context, reads ...MemoryReadRequest) (rsp []MemoryReadResponse, err error) {
+	tStart := time.Now()
+	defer func() {
+		recordCall(a, tStart, err, RecordedCall{Method: "MultiReadMemory", Reads: reads, ReadResponses: rsp})
+	}()
+
+	if c := readCacheFor(a); c != nil {
+		return c.MultiReadMemory(ctx, a.multiReadMemory, reads...)
+	}
+	return a.multiReadMemory(ctx, reads...)
+}
+
+func (a *autoCloseableDevice) multiReadMemory(ctx context.Context, reads ...MemoryReadRequest) (rsp []MemoryReadResponse, err error) {
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("MultiReadMemory(%#v) {\n", reads)
@@ -192,12 +261,28 @@ func (a *autoCloseableDevice) MultiReadMemory(ctx context.Context, reads ...Memo
 		if a.logger != nil {
 			a.logger.Printf("MultiReadMemory(%#v) } -> (%#v, %#v)\n", reads, rsp, err)
 		}
+		if err == nil {
+			countReadBytes(a.uri.Scheme, rsp)
+		}
 		return
 	})
 	return
 }
 
 func (a *autoCloseableDevice) MultiWriteMemory(ctx context.Context, writes ...MemoryWriteRequest) (rsp []MemoryWriteResponse, err error) {
+	if err = CheckLease(ctx, a); err != nil {
+		return
+	}
+
+	tStart := time.Now()
+	defer func() {
+		recordCall(a, tStart, err, RecordedCall{Method: "MultiWriteMemory", Writes: writes, WriteResponses: rsp})
+	}()
+
+	l := conditionalWriteLock(a)
+	defer l.RUnlock()
+	l.RLock()
+
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		if a.logger != nil {
 			a.logger.Printf("MultiWriteMemory(%#v) {\n", writes)
@@ -206,12 +291,66 @@ func (a *autoCloseableDevice) MultiWriteMemory(ctx context.Context, writes ...Me
 		if a.logger != nil {
 			a.logger.Printf("MultiWriteMemory(%#v) } -> (%#v, %#v)\n", writes, rsp, err)
 		}
+		if err == nil {
+			countWriteBytes(a.uri.Scheme, writes)
+		}
+		return
+	})
+	if c := readCacheFor(a); c != nil {
+		c.InvalidateWrites(writes)
+	}
+	return
+}
+
+func (a *autoCloseableDevice) ConditionalWriteMemory(ctx context.Context, conditions []MemoryCondition, writes []MemoryWriteRequest) (rsp ConditionalWriteResponse, err error) {
+	if err = CheckLease(ctx, a); err != nil {
+		return
+	}
+	if err = ValidateMemoryConditions(conditions); err != nil {
+		return
+	}
+
+	tStart := time.Now()
+	defer func() {
+		recordCall(a, tStart, err, RecordedCall{Method: "ConditionalWriteMemory", Conditions: conditions, Writes: writes, ConditionalWrite: rsp})
+	}()
+
+	// hold off other writes in case we need to fall back; this must be locked before the device is scheduled
+	// to avoid deadlocking with MultiWriteMemory:
+	l := conditionalWriteLock(a)
+	defer l.Unlock()
+	l.Lock()
+
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		if a.logger != nil {
+			a.logger.Printf("ConditionalWriteMemory(%#v, %#v) {\n", conditions, writes)
+		}
+		if cw, ok := device.(DeviceConditionalWrite); ok {
+			rsp, err = cw.ConditionalWriteMemory(ctx, conditions, writes)
+		} else {
+			// fall back to best-effort read-compare-write:
+			rsp, err = conditionalWriteMemory(ctx, device, conditions, writes)
+		}
+		if a.logger != nil {
+			a.logger.Printf("ConditionalWriteMemory(%#v, %#v) } -> (%#v, %#v)\n", conditions, writes, rsp, err)
+		}
+		if err == nil && rsp.Written {
+			countWriteBytes(a.uri.Scheme, writes)
+		}
 		return
 	})
+	if c := readCacheFor(a); c != nil {
+		c.InvalidateWrites(writes)
+	}
 	return
 }
 
-func (a *autoCloseableDevice) FetchFields(ctx context.Context, fields ...Field) (values []string, err error) {
+func (a *autoCloseableDevice) FetchFields(ctx context.Context, fields ...Field) (values []FieldValue, err error) {
+	tStart := time.Now()
+	defer func() {
+		recordCall(a, tStart, err, RecordedCall{Method: "FetchFields", Fields: fields, FieldValues: values})
+	}()
+
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		inf, ok := device.(DeviceInfo)
 		if !ok {
@@ -229,7 +368,38 @@ func (a *autoCloseableDevice) FetchFields(ctx context.Context, fields ...Field)
 	return
 }
 
+func (a *autoCloseableDevice) ExecuteASM(ctx context.Context, code []byte, data []byte) (err error) {
+	if err = CheckLease(ctx, a); err != nil {
+		return
+	}
+
+	tStart := time.Now()
+	defer func() { recordCall(a, tStart, err, RecordedCall{Method: "ExecuteASM", Code: code, Data: data}) }()
+
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		exe, ok := device.(DeviceExecuteASM)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceExecuteASM not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("ExecuteASM(%#v, %#v) {\n", code, data)
+		}
+		err = exe.ExecuteASM(ctx, code, data)
+		if a.logger != nil {
+			a.logger.Printf("ExecuteASM(%#v, %#v) } -> (%#v)\n", code, data, err)
+		}
+		return
+	})
+	if c := readCacheFor(a); c != nil {
+		c.InvalidateAll()
+	}
+	return
+}
+
 func (a *autoCloseableDevice) ReadDirectory(ctx context.Context, path string) (rsp []DirEntry, err error) {
+	tStart := time.Now()
+	defer func() { recordCall(a, tStart, err, RecordedCall{Method: "ReadDirectory", Path: path, DirEntries: rsp}) }()
+
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		fs, ok := device.(DeviceFilesystem)
 		if !ok {
@@ -248,6 +418,13 @@ func (a *autoCloseableDevice) ReadDirectory(ctx context.Context, path string) (r
 }
 
 func (a *autoCloseableDevice) MakeDirectory(ctx context.Context, path string) (err error) {
+	if err = CheckLease(ctx, a); err != nil {
+		return
+	}
+
+	tStart := time.Now()
+	defer func() { recordCall(a, tStart, err, RecordedCall{Method: "MakeDirectory", Path: path}) }()
+
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		fs, ok := device.(DeviceFilesystem)
 		if !ok {
@@ -266,6 +443,13 @@ func (a *autoCloseableDevice) MakeDirectory(ctx context.Context, path string) (e
 }
 
 func (a *autoCloseableDevice) RemoveFile(ctx context.Context, path string) (err error) {
+	if err = CheckLease(ctx, a); err != nil {
+		return
+	}
+
+	tStart := time.Now()
+	defer func() { recordCall(a, tStart, err, RecordedCall{Method: "RemoveFile", Path: path}) }()
+
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		fs, ok := device.(DeviceFilesystem)
 		if !ok {
@@ -284,6 +468,15 @@ func (a *autoCloseableDevice) RemoveFile(ctx context.Context, path string) (err
 }
 
 func (a *autoCloseableDevice) RenameFile(ctx context.Context, path, newFilename string) (err error) {
+	if err = CheckLease(ctx, a); err != nil {
+		return
+	}
+
+	tStart := time.Now()
+	defer func() {
+		recordCall(a, tStart, err, RecordedCall{Method: "RenameFile", Path: path, NewPath: newFilename})
+	}()
+
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		fs, ok := device.(DeviceFilesystem)
 		if !ok {
@@ -302,6 +495,19 @@ func (a *autoCloseableDevice) RenameFile(ctx context.Context, path, newFilename
 }
 
 func (a *autoCloseableDevice) PutFile(ctx context.Context, path string, size uint32, r io.Reader, progress ProgressReportFunc) (n uint32, err error) {
+	if err = CheckLease(ctx, a); err != nil {
+		return
+	}
+
+	if recorderFor(a) != nil {
+		rr := &recordingReader{r: r}
+		r = rr
+		tStart := time.Now()
+		defer func() {
+			recordCall(a, tStart, err, RecordedCall{Method: "PutFile", Path: path, Size: rr.size, CRC32: rr.crc})
+		}()
+	}
+
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		fs, ok := device.(DeviceFilesystem)
 		if !ok {
@@ -320,6 +526,15 @@ func (a *autoCloseableDevice) PutFile(ctx context.Context, path string, size uin
 }
 
 func (a *autoCloseableDevice) GetFile(ctx context.Context, path string, w io.Writer, sizeReceived SizeReceivedFunc, progress ProgressReportFunc) (size uint32, err error) {
+	if recorderFor(a) != nil {
+		rw := &recordingWriter{w: w}
+		w = rw
+		tStart := time.Now()
+		defer func() {
+			recordCall(a, tStart, err, RecordedCall{Method: "GetFile", Path: path, Size: size, Data: rw.data})
+		}()
+	}
+
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		fs, ok := device.(DeviceFilesystem)
 		if !ok {
@@ -338,6 +553,13 @@ func (a *autoCloseableDevice) GetFile(ctx context.Context, path string, w io.Wri
 }
 
 func (a *autoCloseableDevice) BootFile(ctx context.Context, path string) (err error) {
+	if err = CheckLease(ctx, a); err != nil {
+		return
+	}
+
+	tStart := time.Now()
+	defer func() { recordCall(a, tStart, err, RecordedCall{Method: "BootFile", Path: path}) }()
+
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		fs, ok := device.(DeviceFilesystem)
 		if !ok {
@@ -352,5 +574,8 @@ func (a *autoCloseableDevice) BootFile(ctx context.Context, path string) (err er
 		}
 		return
 	})
+	if c := readCacheFor(a); c != nil {
+		c.InvalidateAll()
+	}
 	return
 }
@@ -0,0 +1,139 @@
+package snes
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sync"
+)
+
+// MemoryCondition compares memory at RequestAddress against Expected. If Mask is present it must be the same length
+// as Expected and only the bits set in Mask are compared.
+type MemoryCondition struct {
+	RequestAddress AddressTuple
+
+	Expected []byte
+	Mask     []byte
+}
+
+type ConditionalWriteResponse struct {
+	// Written is set if all conditions matched and the writes were performed
+	Written bool
+	// FailedConditions lists the indices of the conditions that did not match
+	FailedConditions []int
+	// Writes contains the responses for the writes performed, if any
+	Writes []MemoryWriteResponse
+}
+
+// DeviceConditionalWrite is implemented by devices that can atomically compare memory and write only if it matches.
+type DeviceConditionalWrite interface {
+	ConditionalWriteMemory(ctx context.Context, conditions []MemoryCondition, writes []MemoryWriteRequest) (ConditionalWriteResponse, error)
+}
+
+var (
+	// conditionalWriteLocks serializes memory writes with the best-effort conditional write fallback per device
+	conditionalWriteLocksMu sync.Mutex
+	conditionalWriteLocks   = make(map[string]*sync.RWMutex)
+)
+
+func conditionalWriteLock(device AutoCloseableDevice) *sync.RWMutex {
+	key := uniqueDeviceKey(device)
+
+	conditionalWriteLocksMu.Lock()
+	defer conditionalWriteLocksMu.Unlock()
+
+	l, ok := conditionalWriteLocks[key]
+	if !ok {
+		l = &sync.RWMutex{}
+		conditionalWriteLocks[key] = l
+	}
+	return l
+}
+
+// ValidateMemoryConditions checks that each condition's Mask, if present, is the same length as its Expected data.
+func ValidateMemoryConditions(conditions []MemoryCondition) error {
+	for i, c := range conditions {
+		if len(c.Expected) == 0 {
+			return WithCode(codes.InvalidArgument, fmt.Errorf("condition[%d] must have expected data", i))
+		}
+		if c.Mask != nil && len(c.Mask) != len(c.Expected) {
+			return WithCode(codes.InvalidArgument, fmt.Errorf(
+				"condition[%d] mask length must match expected length; %d != %d",
+				i,
+				len(c.Mask),
+				len(c.Expected),
+			))
+		}
+	}
+	return nil
+}
+
+// MatchMemoryCondition reports whether the data read from memory matches the condition.
+func MatchMemoryCondition(c *MemoryCondition, data []byte) bool {
+	if len(data) != len(c.Expected) {
+		return false
+	}
+	for j := range c.Expected {
+		mask := byte(0xFF)
+		if c.Mask != nil {
+			mask = c.Mask[j]
+		}
+		if data[j]&mask != c.Expected[j]&mask {
+			return false
+		}
+	}
+	return true
+}
+
+// conditionalWriteMemory is the best-effort fallback for devices that do not implement DeviceConditionalWrite.
+// The conditions are read and compared and then the writes are performed while no other memory writes through SNI
+// to the device are allowed; the SNES itself may still modify memory in between.
+func conditionalWriteMemory(
+	ctx context.Context,
+	device Device,
+	conditions []MemoryCondition,
+	writes []MemoryWriteRequest,
+) (rsp ConditionalWriteResponse, err error) {
+	reads := make([]MemoryReadRequest, 0, len(conditions))
+	for _, c := range conditions {
+		reads = append(reads, MemoryReadRequest{
+			RequestAddress: c.RequestAddress,
+			Size:           len(c.Expected),
+		})
+	}
+
+	if len(reads) > 0 {
+		var mrsp []MemoryReadResponse
+		mrsp, err = device.MultiReadMemory(ctx, reads...)
+		if err != nil {
+			return
+		}
+		if len(mrsp) != len(reads) {
+			err = WithCode(codes.Internal, fmt.Errorf(
+				"multi read must have equal number of responses and requests; actual %d expected %d",
+				len(mrsp),
+				len(reads),
+			))
+			return
+		}
+
+		for i := range conditions {
+			if !MatchMemoryCondition(&conditions[i], mrsp[i].Data) {
+				rsp.FailedConditions = append(rsp.FailedConditions, i)
+			}
+		}
+		if len(rsp.FailedConditions) > 0 {
+			return
+		}
+	}
+
+	if len(writes) > 0 {
+		rsp.Writes, err = device.MultiWriteMemory(ctx, writes...)
+		if err != nil {
+			return
+		}
+	}
+
+	rsp.Written = true
+	return
+}
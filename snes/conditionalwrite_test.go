@@ -0,0 +1,50 @@
+package snes
+
+import "testing"
+
+func TestMatchMemoryCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition MemoryCondition
+		data      []byte
+		want      bool
+	}{
+		{
+			name:      "match",
+			condition: MemoryCondition{Expected: []byte{0x12, 0x34}},
+			data:      []byte{0x12, 0x34},
+			want:      true,
+		},
+		{
+			name:      "mismatch",
+			condition: MemoryCondition{Expected: []byte{0x12, 0x34}},
+			data:      []byte{0x12, 0x35},
+			want:      false,
+		},
+		{
+			name:      "masked match",
+			condition: MemoryCondition{Expected: []byte{0x12, 0x30}, Mask: []byte{0xFF, 0xF0}},
+			data:      []byte{0x12, 0x3F},
+			want:      true,
+		},
+		{
+			name:      "masked mismatch",
+			condition: MemoryCondition{Expected: []byte{0x12, 0x30}, Mask: []byte{0xFF, 0xF0}},
+			data:      []byte{0x12, 0x4F},
+			want:      false,
+		},
+		{
+			name:      "short read",
+			condition: MemoryCondition{Expected: []byte{0x12, 0x34}},
+			data:      []byte{0x12},
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchMemoryCondition(&tt.condition, tt.data); got != tt.want {
+				t.Errorf("MatchMemoryCondition() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -20,6 +20,8 @@ type DeviceOpener func(uri *url.URL) (Device, error)
 
 type deviceContainer struct {
 	opener DeviceOpener
+	// driverName labels metrics and is taken from the scheme of the first opened device URI
+	driverName string
 
 	// track opened devices by URI
 	devicesRw  sync.RWMutex
@@ -56,10 +58,18 @@ func (b *deviceContainer) GetDevice(deviceKey string) (Device, bool) {
 
 func (b *deviceContainer) PutDevice(deviceKey string, device Device) {
 	b.devicesRw.Lock()
-	b.devicesMap[deviceKey] = device
+	b.putUnderLock(deviceKey, device)
 	b.devicesRw.Unlock()
 }
 
+func (b *deviceContainer) putUnderLock(deviceKey string, device Device) {
+	if _, exists := b.devicesMap[deviceKey]; !exists {
+		deviceOpens.Inc(b.driverName)
+		devicesOpen.Inc(b.driverName)
+	}
+	b.devicesMap[deviceKey] = device
+}
+
 func (b *deviceContainer) DeleteDevice(deviceKey string) {
 	b.devicesRw.Lock()
 	b.deleteUnderLock(deviceKey)
@@ -70,11 +80,19 @@ func (b *deviceContainer) deleteUnderLock(deviceKey string) {
 	if b.devicesMap == nil {
 		b.devicesMap = make(map[string]Device)
 	}
+	if _, exists := b.devicesMap[deviceKey]; !exists {
+		return
+	}
 	delete(b.devicesMap, deviceKey)
+	deviceCloses.Inc(b.driverName)
+	devicesOpen.Dec(b.driverName)
 }
 
 func (b *deviceContainer) OpenDevice(deviceKey string, uri *url.URL) (device Device, err error) {
 	b.devicesRw.Lock()
+	if b.driverName == "" {
+		b.driverName = uri.Scheme
+	}
 	device, err = b.opener(uri)
 	if err != nil {
 		b.deleteUnderLock(deviceKey)
@@ -82,7 +100,7 @@ func (b *deviceContainer) OpenDevice(deviceKey string, uri *url.URL) (device Dev
 		return
 	}
 
-	b.devicesMap[deviceKey] = device
+	b.putUnderLock(deviceKey, device)
 	b.devicesRw.Unlock()
 	return
 }
@@ -0,0 +1,216 @@
+package snes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// DeviceEventType values match those of sni.DeviceEventType
+type DeviceEventType int
+
+const (
+	DeviceAdded DeviceEventType = iota
+	DeviceRemoved
+	DeviceChanged
+)
+
+func (t DeviceEventType) String() string {
+	switch t {
+	case DeviceAdded:
+		return "added"
+	case DeviceRemoved:
+		return "removed"
+	case DeviceChanged:
+		return "changed"
+	default:
+		return fmt.Sprintf("DeviceEventType(%d)", int(t))
+	}
+}
+
+// DeviceEvent describes a change to the set of detected devices. Devices are identified by DeviceDescriptor.Uri.
+type DeviceEvent struct {
+	Type   DeviceEventType
+	Device DeviceDescriptor
+}
+
+// DeviceEventSubscription queues up DeviceEvents published by the device detector.
+type DeviceEventSubscription struct {
+	mu     sync.Mutex
+	queue  []DeviceEvent
+	notify chan struct{}
+}
+
+var (
+	// detectMu serializes detection so that events are published in a consistent order:
+	detectMu sync.Mutex
+
+	devicesMu        sync.Mutex
+	detectedDevices  []DeviceDescriptor
+	devicesByDriver  = make(map[string][]DeviceDescriptor)
+	deviceEventsSubs = make(map[*DeviceEventSubscription]struct{})
+
+	startDetectorOnce sync.Once
+)
+
+// StartDeviceDetector starts a background loop that calls DetectDevices every period.
+func StartDeviceDetector(period time.Duration) {
+	startDetectorOnce.Do(func() {
+		go func() {
+			for {
+				_, _ = DetectDevices()
+				time.Sleep(period)
+			}
+		}()
+	})
+}
+
+// DetectDevices calls Detect on all registered drivers and publishes DeviceEvents for any devices added, removed,
+// or changed since the last detection. If a driver fails to detect, its previously detected devices are kept and
+// the first such error is returned along with the full list of devices.
+func DetectDevices() (descriptors []DeviceDescriptor, err error) {
+	detectMu.Lock()
+	defer detectMu.Unlock()
+
+	drivers := Drivers()
+	detected := make(map[string][]DeviceDescriptor, len(drivers))
+	for _, named := range drivers {
+		d, derr := named.Driver.Detect()
+		if derr != nil {
+			log.Printf("detector: error detecting from driver '%s': %v\n", named.Name, derr)
+			if err == nil {
+				err = derr
+			}
+
+			devicesMu.Lock()
+			d = devicesByDriver[named.Name]
+			devicesMu.Unlock()
+		}
+		detected[named.Name] = d
+	}
+
+	descriptors = make([]DeviceDescriptor, 0, 10)
+	for _, named := range drivers {
+		descriptors = append(descriptors, detected[named.Name]...)
+	}
+
+	devicesMu.Lock()
+	defer devicesMu.Unlock()
+
+	events := diffDevices(detectedDevices, descriptors)
+	devicesByDriver = detected
+	detectedDevices = descriptors
+
+	if len(events) == 0 {
+		return
+	}
+	for s := range deviceEventsSubs {
+		s.publish(events)
+	}
+
+	return
+}
+
+// DetectedDevices returns the devices found by the most recent detection.
+func DetectedDevices() []DeviceDescriptor {
+	devicesMu.Lock()
+	defer devicesMu.Unlock()
+	return detectedDevices
+}
+
+// SubscribeDeviceEvents returns the devices found by the most recent detection and a subscription that receives all
+// DeviceEvents published afterwards. Call Close when the subscription is no longer needed.
+func SubscribeDeviceEvents() ([]DeviceDescriptor, *DeviceEventSubscription) {
+	s := &DeviceEventSubscription{
+		notify: make(chan struct{}, 1),
+	}
+
+	devicesMu.Lock()
+	defer devicesMu.Unlock()
+	deviceEventsSubs[s] = struct{}{}
+
+	return detectedDevices, s
+}
+
+func (s *DeviceEventSubscription) publish(events []DeviceEvent) {
+	s.mu.Lock()
+	s.queue = append(s.queue, events...)
+	s.mu.Unlock()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Next waits for and returns all events queued up since the last call to Next.
+func (s *DeviceEventSubscription) Next(ctx context.Context) ([]DeviceEvent, error) {
+	for {
+		s.mu.Lock()
+		events := s.queue
+		s.queue = nil
+		s.mu.Unlock()
+
+		if len(events) > 0 {
+			return events, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.notify:
+		}
+	}
+}
+
+// Close stops delivery of events to the subscription.
+func (s *DeviceEventSubscription) Close() {
+	devicesMu.Lock()
+	defer devicesMu.Unlock()
+	delete(deviceEventsSubs, s)
+}
+
+func diffDevices(prev, curr []DeviceDescriptor) (events []DeviceEvent) {
+	prevByUri := make(map[string]*DeviceDescriptor, len(prev))
+	for i := range prev {
+		prevByUri[prev[i].Uri.String()] = &prev[i]
+	}
+	currByUri := make(map[string]struct{}, len(curr))
+
+	for i := range curr {
+		uri := curr[i].Uri.String()
+		currByUri[uri] = struct{}{}
+
+		p, ok := prevByUri[uri]
+		if !ok {
+			events = append(events, DeviceEvent{Type: DeviceAdded, Device: curr[i]})
+		} else if !sameDevice(p, &curr[i]) {
+			events = append(events, DeviceEvent{Type: DeviceChanged, Device: curr[i]})
+		}
+	}
+
+	for i := range prev {
+		if _, ok := currByUri[prev[i].Uri.String()]; !ok {
+			events = append(events, DeviceEvent{Type: DeviceRemoved, Device: prev[i]})
+		}
+	}
+
+	return
+}
+
+func sameDevice(a, b *DeviceDescriptor) bool {
+	if a.DisplayName != b.DisplayName || a.Kind != b.Kind || a.DefaultAddressSpace != b.DefaultAddressSpace {
+		return false
+	}
+	if len(a.Capabilities) != len(b.Capabilities) {
+		return false
+	}
+	for i := range a.Capabilities {
+		if a.Capabilities[i] != b.Capabilities[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,58 @@
+package snes
+
+import (
+	"net/url"
+	"reflect"
+	"sni/protos/sni"
+	"testing"
+)
+
+func Test_diffDevices(t *testing.T) {
+	a := DeviceDescriptor{Uri: url.URL{Scheme: "fxpakpro", Host: ".", Path: "/COM4"}, DisplayName: "COM4", Kind: "fxpakpro"}
+	b := DeviceDescriptor{Uri: url.URL{Scheme: "ra", Host: "127.0.0.1:55355"}, DisplayName: "RetroArch", Kind: "retroarch"}
+	b2 := b
+	b2.Capabilities = []sni.DeviceCapability{sni.DeviceCapability_ReadMemory}
+
+	type args struct {
+		prev []DeviceDescriptor
+		curr []DeviceDescriptor
+	}
+	tests := []struct {
+		name string
+		args args
+		want []DeviceEvent
+	}{
+		{
+			name: "none",
+			args: args{nil, nil},
+			want: nil,
+		},
+		{
+			name: "added",
+			args: args{[]DeviceDescriptor{a}, []DeviceDescriptor{a, b}},
+			want: []DeviceEvent{{Type: DeviceAdded, Device: b}},
+		},
+		{
+			name: "removed",
+			args: args{[]DeviceDescriptor{a, b}, []DeviceDescriptor{b}},
+			want: []DeviceEvent{{Type: DeviceRemoved, Device: a}},
+		},
+		{
+			name: "changed",
+			args: args{[]DeviceDescriptor{a, b}, []DeviceDescriptor{a, b2}},
+			want: []DeviceEvent{{Type: DeviceChanged, Device: b2}},
+		},
+		{
+			name: "unchanged",
+			args: args{[]DeviceDescriptor{a, b}, []DeviceDescriptor{a, b}},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diffDevices(tt.args.prev, tt.args.curr); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffDevices() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"google.golang.org/grpc/codes"
 	"io"
 	"log"
 	"net"
@@ -230,6 +231,19 @@ func parseResponse(r *bufio.Reader) (bin []byte, ascii []map[string]string, err
 	return
 }
 
+// checkMemoryType fails for the FX Pak Pro's MISC, PPUREG and CPUREG snapshots which have no emulator memory
+// counterpart; all other memory types are passed through as emulator memory names
+func checkMemoryType(memType mapping.MemoryType) error {
+	switch memType {
+	case mapping.MemoryTypeMISC, mapping.MemoryTypePPUREG, mapping.MemoryTypeCPUREG:
+		return snes.WithCode(
+			codes.Unimplemented,
+			fmt.Errorf("emunw: %s memory is not accessible from the emulator", memType),
+		)
+	}
+	return nil
+}
+
 type memRegion struct {
 	mapping.MemoryType
 	Offset uint32
@@ -253,6 +267,10 @@ func (c *Client) MultiReadMemory(ctx context.Context, reads ...snes.MemoryReadRe
 	for j, read := range reads {
 		a := &read.RequestAddress
 		memType, pakAddress, offset := mapping.MemoryTypeFor(a)
+		if err = checkMemoryType(memType); err != nil {
+			mrsp = nil
+			return
+		}
 
 		mrsp[j].RequestAddress = read.RequestAddress
 		mrsp[j].DeviceAddress = snes.AddressTuple{
@@ -346,6 +364,10 @@ func (c *Client) MultiWriteMemory(ctx context.Context, writes ...snes.MemoryWrit
 	for j, write := range writes {
 		a := &write.RequestAddress
 		memType, pakAddress, offset := mapping.MemoryTypeFor(a)
+		if err = checkMemoryType(memType); err != nil {
+			mrsp = nil
+			return
+		}
 
 		mrsp[j].RequestAddress = write.RequestAddress
 		mrsp[j].DeviceAddress = snes.AddressTuple{
@@ -3,7 +3,12 @@ package emunw
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
 	"reflect"
+	"sni/protos/sni"
+	"sni/snes"
 	"testing"
 )
 
@@ -88,3 +93,20 @@ func Test_parseResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_MultiReadMemory_Unimplemented(t *testing.T) {
+	c := &Client{}
+	for _, address := range []uint32{0xF90420, 0xF90500, 0xF90700} {
+		_, err := c.MultiReadMemory(context.Background(), snes.MemoryReadRequest{
+			RequestAddress: snes.AddressTuple{
+				Address:      address,
+				AddressSpace: sni.AddressSpace_FxPakPro,
+			},
+			Size: 0x10,
+		})
+		var coded *snes.CodedError
+		if !errors.As(err, &coded) || coded.Code != codes.Unimplemented {
+			t.Errorf("MultiReadMemory(0x%06x) error = %v, want Unimplemented", address, err)
+		}
+	}
+}
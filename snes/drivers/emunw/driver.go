@@ -209,6 +209,9 @@ func DriverInit() {
 		log.Printf("enabling emunw detector logging")
 	}
 
+	// emulator reads are cheap so only coalesce when configured:
+	snes.ConfigureReadCache(driverName, "SNI_EMUNW_READ_CACHE_FRAMES", 0)
+
 	// register the driver:
 	driver = NewDriver(addresses)
 	snes.Register(driverName, driver)
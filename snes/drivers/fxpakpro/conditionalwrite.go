@@ -0,0 +1,246 @@
+package fxpakpro
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sni/protos/sni"
+	"sni/snes"
+	"sni/snes/asm"
+	"sni/snes/mapping"
+	"strings"
+)
+
+func isWRAM(addr uint32, size int) bool {
+	return addr >= 0xF50000 && addr+uint32(size) <= 0xF70000
+}
+
+// ConditionalWriteMemory compares and writes WRAM atomically within a single frame using the NMI EXE feature.
+func (d *Device) ConditionalWriteMemory(
+	ctx context.Context,
+	conditions []snes.MemoryCondition,
+	writes []snes.MemoryWriteRequest,
+) (rsp snes.ConditionalWriteResponse, err error) {
+	// translate all addresses into FX Pak Pro space:
+	pakConditions := make([]snes.MemoryCondition, len(conditions))
+	for i, c := range conditions {
+		pakConditions[i] = c
+		pakConditions[i].RequestAddress = snes.AddressTuple{
+			AddressSpace:  sni.AddressSpace_FxPakPro,
+			MemoryMapping: c.RequestAddress.MemoryMapping,
+		}
+		pakConditions[i].RequestAddress.Address, err = mapping.TranslateAddress(c.RequestAddress, sni.AddressSpace_FxPakPro)
+		if err != nil {
+			return
+		}
+		if !isWRAM(pakConditions[i].RequestAddress.Address, len(c.Expected)) {
+			err = snes.WithCode(codes.InvalidArgument, fmt.Errorf("fxpakpro: condition[%d] must be entirely within WRAM", i))
+			return
+		}
+	}
+
+	pakWrites := make([]snes.MemoryWriteRequest, len(writes))
+	writeResponses := make([]snes.MemoryWriteResponse, len(writes))
+	for i, w := range writes {
+		if len(w.Data) == 0 {
+			err = snes.WithCode(codes.InvalidArgument, fmt.Errorf("fxpakpro: write[%d] must have data", i))
+			return
+		}
+
+		pakWrites[i] = snes.MemoryWriteRequest{
+			RequestAddress: snes.AddressTuple{
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: w.RequestAddress.MemoryMapping,
+			},
+			Data: w.Data,
+		}
+		pakWrites[i].RequestAddress.Address, err = mapping.TranslateAddress(w.RequestAddress, sni.AddressSpace_FxPakPro)
+		if err != nil {
+			return
+		}
+		if !isWRAM(pakWrites[i].RequestAddress.Address, len(w.Data)) {
+			err = snes.WithCode(codes.InvalidArgument, fmt.Errorf("fxpakpro: write[%d] must be entirely within WRAM", i))
+			return
+		}
+
+		writeResponses[i] = snes.MemoryWriteResponse{
+			RequestAddress: w.RequestAddress,
+			DeviceAddress:  pakWrites[i].RequestAddress,
+			Size:           len(w.Data),
+		}
+	}
+
+	var a asm.Emitter
+	a.Code = &bytes.Buffer{}
+	a.Text = &strings.Builder{}
+	resultsAddr := GenerateConditionalWriteAsm(&a, pakConditions, pakWrites)
+
+	if actual, expected := a.Code.Len(), nmiExeMaxSize; actual > expected {
+		err = snes.WithCode(codes.InvalidArgument, fmt.Errorf(
+			"fxpakpro: too many conditions or too much WRAM data for the snescmd buffer; %d > %d",
+			actual,
+			expected,
+		))
+		return
+	}
+
+	subctx := ctx
+	if shouldLock(ctx) {
+		defer d.lock.Unlock()
+		d.lock.Lock()
+		subctx = context.WithValue(ctx, lockedKey, &struct{}{})
+	}
+
+	err = d.executeNMIEXE(subctx, a.Code.Bytes())
+	if err != nil {
+		return
+	}
+
+	// read back the condition results; the last byte is set if any condition failed:
+	results := make([]byte, len(conditions)+1)
+	err = d.vget(subctx, SpaceCMD, vgetChunk{addr: resultsAddr, size: byte(len(results)), target: results})
+	if err != nil {
+		return
+	}
+
+	for i := range conditions {
+		if results[i] != 0 {
+			rsp.FailedConditions = append(rsp.FailedConditions, i)
+		}
+	}
+	if results[len(conditions)] == 0 {
+		rsp.Written = true
+		rsp.Writes = writeResponses
+	}
+
+	return
+}
+
+// GenerateConditionalWriteAsm generates NMI EXE code for the $2C00 snescmd buffer that compares WRAM against all
+// conditions and only if all match performs the writes to WRAM. All addresses must be in FX Pak Pro space within WRAM.
+// The comparison results are stored in the snescmd buffer at the returned address; one byte per condition set to
+// non-zero if it failed to match followed by a byte set to non-zero if any condition failed.
+func GenerateConditionalWriteAsm(a *asm.Emitter, conditions []snes.MemoryCondition, writes []snes.MemoryWriteRequest) (resultsAddr uint32) {
+	// first pass to determine the code layout:
+	var sizer asm.Emitter
+	doneAddr, dataAddr := emitConditionalWriteAsm(&sizer, conditions, writes, 0, 0, 0)
+
+	// write data is followed by the results:
+	resultsAddr = dataAddr
+	for _, write := range writes {
+		resultsAddr += uint32(len(write.Data))
+	}
+
+	emitConditionalWriteAsm(a, conditions, writes, doneAddr, dataAddr, resultsAddr)
+
+	// copy in the data to be written to WRAM:
+	for _, write := range writes {
+		a.EmitBytes(write.Data)
+	}
+
+	// reserve zeroed space for the results:
+	a.Comment("results:")
+	a.EmitBytes(make([]byte, len(conditions)+1))
+
+	return
+}
+
+func emitConditionalWriteAsm(
+	a *asm.Emitter,
+	conditions []snes.MemoryCondition,
+	writes []snes.MemoryWriteRequest,
+	doneAddr, dataAddr, resultsAddr uint32,
+) (doneAddrOut, dataAddrOut uint32) {
+	anyFailedAddr := resultsAddr + uint32(len(conditions))
+
+	a.SetBase(0x002C00)
+
+	// this NOP slide is necessary to avoid the problematic $2C00 address itself.
+	a.NOP()
+	a.NOP()
+
+	a.Comment("preserve registers:")
+	a.PHP()
+	a.REP(0x30)
+	a.PHA()
+	a.PHX()
+	a.PHY()
+	a.PHD()
+	a.PHB()
+
+	a.SEP(0x30)
+	for i, c := range conditions {
+		addr := 0x7E0000 + (c.RequestAddress.Address - 0xF50000)
+		a.Comment(fmt.Sprintf("condition[%d]: compare $%x bytes at $%06x", i, len(c.Expected), addr))
+		for j, expected := range c.Expected {
+			mask := byte(0xFF)
+			if c.Mask != nil {
+				mask = c.Mask[j]
+			}
+
+			a.LDA_long(addr + uint32(j))
+			if mask != 0xFF {
+				a.AND_imm8_b(mask)
+			}
+			a.CMP_imm8_b(expected & mask)
+			// skip over the failure marking:
+			a.BEQ(2 + 4 + 4)
+			a.LDA_imm8_b(0x01)
+			a.STA_long(resultsAddr + uint32(i))
+			a.STA_long(anyFailedAddr)
+		}
+	}
+
+	a.Comment("skip writes if any condition failed:")
+	a.LDA_long(anyFailedAddr)
+	a.BEQ(4)
+	a.JML(doneAddr)
+
+	// MVN affects B register but it is restored below:
+	a.REP(0x30)
+	srcOffs := uint16(dataAddr)
+	for _, write := range writes {
+		data := write.Data
+		size := uint16(len(data))
+		if size == 0 {
+			// MVN cannot copy zero bytes; a count of $FFFF copies 64KiB:
+			continue
+		}
+		targetFXPakProAddress := write.RequestAddress.Address
+		destBank := uint8(0x7E + (targetFXPakProAddress-0xF5_0000)>>16)
+		destOffs := uint16(targetFXPakProAddress & 0xFFFF)
+
+		a.Comment(fmt.Sprintf("transfer $%04x bytes from $00:%04x to $%02x:%04x", size, srcOffs, destBank, destOffs))
+		// A - Specifies the amount of bytes to transfer, minus 1
+		a.LDA_imm16_w(size - 1)
+		// X - Specifies the high and low bytes of the data source memory address
+		a.LDX_imm16_w(srcOffs)
+		// Y - Specifies the high and low bytes of the destination memory address
+		a.LDY_imm16_w(destOffs)
+		a.MVN(destBank, 0x00)
+
+		srcOffs += size
+	}
+
+	doneAddrOut = a.GetBase()
+	a.Comment("disable NMI vector override:")
+	a.SEP(0x30)
+	a.LDA_imm8_b(0x00)
+	a.STA_long(0x002C00)
+
+	a.Comment("restore registers:")
+	a.REP(0x30)
+	a.PLB()
+	a.PLD()
+	a.PLY()
+	a.PLX()
+	a.PLA()
+	a.PLP()
+
+	a.Comment("jump to original NMI:")
+	a.JMP_indirect(0xFFEA)
+
+	dataAddrOut = a.GetBase()
+	return
+}
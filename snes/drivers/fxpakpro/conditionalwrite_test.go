@@ -0,0 +1,49 @@
+package fxpakpro
+
+import (
+	"bytes"
+	"sni/protos/sni"
+	"sni/snes"
+	"sni/snes/asm"
+	"strings"
+	"testing"
+)
+
+func TestGenerateConditionalWriteAsm(t *testing.T) {
+	conditions := []snes.MemoryCondition{
+		{
+			RequestAddress: snes.AddressTuple{
+				Address:       0xF50010,
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: sni.MemoryMapping_LoROM,
+			},
+			Expected: []byte{0x05, 0x10},
+			Mask:     []byte{0xFF, 0xF0},
+		},
+	}
+	writes := []snes.MemoryWriteRequest{
+		{
+			RequestAddress: snes.AddressTuple{
+				Address:       0xF50010,
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: sni.MemoryMapping_LoROM,
+			},
+			Data: []byte{0x06},
+		},
+	}
+
+	var a asm.Emitter
+	a.Code = &bytes.Buffer{}
+	a.Text = &strings.Builder{}
+	resultsAddr := GenerateConditionalWriteAsm(&a, conditions, writes)
+	t.Log("\n" + a.Text.String())
+
+	// results must be placed at the very end of the code:
+	if actual, expected := resultsAddr+uint32(len(conditions)+1), uint32(0x2C00+a.Code.Len()); actual != expected {
+		t.Fatalf("results end $%04x != code end $%04x", actual, expected)
+	}
+	// write data must immediately precede the results:
+	if actual, expected := a.Code.Bytes()[resultsAddr-0x2C00-1], byte(0x06); actual != expected {
+		t.Fatalf("write data $%02x != $%02x", actual, expected)
+	}
+}
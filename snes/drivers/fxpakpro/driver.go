@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"go.bug.st/serial"
 	"go.bug.st/serial/enumerator"
+	"google.golang.org/grpc/codes"
 	"log"
 	"net/url"
 	"runtime"
@@ -13,10 +14,14 @@ import (
 	"sni/util/env"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 const (
 	driverName = "fxpakpro"
+
+	// simulatorHost is the URI host selecting the simulator instead of a serial port, i.e. `fxpakpro://sim`
+	simulatorHost = "sim"
 )
 
 var driver *Driver
@@ -44,6 +49,11 @@ const defaultAddressSpace = sni.AddressSpace_FxPakPro
 
 type Driver struct {
 	container snes.DeviceContainer
+
+	// listSimulator adds the simulator to detected devices and allows opening it:
+	listSimulator bool
+	simLock       sync.Mutex
+	sim           *Simulator
 }
 
 func (d *Driver) DisplayOrder() int {
@@ -66,6 +76,7 @@ var driverCapabilities = []sni.DeviceCapability{
 	sni.DeviceCapability_ResetSystem,
 	sni.DeviceCapability_ResetToMenu,
 	sni.DeviceCapability_ExecuteASM,
+	sni.DeviceCapability_FetchFields,
 	// filesystem:
 	sni.DeviceCapability_ReadDirectory,
 	sni.DeviceCapability_MakeDirectory,
@@ -119,10 +130,33 @@ func (d *Driver) Detect() (devices []snes.DeviceDescriptor, err error) {
 		}
 	}
 
+	if d.listSimulator {
+		devices = append(devices, snes.DeviceDescriptor{
+			Uri:                 url.URL{Scheme: driverName, Host: simulatorHost},
+			DisplayName:         "FX Pak Pro Simulator",
+			Kind:                d.Kind(),
+			Capabilities:        driverCapabilities[:],
+			DefaultAddressSpace: defaultAddressSpace,
+		})
+	}
+
 	err = nil
 	return
 }
 
+// simulator returns the simulator with its port (re)opened; the simulated SD card and memory persist across opens.
+func (d *Driver) simulator() *Simulator {
+	d.simLock.Lock()
+	defer d.simLock.Unlock()
+
+	if d.sim == nil {
+		d.sim = NewSimulator()
+	} else {
+		d.sim.open()
+	}
+	return d.sim
+}
+
 func (d *Driver) openPort(portName string, baudRequest int) (f serial.Port, err error) {
 	f = serial.Port(nil)
 
@@ -161,6 +195,10 @@ func (d *Driver) openPort(portName string, baudRequest int) (f serial.Port, err
 }
 
 func (d *Driver) DeviceKey(uri *url.URL) (key string) {
+	if uri.Host == simulatorHost {
+		return simulatorHost
+	}
+
 	key = uri.Path
 	// macos/linux paths:
 	if strings.HasPrefix(key, "/dev/") {
@@ -176,6 +214,17 @@ func (d *Driver) DeviceKey(uri *url.URL) (key string) {
 }
 
 func (d *Driver) openDevice(uri *url.URL) (device snes.Device, err error) {
+	if uri.Host == simulatorHost {
+		if !d.listSimulator {
+			err = snes.WithCode(codes.FailedPrecondition, fmt.Errorf("fxpakpro: simulator is disabled; set SNI_FXPAKPRO_SIM=1 to enable it"))
+			return
+		}
+		dev := &Device{f: d.simulator()}
+		err = dev.Init()
+		device = dev
+		return
+	}
+
 	portName := uri.Path
 
 	var baudRequest int
@@ -226,7 +275,12 @@ func DriverInit() {
 		)
 	}
 
-	driver = &Driver{}
+	driver = &Driver{
+		listSimulator: util.IsTruthy(env.GetOrDefault("SNI_FXPAKPRO_SIM", "0")),
+	}
 	driver.container = snes.NewDeviceDriverContainer(driver.openDevice)
+	// every VGET costs a USB round-trip so coalesce reads within a frame by default:
+	snes.ConfigureReadCache(driverName, "SNI_FXPAKPRO_READ_CACHE_FRAMES", 1)
+
 	snes.Register(driverName, driver)
 }
@@ -0,0 +1,111 @@
+package fxpakpro
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sni/snes"
+	"sni/snes/asm"
+	"strings"
+)
+
+// executeAsmWrapperSize is the size of the ASM code emitted by GenerateExecuteAsm before the routine:
+const executeAsmWrapperSize = 0x2F
+
+func (d *Device) ExecuteASM(ctx context.Context, code []byte, data []byte) (err error) {
+	if len(code) == 0 {
+		return snes.WithCode(codes.InvalidArgument, fmt.Errorf("fxpakpro: ASM routine must not be empty"))
+	}
+	if actual, expected := executeAsmWrapperSize+len(code)+len(data), nmiExeMaxSize; actual > expected {
+		return snes.WithCode(codes.InvalidArgument, fmt.Errorf(
+			"fxpakpro: ASM routine and data do not fit in the snescmd buffer; %d > %d",
+			actual,
+			expected,
+		))
+	}
+
+	var a asm.Emitter
+	a.Code = &bytes.Buffer{}
+	a.Text = &strings.Builder{}
+	GenerateExecuteAsm(&a, code, data)
+
+	if shouldLock(ctx) {
+		defer d.lock.Unlock()
+		d.lock.Lock()
+		ctx = context.WithValue(ctx, lockedKey, &struct{}{})
+	}
+
+	err = d.executeNMIEXE(ctx, a.Code.Bytes())
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = snes.WithCode(codes.DeadlineExceeded, fmt.Errorf("fxpakpro: ASM routine did not complete: %w", err))
+		return
+	}
+
+	return
+}
+
+// GenerateExecuteAsm generates NMI EXE code for the $2C00 snescmd buffer that calls the given routine once with JSL
+// and then returns to the original NMI handler. The routine is placed immediately after the generated code and the
+// data immediately after the routine. The routine is entered with 16-bit A, X, and Y registers, data bank $00,
+// X holding the address of the data in bank $00 and Y holding the size of the data. It must return with RTL.
+// All registers, the direct page, data bank, and processor status are restored after it returns.
+func GenerateExecuteAsm(a *asm.Emitter, code []byte, data []byte) {
+	routineAddr := uint32(0x2C00 + executeAsmWrapperSize)
+	dataAddr := routineAddr + uint32(len(code))
+
+	a.SetBase(0x002C00)
+
+	// this NOP slide is necessary to avoid the problematic $2C00 address itself.
+	a.NOP()
+	a.NOP()
+
+	a.Comment("preserve registers:")
+	a.PHP()
+	a.REP(0x30)
+	a.PHA()
+	a.PHX()
+	a.PHY()
+	a.PHD()
+	a.PHB()
+
+	a.Comment("set data bank to $00:")
+	a.SEP(0x20)
+	a.LDA_imm8_b(0x00)
+	a.PHA()
+	a.PLB()
+	a.REP(0x20)
+
+	a.Comment(fmt.Sprintf("call routine with $%04x bytes of data at $00:%04x", len(data), dataAddr))
+	a.LDX_imm16_w(uint16(dataAddr))
+	a.LDY_imm16_w(uint16(len(data)))
+	a.JSL(routineAddr)
+
+	a.Comment("disable NMI vector override:")
+	a.SEP(0x30)
+	a.LDA_imm8_b(0x00)
+	a.STA_long(0x002C00)
+
+	a.Comment("restore registers:")
+	a.REP(0x30)
+	a.PLB()
+	a.PLD()
+	a.PLY()
+	a.PLX()
+	a.PLA()
+	a.PLP()
+
+	a.Comment("jump to original NMI:")
+	a.JMP_indirect(0xFFEA)
+
+	// bug check: make sure emitted code is the expected size
+	if actual, expected := a.Code.Len(), executeAsmWrapperSize; actual != expected {
+		panic(fmt.Errorf("bug check: emitted code size %d != %d", actual, expected))
+	}
+
+	a.Comment("routine:")
+	a.EmitBytes(code)
+	a.Comment("data:")
+	a.EmitBytes(data)
+}
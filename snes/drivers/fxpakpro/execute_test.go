@@ -0,0 +1,27 @@
+package fxpakpro
+
+import (
+	"bytes"
+	"sni/snes/asm"
+	"strings"
+	"testing"
+)
+
+func TestGenerateExecuteAsm(t *testing.T) {
+	// SEP #$20; LDA #$01; STA.l $7E0010; RTL
+	code := []byte{0xE2, 0x20, 0xA9, 0x01, 0x8F, 0x10, 0x00, 0x7E, 0x6B}
+	data := []byte{0x01, 0x02, 0x03}
+
+	var a asm.Emitter
+	a.Code = &bytes.Buffer{}
+	a.Text = &strings.Builder{}
+	GenerateExecuteAsm(&a, code, data)
+	t.Log("\n" + a.Text.String())
+
+	if actual, expected := a.Code.Len(), executeAsmWrapperSize+len(code)+len(data); actual != expected {
+		t.Fatalf("code size %d != %d", actual, expected)
+	}
+	if !bytes.Equal(a.Code.Bytes()[executeAsmWrapperSize:], append(code, data...)) {
+		t.Fatal("routine and data must follow the wrapper code")
+	}
+}
@@ -70,10 +70,7 @@ func (d *Device) get(ctx context.Context, space space, address uint32, size uint
 	}
 
 	// read the response:
-	paddedSize := size
-	if size&511 != 0 {
-		paddedSize = size&512 + 512
-	}
+	paddedSize := (size + 511) &^ 511
 
 	data = make([]byte, paddedSize)
 	err = recvSerial(ctx, d.f, data, int(paddedSize))
@@ -1,7 +1,10 @@
 package fxpakpro
 
 import (
+	"bytes"
 	"context"
+	"sni/snes"
+	"sni/snes/snestest"
 	"testing"
 )
 
@@ -47,6 +50,37 @@ func TestDevice_get(t *testing.T) {
 	}
 }
 
+// TestDevice_get_padding checks that GET responses longer than one 512 byte block are read up to the next block
+// boundary; reading only one block cut the data short and left the rest of the response in the serial stream.
+func TestDevice_get_padding(t *testing.T) {
+	ctx := context.Background()
+	d := openSimulatedDevice(t)
+
+	data := make([]byte, 0x800)
+	for i := range data {
+		data[i] = byte(i*7 + 1)
+	}
+	_, err := d.MultiWriteMemory(ctx, snes.MemoryWriteRequest{RequestAddress: snestest.PakAddress(0xE00000), Data: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, size := range []uint32{0x1FF, 0x200, 0x201, 0x3FF, 0x401, 0x600, 0x7FF} {
+		gotData, err := d.get(ctx, SpaceSNES, 0xE00000, size)
+		if err != nil {
+			t.Fatalf("get(size $%x) error = %v", size, err)
+		}
+		if !bytes.Equal(gotData, data[:size]) {
+			t.Fatalf("get(size $%x) returned the wrong data", size)
+		}
+
+		// the next command reads its own response:
+		if actual := snestest.MustReadPak(t, d, 0xE00010, 2); !bytes.Equal(actual, data[0x10:0x12]) {
+			t.Fatalf("read after get(size $%x) = %x, want %x", size, actual, data[0x10:0x12])
+		}
+	}
+}
+
 func BenchmarkDevice_get(b *testing.B) {
 	d := openExactDevice(b)
 	defer d.Close()
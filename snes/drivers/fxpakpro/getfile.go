@@ -75,13 +75,15 @@ func (d *Device) getFile(ctx context.Context, path string, w io.Writer, sizeRece
 
 	// read all remaining bytes in chunks of 512 bytes:
 	chunk := make([]byte, 512)
-	chunkCount := size / 512
+
+	// once aborted, the remaining data must still be read from the fxpakpro to leave the device usable:
+	var abortErr error
 
 	received = 0
 	if progress != nil {
 		progress(received, size)
 	}
-	for i := uint32(0); i < chunkCount; i++ {
+	for remaining := size; remaining > 0; {
 		err = readExact(ctx, d.f, 512, chunk)
 		if err != nil {
 			received = 0
@@ -89,48 +91,39 @@ func (d *Device) getFile(ctx context.Context, path string, w io.Writer, sizeRece
 			return
 		}
 
-		var n int
-		n, err = w.Write(chunk)
-		if err != nil {
-			err = d.NonFatalError(err)
-			return
-		}
-		if n != 512 {
-			err = d.NonFatalError(fmt.Errorf("fxpakpro: getFile: wrote only %d bytes out of %d byte chunk to io.Writer", n, 512))
-			return
+		m := uint32(512)
+		if remaining < m {
+			m = remaining
 		}
-		received += 512
+		remaining -= m
 
-		if progress != nil {
-			progress(received, size)
+		if abortErr == nil {
+			abortErr = ctx.Err()
 		}
-	}
-
-	remainder := int(size & 511)
-	if remainder != 0 {
-		err = readExact(ctx, d.f, 512, chunk)
-		if err != nil {
-			received = 0
-			err = d.FatalError(err)
-			return
+		if abortErr != nil {
+			continue
 		}
 
 		var n int
-		n, err = w.Write(chunk[:remainder])
-		if err != nil {
-			err = d.NonFatalError(err)
-			return
+		n, abortErr = w.Write(chunk[:m])
+		if abortErr != nil {
+			continue
 		}
-		if n != remainder {
-			err = d.NonFatalError(fmt.Errorf("fxpakpro: getFile: wrote only %d bytes out of %d byte chunk to io.Writer", n, remainder))
-			return
+		if n != int(m) {
+			abortErr = fmt.Errorf("fxpakpro: getFile: wrote only %d bytes out of %d byte chunk to io.Writer", n, m)
+			continue
 		}
-		received += uint32(remainder)
+		received += m
 
 		if progress != nil {
 			progress(received, size)
 		}
 	}
 
+	if abortErr != nil {
+		err = d.NonFatalError(abortErr)
+		return
+	}
+
 	return
 }
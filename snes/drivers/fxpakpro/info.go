@@ -6,7 +6,7 @@ import (
 	"sni/snes"
 )
 
-func (d *Device) FetchFields(ctx context.Context, fields ...snes.Field) (values []string, err error) {
+func (d *Device) FetchFields(ctx context.Context, fields ...snes.Field) (values []snes.FieldValue, err error) {
 	var version string
 	var deviceName string
 	var rom string
@@ -19,17 +19,17 @@ func (d *Device) FetchFields(ctx context.Context, fields ...snes.Field) (values
 	for _, field := range fields {
 		switch field {
 		case snes.Field_DeviceName:
-			values = append(values, deviceName)
+			values = append(values, snes.FieldValue{Value: deviceName})
 			break
 		case snes.Field_DeviceVersion:
-			values = append(values, version)
+			values = append(values, snes.FieldValue{Value: version})
 			break
 		case snes.Field_RomFileName:
-			values = append(values, rom)
+			values = append(values, snes.FieldValue{Value: rom})
 			break
 		default:
-			// unknown value; append empty string to maintain index association:
-			values = append(values, "")
+			// unsupported value; append marker to maintain index association:
+			values = append(values, snes.FieldUnsupported)
 			break
 		}
 	}
@@ -245,7 +245,7 @@ func (d *Device) MultiWriteMemory(
 
 		//log.Print("\n" + a.Text.String())
 
-		if actual, expected := a.Code.Len(), 1024; actual > expected {
+		if actual, expected := a.Code.Len(), nmiExeMaxSize; actual > expected {
 			return nil, fmt.Errorf(
 				"fxpakpro: too much WRAM data for the snescmd buffer; %d > %d",
 				actual,
@@ -253,73 +253,86 @@ func (d *Device) MultiWriteMemory(
 			)
 		}
 
-		chunks := make([]vputChunk, 0, 8)
-		startAddr := uint32(0x2C00)
-		addr := startAddr
-		data := a.Code.Bytes()
-		size := len(data)
-		for size > 0 {
-			chunkSize := 255
-			if size < chunkSize {
-				chunkSize = size
-			}
+		err = d.executeNMIEXE(subctx, a.Code.Bytes())
+		if err != nil {
+			return
+		}
+	}
 
-			// 4-byte struct: 1 byte size, 3 byte address
-			chunks = append(chunks, vputChunk{
-				addr: addr,
-				// target offset to write to in Data[] for MemoryWriteResponse:
-				data: data[int(addr-startAddr) : int(addr-startAddr)+chunkSize],
-			})
+	return
+}
 
-			size -= 255
-			addr += 255
+// nmiExeMaxSize is the size of the snescmd buffer at $2C00 available for NMI EXE code
+const nmiExeMaxSize = 1024
+
+// executeNMIEXE uploads code to the NMI EXE buffer at $2C00 in CMD space and waits for the SNES to execute it
+// on the next NMI. The code must begin with a non-zero byte to enable the NMI vector override and must write $00
+// to $2C00 when done to disable it.
+func (d *Device) executeNMIEXE(ctx context.Context, code []byte) (err error) {
+	chunks := make([]vputChunk, 0, 8)
+	startAddr := uint32(0x2C00)
+	addr := startAddr
+	size := len(code)
+	for size > 0 {
+		chunkSize := 255
+		if size < chunkSize {
+			chunkSize = size
 		}
 
-		if actual, expected := len(chunks), 8; actual > expected {
-			return nil, fmt.Errorf(
-				"fxpakpro: too many VPUT chunks to write WRAM data with; %d > %d",
-				actual,
-				expected,
-			)
-		}
+		// 4-byte struct: 1 byte size, 3 byte address
+		chunks = append(chunks, vputChunk{
+			addr: addr,
+			data: code[int(addr-startAddr) : int(addr-startAddr)+chunkSize],
+		})
 
-		// await 5 seconds in game-frames for NMI EXE:
-		awaitctx, awaitcancel := context.WithTimeout(subctx, timing.Frame*60*5)
-		defer awaitcancel()
-
-		// VGET to await NMI EXE availability:
-		{
-			var ok bool
-			ok, err = d.awaitNMIEXE(awaitctx)
-			if err != nil {
-				err = fmt.Errorf("fxpakpro: could not acquire NMI EXE pre-write: %w", err)
-				return
-			}
-			if !ok {
-				err = fmt.Errorf("fxpakpro: could not acquire NMI EXE pre-write")
-				return
-			}
-		}
+		size -= 255
+		addr += 255
+	}
 
-		// VPUT command to CMD space:
-		err = d.vput(awaitctx, SpaceCMD, chunks...)
+	if actual, expected := len(chunks), 8; actual > expected {
+		return fmt.Errorf(
+			"fxpakpro: too many VPUT chunks to write NMI EXE code with; %d > %d",
+			actual,
+			expected,
+		)
+	}
+
+	// await 5 seconds in game-frames for NMI EXE:
+	awaitctx, awaitcancel := context.WithTimeout(ctx, timing.Frame*60*5)
+	defer awaitcancel()
+
+	// VGET to await NMI EXE availability:
+	{
+		var ok bool
+		ok, err = d.awaitNMIEXE(awaitctx)
 		if err != nil {
-			err = fmt.Errorf("fxpakpro: could not VPUT to NMI EXE: %w", err)
+			err = fmt.Errorf("fxpakpro: could not acquire NMI EXE pre-write: %w", err)
 			return
 		}
+		if !ok {
+			err = fmt.Errorf("fxpakpro: could not acquire NMI EXE pre-write")
+			return
+		}
+	}
 
-		// await NMI EXE availability to validate the write was completed:
-		{
-			var ok bool
-			ok, err = d.awaitNMIEXE(awaitctx)
-			if err != nil {
-				err = fmt.Errorf("fxpakpro: could not acquire NMI EXE post-write: %w", err)
-				return
-			}
-			if !ok {
-				err = fmt.Errorf("fxpakpro: could not acquire NMI EXE post-write")
-				return
-			}
+	// VPUT command to CMD space:
+	err = d.vput(awaitctx, SpaceCMD, chunks...)
+	if err != nil {
+		err = fmt.Errorf("fxpakpro: could not VPUT to NMI EXE: %w", err)
+		return
+	}
+
+	// await NMI EXE availability to validate the write was completed:
+	{
+		var ok bool
+		ok, err = d.awaitNMIEXE(awaitctx)
+		if err != nil {
+			err = fmt.Errorf("fxpakpro: could not acquire NMI EXE post-write: %w", err)
+			return
+		}
+		if !ok {
+			err = fmt.Errorf("fxpakpro: could not acquire NMI EXE post-write")
+			return
 		}
 	}
 
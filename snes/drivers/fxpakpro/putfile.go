@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"log"
 	"sni/snes"
 )
 
@@ -28,9 +29,11 @@ func (d *Device) putFile(ctx context.Context, path string, size uint32, r io.Rea
 	// size of ROM contents:
 	binary.BigEndian.PutUint32(sb[252:], size)
 
+	subctx := ctx
 	if shouldLock(ctx) {
 		defer d.lock.Unlock()
 		d.lock.Lock()
+		subctx = context.WithValue(ctx, lockedKey, &struct{}{})
 	}
 
 	// send command:
@@ -78,11 +81,50 @@ func (d *Device) putFile(ctx context.Context, path string, size uint32, r io.Rea
 	}
 
 	// send data:
-	n, err = sendSerialProgress(d.f, 512, size, r, progress)
+	ar := &abortableReader{ctx: ctx, r: r}
+	n, err = sendSerialProgress(d.f, 512, size, ar, progress)
 	if err != nil {
 		err = d.FatalError(err)
 		return
 	}
 
+	if ar.err != nil {
+		// the transfer was aborted so remove the partially written file:
+		if rmErr := d.rm(subctx, path); rmErr != nil {
+			log.Printf("%s: putFile: could not remove partial file '%s' after abort: %v\n", driverName, path, rmErr)
+		}
+		n, err = ar.n, d.NonFatalError(fmt.Errorf("putfile: transfer aborted: %w", ar.err))
+		return
+	}
+
 	return
 }
+
+// abortableReader substitutes zeros for the remainder of the data once ctx is cancelled or the underlying reader
+// fails. The fxpakpro cannot abort a PUT midway so the transfer must be completed to leave the device usable.
+type abortableReader struct {
+	ctx context.Context
+	r   io.Reader
+	n   uint32
+	err error
+}
+
+func (a *abortableReader) Read(p []byte) (n int, err error) {
+	if a.err == nil {
+		a.err = a.ctx.Err()
+	}
+	if a.err == nil {
+		n, err = a.r.Read(p)
+		a.n += uint32(n)
+		if err == nil || err == io.EOF {
+			return
+		}
+		a.err = err
+	}
+
+	// zero out the rest of the buffer:
+	for i := n; i < len(p); i++ {
+		p[i] = 0
+	}
+	return len(p), nil
+}
@@ -0,0 +1,69 @@
+package fxpakpro
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+type failingReader struct {
+	r   io.Reader
+	err error
+}
+
+func (f *failingReader) Read(p []byte) (n int, err error) {
+	n, err = f.r.Read(p)
+	if err == io.EOF {
+		err = f.err
+	}
+	return
+}
+
+func Test_abortableReader(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	t.Run("passthrough", func(t *testing.T) {
+		ar := &abortableReader{ctx: context.Background(), r: bytes.NewReader([]byte{1, 2, 3})}
+		p := make([]byte, 4)
+		n, err := ar.Read(p)
+		if n != 3 || err != nil || ar.err != nil {
+			t.Fatalf("Read() = %v, %v; aborted %v", n, err, ar.err)
+		}
+		n, err = ar.Read(p)
+		if n != 0 || err != io.EOF || ar.err != nil {
+			t.Fatalf("Read() = %v, %v; aborted %v", n, err, ar.err)
+		}
+	})
+
+	t.Run("reader fails", func(t *testing.T) {
+		ar := &abortableReader{ctx: context.Background(), r: &failingReader{bytes.NewReader([]byte{1, 2}), errFailed}}
+		p := []byte{9, 9, 9, 9}
+		n, err := ar.Read(p)
+		if n != 2 || err != nil {
+			t.Fatalf("Read() = %v, %v", n, err)
+		}
+		n, err = ar.Read(p)
+		if n != 4 || err != nil || !bytes.Equal(p[:2], []byte{0, 0}) {
+			t.Fatalf("Read() = %v, %v, %v", n, err, p)
+		}
+		if ar.err != errFailed || ar.n != 2 {
+			t.Fatalf("aborted = %v after %d bytes", ar.err, ar.n)
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		ar := &abortableReader{ctx: ctx, r: bytes.NewReader([]byte{1, 2, 3})}
+		p := []byte{9, 9}
+		n, err := ar.Read(p)
+		if n != 2 || err != nil || !bytes.Equal(p, []byte{0, 0}) {
+			t.Fatalf("Read() = %v, %v, %v", n, err, p)
+		}
+		if !errors.Is(ar.err, context.Canceled) {
+			t.Fatalf("aborted = %v", ar.err)
+		}
+	})
+}
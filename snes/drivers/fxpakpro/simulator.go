@@ -0,0 +1,737 @@
+package fxpakpro
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"go.bug.st/serial"
+	"log"
+	"path"
+	"sni/protos/sni"
+	"sni/snes"
+	"sni/snes/mapping"
+	"sni/snes/timing"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FatFs result codes the firmware responds with as error codes:
+const (
+	frOK          = 0
+	frIntErr      = 2
+	frNoFile      = 4
+	frNoPath      = 5
+	frInvalidName = 6
+	frDenied      = 7
+	frExist       = 8
+)
+
+const (
+	simROMEnd    = 0xE00000
+	simSRAMStart = 0xE00000
+	simSRAMEnd   = 0xF00000
+	simWRAMStart = 0xF50000
+	simWRAMEnd   = 0xF70000
+
+	simMenuROMName = "/sd2snes/m3nu.bin"
+	simVersion     = "1.11.0"
+	simDeviceName  = "FXPAK PRO SIM"
+)
+
+var errSimulatorClosed = fmt.Errorf("fxpakpro: simulator port is closed")
+
+// simFile is a file or directory on the simulated SD card
+type simFile struct {
+	name string
+	dir  bool
+	data []byte
+}
+
+// Simulator implements serial.Port by emulating the FX Pak Pro firmware's USB command interface against a virtual
+// SD card and memory. Commands are processed as they are written and responses are queued to be read back.
+// A booted ROM is not actually run but NMI EXE code uploaded to $2C00 in CMD space is executed once per frame as
+// the in-game hook would. The SD card and memory survive closing and reopening the port.
+type Simulator struct {
+	lock sync.Mutex
+
+	// Memory is laid out in the FX Pak Pro address space: ROM at $00_0000, SRAM at $E0_0000 and WRAM at $F5_0000
+	Memory [0x1000000]byte
+	// CMD is the snescmd space which holds the NMI EXE buffer at $2C00
+	CMD [0x10000]byte
+
+	files map[string]*simFile
+
+	romName string
+	mapping sni.MemoryMapping
+	booted  bool
+	// crashed stops NMI EXE until the next reset after code could not be executed:
+	crashed bool
+
+	closed      bool
+	in          []byte
+	out         []byte
+	readTimeout time.Duration
+	available   chan struct{}
+
+	// expected is the number of data bytes that must arrive before onData is called:
+	expected int
+	onData   func(data []byte)
+
+	frameTicker *time.Ticker
+	stop        chan struct{}
+}
+
+// NewSimulator creates a simulated FX Pak Pro at the menu with an empty SD card and opens its port.
+func NewSimulator() *Simulator {
+	s := &Simulator{
+		files:       make(map[string]*simFile),
+		romName:     simMenuROMName,
+		readTimeout: serial.NoTimeout,
+	}
+	s.open()
+	return s
+}
+
+// open (re)connects the port with empty buffers and starts running frames.
+func (s *Simulator) open() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.closed && s.stop != nil {
+		s.shutdown()
+	}
+
+	s.closed = false
+	s.in, s.out = nil, nil
+	s.expected, s.onData = 0, nil
+	s.available = make(chan struct{}, 1)
+
+	s.stop = make(chan struct{})
+	s.frameTicker = time.NewTicker(timing.Frame)
+	go s.run(s.frameTicker, s.stop)
+}
+
+// shutdown stops running frames; s.lock must be held.
+func (s *Simulator) shutdown() {
+	s.frameTicker.Stop()
+	close(s.stop)
+	s.stop = nil
+}
+
+func (s *Simulator) run(ticker *time.Ticker, stop chan struct{}) {
+	for {
+		select {
+		case <-ticker.C:
+			s.lock.Lock()
+			s.nmi()
+			s.lock.Unlock()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// nmi runs the NMI EXE code if it is enabled by a non-zero byte at $2C00; s.lock must be held.
+func (s *Simulator) nmi() {
+	if !s.booted || s.crashed || s.CMD[0x2C00] == 0 {
+		return
+	}
+
+	c := cpu65816{bus: s}
+	if err := c.runNMI(0x002C00); err != nil {
+		log.Printf("%s: simulator: NMI EXE crashed: %v\n", driverName, err)
+		s.crashed = true
+	}
+}
+
+func (s *Simulator) SetMode(mode *serial.Mode) error { return nil }
+
+func (s *Simulator) SetDTR(dtr bool) error { return nil }
+
+func (s *Simulator) SetRTS(rts bool) error { return nil }
+
+func (s *Simulator) GetModemStatusBits() (*serial.ModemStatusBits, error) {
+	return &serial.ModemStatusBits{CTS: true, DSR: true}, nil
+}
+
+func (s *Simulator) ResetInputBuffer() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.out = nil
+	return nil
+}
+
+func (s *Simulator) ResetOutputBuffer() error { return nil }
+
+func (s *Simulator) SetReadTimeout(t time.Duration) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.readTimeout = t
+	return nil
+}
+
+func (s *Simulator) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	s.shutdown()
+	// wake up a blocked Read:
+	select {
+	case s.available <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Read blocks until response data is available or the read timeout elapses; like a serial port it returns 0 bytes
+// and no error on timeout.
+func (s *Simulator) Read(p []byte) (n int, err error) {
+	var timeout <-chan time.Time
+	for {
+		s.lock.Lock()
+		if s.closed {
+			s.lock.Unlock()
+			return 0, errSimulatorClosed
+		}
+		if len(s.out) > 0 {
+			n = copy(p, s.out)
+			s.out = s.out[n:]
+			s.lock.Unlock()
+			return
+		}
+		available := s.available
+		if timeout == nil && s.readTimeout >= 0 {
+			t := time.NewTimer(s.readTimeout)
+			defer t.Stop()
+			timeout = t.C
+		}
+		s.lock.Unlock()
+
+		select {
+		case <-available:
+		case <-timeout:
+			return 0, nil
+		}
+	}
+}
+
+// Write processes all complete commands and data written to the port.
+func (s *Simulator) Write(p []byte) (n int, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed {
+		return 0, errSimulatorClosed
+	}
+
+	s.in = append(s.in, p...)
+	s.process()
+	return len(p), nil
+}
+
+// respond queues data to be read; s.lock must be held.
+func (s *Simulator) respond(data []byte) {
+	s.out = append(s.out, data...)
+	select {
+	case s.available <- struct{}{}:
+	default:
+	}
+}
+
+// expect awaits size bytes of data padded to the block size before calling onData; s.lock must be held.
+func (s *Simulator) expect(size int, blockSize int, onData func(data []byte)) {
+	s.expected = (size + blockSize - 1) / blockSize * blockSize
+	s.onData = func(data []byte) { onData(data[:size]) }
+}
+
+// process handles the buffered input; s.lock must be held.
+func (s *Simulator) process() {
+	for {
+		if s.expected > 0 {
+			if len(s.in) < s.expected {
+				return
+			}
+			data := s.in[:s.expected]
+			s.in = s.in[s.expected:]
+			onData := s.onData
+			s.expected, s.onData = 0, nil
+			onData(data)
+			continue
+		}
+
+		if len(s.in) < 64 {
+			return
+		}
+		if !bytes.Equal(s.in[0:4], []byte("USBA")) {
+			// the firmware ignores blocks that are not commands:
+			s.in = s.in[64:]
+			continue
+		}
+
+		op, flags := opcode(s.in[4]), server_flags(s.in[6])
+		cmdSize := 512
+		if (op == OpVGET || op == OpVPUT) && flags&FlagDATA64B != 0 {
+			cmdSize = 64
+		}
+		if len(s.in) < cmdSize {
+			return
+		}
+
+		// 64-byte commands are zero-extended to simplify decoding:
+		cmd := make([]byte, 512)
+		copy(cmd, s.in[:cmdSize])
+		s.in = s.in[cmdSize:]
+		s.command(cmd)
+	}
+}
+
+// response creates a response packet for the command; s.lock must be held.
+func (s *Simulator) response(cmd []byte, ec byte, size uint32) []byte {
+	rsp := make([]byte, 512)
+	copy(rsp, "USBA")
+	rsp[4] = byte(OpRESPONSE)
+	rsp[5] = ec
+	rsp[6] = cmd[6]
+	binary.BigEndian.PutUint32(rsp[252:], size)
+	return rsp
+}
+
+// command executes a single command; s.lock must be held.
+func (s *Simulator) command(cmd []byte) {
+	op, spc, flags := opcode(cmd[4]), space(cmd[5]), server_flags(cmd[6])
+	size := binary.BigEndian.Uint32(cmd[252:256])
+	address := binary.BigEndian.Uint32(cmd[256:260])
+	name := string(cmd[256:][:clen(cmd[256:])])
+
+	blockSize := 512
+	if flags&FlagDATA64B != 0 {
+		blockSize = 64
+	}
+	respond := func(ec byte, size uint32) {
+		if flags&FlagNORESP == 0 {
+			s.respond(s.response(cmd, ec, size))
+		}
+	}
+
+	switch op {
+	case OpGET:
+		if spc == SpaceFILE {
+			f, ec := s.lookup(name, false)
+			if ec != frOK {
+				respond(ec, 0)
+				return
+			}
+			respond(frOK, uint32(len(f.data)))
+			s.respond(pad(f.data, blockSize))
+			return
+		}
+		respond(frOK, size)
+		s.respond(pad(s.readMemory(spc, address, int(size)), blockSize))
+	case OpPUT:
+		if spc == SpaceFILE {
+			key, parentOK := s.create(name)
+			if !parentOK {
+				respond(frNoPath, 0)
+				return
+			}
+			respond(frOK, size)
+			if size == 0 {
+				// the firmware still expects a single block of data:
+				s.expect(blockSize, blockSize, func([]byte) {
+					s.files[key] = &simFile{name: path.Base("/" + name), data: []byte{}}
+				})
+				return
+			}
+			s.expect(int(size), blockSize, func(data []byte) {
+				s.files[key] = &simFile{name: path.Base("/" + name), data: append([]byte(nil), data...)}
+			})
+			return
+		}
+		respond(frOK, size)
+		s.expect(int(size), blockSize, func(data []byte) {
+			s.writeMemory(spc, address, data)
+		})
+	case OpVGET:
+		chunks, total := vChunks(cmd)
+		respond(frOK, uint32(total))
+		data := make([]byte, 0, total)
+		for _, c := range chunks {
+			data = append(data, s.readMemory(spc, c.addr, int(c.size))...)
+		}
+		s.respond(pad(data, blockSize))
+	case OpVPUT:
+		chunks, total := vChunks(cmd)
+		respond(frOK, uint32(total))
+		s.expect(total, blockSize, func(data []byte) {
+			for _, c := range chunks {
+				s.writeMemory(spc, c.addr, data[:c.size])
+				data = data[c.size:]
+			}
+		})
+	case OpLS:
+		entries, ec := s.list(name)
+		respond(ec, 1)
+		if ec == frOK {
+			s.respond(lsPackets(entries))
+		}
+	case OpMKDIR:
+		respond(s.mkdir(name), 0)
+	case OpRM:
+		respond(s.rm(name), 0)
+	case OpMV:
+		newName := string(cmd[8:256][:clen(cmd[8:256])])
+		respond(s.mv(name, newName), 0)
+	case OpBOOT:
+		respond(s.boot(name), 0)
+	case OpRESET:
+		s.reset()
+		respond(frOK, 0)
+	case OpMENU_RESET:
+		s.menu()
+		respond(frOK, 0)
+	case OpINFO:
+		rsp := s.response(cmd, frOK, 0)
+		rsp[6] = byte(FeatCMD_UNLOCK | FeatUSB1 | FeatDMA1)
+		copy(rsp[16:252], s.romName)
+		copy(rsp[260:260+64], simVersion)
+		copy(rsp[260+64:260+64+64], simDeviceName)
+		s.respond(rsp)
+	default:
+		respond(frIntErr, 0)
+	}
+}
+
+// vChunks decodes the VGET/VPUT chunk list of 1 byte size and 3 byte address pairs
+func vChunks(cmd []byte) (chunks []vgetChunk, total int) {
+	for sp := cmd[32:64]; len(sp) >= 4; sp = sp[4:] {
+		if sp[0] == 0 {
+			continue
+		}
+		chunks = append(chunks, vgetChunk{
+			size: sp[0],
+			addr: uint32(sp[1])<<16 | uint32(sp[2])<<8 | uint32(sp[3]),
+		})
+		total += int(sp[0])
+	}
+	return
+}
+
+// pad zero-fills data up to a whole number of blocks
+func pad(data []byte, blockSize int) []byte {
+	padded := make([]byte, (len(data)+blockSize-1)/blockSize*blockSize)
+	copy(padded, data)
+	return padded
+}
+
+// lsPackets encodes directory entries into 512-byte packets as the LS command responds with
+func lsPackets(entries []*simFile) []byte {
+	var packets []byte
+	packet := make([]byte, 512)
+	i := 0
+	for _, f := range entries {
+		// leave room for the terminating marker:
+		if i+1+len(f.name)+1 >= 512 {
+			// 2 means more data follows in the next packet:
+			packet[i] = 2
+			packets = append(packets, packet...)
+			packet = make([]byte, 512)
+			i = 0
+		}
+		packet[i] = byte(FtFILE)
+		if f.dir {
+			packet[i] = byte(FtDIRECTORY)
+		}
+		i++
+		i += copy(packet[i:], f.name)
+		packet[i] = 0
+		i++
+	}
+	// FF means no more data:
+	packet[i] = 0xFF
+	return append(packets, packet...)
+}
+
+// memory returns the memory for the space and the mask to wrap addresses within it; s.lock must be held.
+func (s *Simulator) memory(spc space) (m []byte, mask uint32) {
+	if spc == SpaceCMD {
+		return s.CMD[:], 0xFFFF
+	}
+	return s.Memory[:], 0xFFFFFF
+}
+
+func (s *Simulator) readMemory(spc space, address uint32, size int) []byte {
+	m, mask := s.memory(spc)
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = m[(address+uint32(i))&mask]
+	}
+	return data
+}
+
+func (s *Simulator) writeMemory(spc space, address uint32, data []byte) {
+	m, mask := s.memory(spc)
+	for i, b := range data {
+		m[(address+uint32(i))&mask] = b
+	}
+}
+
+// clear zeroes memory in [start, end); s.lock must be held.
+func (s *Simulator) clear(start, end uint32) {
+	m := s.Memory[start:end]
+	for i := range m {
+		m[i] = 0
+	}
+}
+
+// reset clears WRAM and the NMI EXE buffer as resetting the SNES does; s.lock must be held.
+func (s *Simulator) reset() {
+	s.clear(simWRAMStart, simWRAMEnd)
+	s.CMD[0x2C00] = 0
+	s.crashed = false
+}
+
+// menu unloads the ROM and returns to the menu; s.lock must be held.
+func (s *Simulator) menu() {
+	s.clear(0, simSRAMEnd)
+	s.romName = simMenuROMName
+	s.mapping = sni.MemoryMapping_Unknown
+	s.booted = false
+	s.reset()
+}
+
+// boot loads the ROM file into memory and starts it; s.lock must be held.
+func (s *Simulator) boot(name string) byte {
+	f, ec := s.lookup(name, false)
+	if ec != frOK {
+		return ec
+	}
+
+	rom := f.data
+	if len(rom)&0x7FFF == snes.CopierHeaderSize {
+		// skip the copier header:
+		rom = rom[snes.CopierHeaderSize:]
+	}
+	if len(rom) > simROMEnd {
+		return frDenied
+	}
+
+	s.clear(0, simSRAMEnd)
+	copy(s.Memory[:simROMEnd], rom)
+	s.romName = "/" + strings.TrimLeft(name, "/")
+	s.mapping = romMapping(rom)
+	s.booted = true
+	s.reset()
+	return frOK
+}
+
+// romMapping detects the ROM's memory mapping from its best scoring header
+func romMapping(contents []byte) sni.MemoryMapping {
+	fallback := sni.MemoryMapping_LoROM
+
+	rom, err := snes.NewROM("", contents)
+	if err != nil || rom.MemoryMapping == sni.MemoryMapping_Unknown {
+		return fallback
+	}
+
+	m, _, _, err := mapping.Detect(context.Background(), nil, &fallback, rom.Contents[rom.HeaderOffset:rom.HeaderOffset+0x50])
+	if err != nil {
+		return fallback
+	}
+	return m
+}
+
+// sdKey normalizes an SD card path to a case-insensitive key without leading or trailing slashes
+func sdKey(name string) string {
+	return strings.ToLower(strings.Trim(path.Clean("/"+name), "/"))
+}
+
+func sdParent(key string) string {
+	if i := strings.LastIndexByte(key, '/'); i >= 0 {
+		return key[:i]
+	}
+	return ""
+}
+
+// lookup finds the file or directory; s.lock must be held.
+func (s *Simulator) lookup(name string, dir bool) (f *simFile, ec byte) {
+	key := sdKey(name)
+	if key == "" {
+		if dir {
+			return &simFile{dir: true}, frOK
+		}
+		return nil, frNoFile
+	}
+	f, ok := s.files[key]
+	if !ok {
+		if !s.isDir(sdParent(key)) {
+			return nil, frNoPath
+		}
+		return nil, frNoFile
+	}
+	if f.dir != dir {
+		return nil, frDenied
+	}
+	return f, frOK
+}
+
+func (s *Simulator) isDir(key string) bool {
+	if key == "" {
+		return true
+	}
+	f, ok := s.files[key]
+	return ok && f.dir
+}
+
+// create returns the key for a new file and whether its parent directory exists; s.lock must be held.
+func (s *Simulator) create(name string) (key string, parentOK bool) {
+	key = sdKey(name)
+	return key, key != "" && s.isDir(sdParent(key)) && !s.isDir(key)
+}
+
+func (s *Simulator) list(name string) (entries []*simFile, ec byte) {
+	key := sdKey(name)
+	if !s.isDir(key) {
+		return nil, frNoPath
+	}
+
+	if key != "" {
+		// only subdirectories have dot entries on FAT:
+		entries = append(entries, &simFile{name: ".", dir: true}, &simFile{name: "..", dir: true})
+	}
+	children := make([]*simFile, 0)
+	for k, f := range s.files {
+		if k != key && sdParent(k) == key {
+			children = append(children, f)
+		}
+	}
+	sort.Slice(children, func(i, j int) bool { return children[i].name < children[j].name })
+	return append(entries, children...), frOK
+}
+
+func (s *Simulator) mkdir(name string) byte {
+	key := sdKey(name)
+	if key == "" {
+		return frExist
+	}
+	if !s.isDir(sdParent(key)) {
+		return frNoPath
+	}
+	if _, ok := s.files[key]; ok {
+		return frExist
+	}
+	s.files[key] = &simFile{name: path.Base("/" + name), dir: true}
+	return frOK
+}
+
+func (s *Simulator) rm(name string) byte {
+	key := sdKey(name)
+	f, ok := s.files[key]
+	if !ok {
+		if !s.isDir(sdParent(key)) {
+			return frNoPath
+		}
+		return frNoFile
+	}
+	if f.dir {
+		for k := range s.files {
+			if strings.HasPrefix(k, key+"/") {
+				// directory is not empty:
+				return frDenied
+			}
+		}
+	}
+	delete(s.files, key)
+	return frOK
+}
+
+func (s *Simulator) mv(name, newName string) byte {
+	if newName == "" || newName == "." || newName == ".." || strings.ContainsAny(newName, "/\\") {
+		return frInvalidName
+	}
+
+	key := sdKey(name)
+	f, ok := s.files[key]
+	if !ok {
+		if !s.isDir(sdParent(key)) {
+			return frNoPath
+		}
+		return frNoFile
+	}
+
+	newKey := strings.ToLower(newName)
+	if parent := sdParent(key); parent != "" {
+		newKey = parent + "/" + newKey
+	}
+	if newKey == key {
+		f.name = newName
+		return frOK
+	}
+	if _, exists := s.files[newKey]; exists {
+		return frExist
+	}
+
+	// move the entry and everything under it:
+	for k, child := range s.files {
+		if strings.HasPrefix(k, key+"/") {
+			delete(s.files, k)
+			s.files[newKey+k[len(key):]] = child
+		}
+	}
+	delete(s.files, key)
+	f.name = newName
+	s.files[newKey] = f
+	return frOK
+}
+
+// read implements cpuBus by mapping the SNES A-bus to memory as the FX Pak Pro does; s.lock must be held.
+func (s *Simulator) read(busAddr uint32) byte {
+	if m, addr, ok := s.busMemory(busAddr); ok {
+		return m[addr]
+	}
+	// open bus:
+	return 0
+}
+
+// write implements cpuBus; writes to ROM are ignored. s.lock must be held.
+func (s *Simulator) write(busAddr uint32, b byte) {
+	m, addr, ok := s.busMemory(busAddr)
+	if !ok {
+		return
+	}
+	if len(m) == len(s.Memory) && addr < simROMEnd {
+		return
+	}
+	m[addr] = b
+}
+
+func (s *Simulator) busMemory(busAddr uint32) (m []byte, addr uint32, ok bool) {
+	busAddr &= 0xFFFFFF
+	bank, offs := busAddr>>16, busAddr&0xFFFF
+	systemBank := bank < 0x40 || (bank >= 0x80 && bank < 0xC0)
+
+	if systemBank && offs >= 0x2A00 && offs < 0x3000 {
+		// the snescmd buffer:
+		return s.CMD[:], offs, true
+	}
+	if bank == 0x7E || bank == 0x7F {
+		return s.Memory[:], simWRAMStart + (busAddr - 0x7E0000), true
+	}
+	if systemBank && offs < 0x2000 {
+		// lower 8KiB of WRAM:
+		return s.Memory[:], simWRAMStart + offs, true
+	}
+
+	pakAddr, err := mapping.TranslateAddress(
+		snes.AddressTuple{Address: busAddr, AddressSpace: sni.AddressSpace_SnesABus, MemoryMapping: s.mapping},
+		sni.AddressSpace_FxPakPro,
+	)
+	if err != nil {
+		return nil, 0, false
+	}
+	return s.Memory[:], pakAddr & 0xFFFFFF, true
+}
@@ -0,0 +1,540 @@
+package fxpakpro
+
+import (
+	"fmt"
+	"sni/snes/asm"
+)
+
+// cpuBus is the SNES A-bus as seen by the CPU
+type cpuBus interface {
+	read(busAddr uint32) byte
+	write(busAddr uint32, b byte)
+}
+
+// cpuMaxSteps limits how many instructions NMI EXE code may execute before it is considered hung
+const cpuMaxSteps = 1000000
+
+// cpu65816 interprets the native mode 65816 instructions NMI EXE code is expected to use. Decimal mode is not
+// supported.
+type cpu65816 struct {
+	bus cpuBus
+
+	a, x, y uint16
+	s, d    uint16
+	dbr     uint8
+	pbr     uint8
+	pc      uint16
+	p       asm.Flags
+}
+
+// runNMI executes code as an NMI handler from entry until it jumps to the original NMI vector at $FFEA or returns
+// with RTI.
+func (c *cpu65816) runNMI(entry uint32) error {
+	c.pbr, c.pc = uint8(entry>>16), uint16(entry)
+	c.dbr, c.d = 0, 0
+	// a typical stack location for games:
+	c.s = 0x1FFF
+	c.p = asm.IRQDisable | asm.Accumulator8bit | asm.IndexRegister8bit
+
+	for steps := 0; steps < cpuMaxSteps; steps++ {
+		done, err := c.step()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+	}
+	return fmt.Errorf("code did not return within %d instructions", cpuMaxSteps)
+}
+
+func (c *cpu65816) m8() bool { return c.p&asm.Accumulator8bit != 0 }
+func (c *cpu65816) x8() bool { return c.p&asm.IndexRegister8bit != 0 }
+
+func (c *cpu65816) setFlag(f asm.Flags, set bool) {
+	if set {
+		c.p |= f
+	} else {
+		c.p &^= f
+	}
+}
+
+func (c *cpu65816) setNZ(v uint16, is8 bool) {
+	if is8 {
+		c.setFlag(asm.Zero, v&0xFF == 0)
+		c.setFlag(asm.Negative, v&0x80 != 0)
+	} else {
+		c.setFlag(asm.Zero, v == 0)
+		c.setFlag(asm.Negative, v&0x8000 != 0)
+	}
+}
+
+// fixIndex clears the high bytes of the index registers when they are 8-bit
+func (c *cpu65816) fixIndex() {
+	if c.x8() {
+		c.x &= 0xFF
+		c.y &= 0xFF
+	}
+}
+
+func (c *cpu65816) fetch8() uint8 {
+	b := c.bus.read(uint32(c.pbr)<<16 | uint32(c.pc))
+	c.pc++
+	return b
+}
+
+func (c *cpu65816) fetch16() uint16 {
+	lo := uint16(c.fetch8())
+	return lo | uint16(c.fetch8())<<8
+}
+
+func (c *cpu65816) fetch24() uint32 {
+	lo := uint32(c.fetch16())
+	return lo | uint32(c.fetch8())<<16
+}
+
+func (c *cpu65816) read16(addr uint32) uint16 {
+	return uint16(c.bus.read(addr&0xFFFFFF)) | uint16(c.bus.read((addr+1)&0xFFFFFF))<<8
+}
+
+func (c *cpu65816) write16(addr uint32, v uint16) {
+	c.bus.write(addr&0xFFFFFF, uint8(v))
+	c.bus.write((addr+1)&0xFFFFFF, uint8(v>>8))
+}
+
+// readW reads 8 or 16 bits depending on is8
+func (c *cpu65816) readW(addr uint32, is8 bool) uint16 {
+	if is8 {
+		return uint16(c.bus.read(addr & 0xFFFFFF))
+	}
+	return c.read16(addr)
+}
+
+func (c *cpu65816) writeW(addr uint32, v uint16, is8 bool) {
+	if is8 {
+		c.bus.write(addr&0xFFFFFF, uint8(v))
+		return
+	}
+	c.write16(addr, v)
+}
+
+func (c *cpu65816) push8(b uint8) {
+	c.bus.write(uint32(c.s), b)
+	c.s--
+}
+
+func (c *cpu65816) pull8() uint8 {
+	c.s++
+	return c.bus.read(uint32(c.s))
+}
+
+func (c *cpu65816) push16(v uint16) {
+	c.push8(uint8(v >> 8))
+	c.push8(uint8(v))
+}
+
+func (c *cpu65816) pull16() uint16 {
+	lo := uint16(c.pull8())
+	return lo | uint16(c.pull8())<<8
+}
+
+func (c *cpu65816) pushW(v uint16, is8 bool) {
+	if is8 {
+		c.push8(uint8(v))
+		return
+	}
+	c.push16(v)
+}
+
+func (c *cpu65816) pullW(is8 bool) uint16 {
+	if is8 {
+		return uint16(c.pull8())
+	}
+	return c.pull16()
+}
+
+// addressing modes:
+
+func (c *cpu65816) dp() uint32    { return uint32(c.d + uint16(c.fetch8())) }
+func (c *cpu65816) abs() uint32   { return uint32(c.dbr)<<16 | uint32(c.fetch16()) }
+func (c *cpu65816) absX() uint32  { return (c.abs() + uint32(c.x)) & 0xFFFFFF }
+func (c *cpu65816) absY() uint32  { return (c.abs() + uint32(c.y)) & 0xFFFFFF }
+func (c *cpu65816) long() uint32  { return c.fetch24() }
+func (c *cpu65816) longX() uint32 { return (c.fetch24() + uint32(c.x)) & 0xFFFFFF }
+
+// immM fetches an immediate operand sized by the accumulator width
+func (c *cpu65816) immM() uint16 {
+	if c.m8() {
+		return uint16(c.fetch8())
+	}
+	return c.fetch16()
+}
+
+// immX fetches an immediate operand sized by the index register width
+func (c *cpu65816) immX() uint16 {
+	if c.x8() {
+		return uint16(c.fetch8())
+	}
+	return c.fetch16()
+}
+
+func (c *cpu65816) readM(addr uint32) uint16 { return c.readW(addr, c.m8()) }
+func (c *cpu65816) readX(addr uint32) uint16 { return c.readW(addr, c.x8()) }
+
+// getA returns the accumulator sized by its width
+func (c *cpu65816) getA() uint16 {
+	if c.m8() {
+		return c.a & 0xFF
+	}
+	return c.a
+}
+
+// setA sets the accumulator and N and Z flags; in 8-bit mode the B accumulator is preserved
+func (c *cpu65816) setA(v uint16) {
+	if c.m8() {
+		c.a = c.a&0xFF00 | v&0xFF
+	} else {
+		c.a = v
+	}
+	c.setNZ(v, c.m8())
+}
+
+func (c *cpu65816) setIndex(r *uint16, v uint16) {
+	if c.x8() {
+		v &= 0xFF
+	}
+	*r = v
+	c.setNZ(v, c.x8())
+}
+
+func (c *cpu65816) compare(reg, v uint16, is8 bool) {
+	if is8 {
+		reg, v = reg&0xFF, v&0xFF
+	}
+	c.setFlag(asm.Carry, reg >= v)
+	c.setNZ(reg-v, is8)
+}
+
+func (c *cpu65816) adc(v uint16) {
+	if c.m8() {
+		v &= 0xFF
+	}
+	a := uint32(c.getA())
+	carry := uint32(0)
+	if c.p&asm.Carry != 0 {
+		carry = 1
+	}
+	r := a + uint32(v) + carry
+
+	signBit, limit := uint32(0x8000), uint32(0xFFFF)
+	if c.m8() {
+		signBit, limit = 0x80, 0xFF
+	}
+	c.setFlag(asm.Carry, r > limit)
+	c.setFlag(asm.Overflow, ^(a^uint32(v))&(a^r)&signBit != 0)
+	c.setA(uint16(r))
+}
+
+func (c *cpu65816) incdec(addr uint32, delta uint16) {
+	v := c.readM(addr) + delta
+	c.writeW(addr, v, c.m8())
+	c.setNZ(v, c.m8())
+}
+
+func (c *cpu65816) branch(cond bool) {
+	rel := int8(c.fetch8())
+	if cond {
+		c.pc = uint16(int(c.pc) + int(rel))
+	}
+}
+
+// blockMove implements MVN and MVP
+func (c *cpu65816) blockMove(step uint16) {
+	dest := c.fetch8()
+	src := c.fetch8()
+	c.dbr = dest
+	for {
+		c.bus.write(uint32(dest)<<16|uint32(c.y), c.bus.read(uint32(src)<<16|uint32(c.x)))
+		c.x += step
+		c.y += step
+		c.fixIndex()
+		c.a--
+		if c.a == 0xFFFF {
+			break
+		}
+	}
+}
+
+// step executes a single instruction and reports when the NMI handler is done.
+func (c *cpu65816) step() (done bool, err error) {
+	pbr, pc := c.pbr, c.pc
+	op := c.fetch8()
+
+	switch op {
+	case 0xEA: // NOP
+	case 0xC2: // REP
+		c.p &^= asm.Flags(c.fetch8())
+	case 0xE2: // SEP
+		c.p |= asm.Flags(c.fetch8())
+		c.fixIndex()
+	case 0x18: // CLC
+		c.setFlag(asm.Carry, false)
+	case 0x38: // SEC
+		c.setFlag(asm.Carry, true)
+	case 0x58: // CLI
+		c.setFlag(asm.IRQDisable, false)
+	case 0x78: // SEI
+		c.setFlag(asm.IRQDisable, true)
+	case 0xB8: // CLV
+		c.setFlag(asm.Overflow, false)
+	case 0xD8: // CLD
+		c.setFlag(asm.DecimalMode, false)
+	case 0xEB: // XBA
+		c.a = c.a<<8 | c.a>>8
+		c.setNZ(c.a, true)
+
+	// loads and stores:
+	case 0xA9:
+		c.setA(c.immM())
+	case 0xA5:
+		c.setA(c.readM(c.dp()))
+	case 0xAD:
+		c.setA(c.readM(c.abs()))
+	case 0xBD:
+		c.setA(c.readM(c.absX()))
+	case 0xB9:
+		c.setA(c.readM(c.absY()))
+	case 0xAF:
+		c.setA(c.readM(c.long()))
+	case 0xBF:
+		c.setA(c.readM(c.longX()))
+	case 0x85:
+		c.writeW(c.dp(), c.a, c.m8())
+	case 0x8D:
+		c.writeW(c.abs(), c.a, c.m8())
+	case 0x9D:
+		c.writeW(c.absX(), c.a, c.m8())
+	case 0x99:
+		c.writeW(c.absY(), c.a, c.m8())
+	case 0x8F:
+		c.writeW(c.long(), c.a, c.m8())
+	case 0x9F:
+		c.writeW(c.longX(), c.a, c.m8())
+	case 0x64:
+		c.writeW(c.dp(), 0, c.m8())
+	case 0x9C:
+		c.writeW(c.abs(), 0, c.m8())
+	case 0x9E:
+		c.writeW(c.absX(), 0, c.m8())
+	case 0xA2:
+		c.setIndex(&c.x, c.immX())
+	case 0xA6:
+		c.setIndex(&c.x, c.readX(c.dp()))
+	case 0xAE:
+		c.setIndex(&c.x, c.readX(c.abs()))
+	case 0xA0:
+		c.setIndex(&c.y, c.immX())
+	case 0xA4:
+		c.setIndex(&c.y, c.readX(c.dp()))
+	case 0xAC:
+		c.setIndex(&c.y, c.readX(c.abs()))
+	case 0x86:
+		c.writeW(c.dp(), c.x, c.x8())
+	case 0x8E:
+		c.writeW(c.abs(), c.x, c.x8())
+	case 0x84:
+		c.writeW(c.dp(), c.y, c.x8())
+	case 0x8C:
+		c.writeW(c.abs(), c.y, c.x8())
+
+	// logic and arithmetic:
+	case 0x09:
+		c.setA(c.getA() | c.immM())
+	case 0x05:
+		c.setA(c.getA() | c.readM(c.dp()))
+	case 0x0D:
+		c.setA(c.getA() | c.readM(c.abs()))
+	case 0x0F:
+		c.setA(c.getA() | c.readM(c.long()))
+	case 0x29:
+		c.setA(c.getA() & c.immM())
+	case 0x25:
+		c.setA(c.getA() & c.readM(c.dp()))
+	case 0x2D:
+		c.setA(c.getA() & c.readM(c.abs()))
+	case 0x2F:
+		c.setA(c.getA() & c.readM(c.long()))
+	case 0x49:
+		c.setA(c.getA() ^ c.immM())
+	case 0x45:
+		c.setA(c.getA() ^ c.readM(c.dp()))
+	case 0x4D:
+		c.setA(c.getA() ^ c.readM(c.abs()))
+	case 0x4F:
+		c.setA(c.getA() ^ c.readM(c.long()))
+	case 0x69:
+		c.adc(c.immM())
+	case 0x65:
+		c.adc(c.readM(c.dp()))
+	case 0x6D:
+		c.adc(c.readM(c.abs()))
+	case 0x6F:
+		c.adc(c.readM(c.long()))
+	case 0xE9:
+		c.adc(^c.immM())
+	case 0xE5:
+		c.adc(^c.readM(c.dp()))
+	case 0xED:
+		c.adc(^c.readM(c.abs()))
+	case 0xEF:
+		c.adc(^c.readM(c.long()))
+	case 0xC9:
+		c.compare(c.a, c.immM(), c.m8())
+	case 0xC5:
+		c.compare(c.a, c.readM(c.dp()), c.m8())
+	case 0xCD:
+		c.compare(c.a, c.readM(c.abs()), c.m8())
+	case 0xDD:
+		c.compare(c.a, c.readM(c.absX()), c.m8())
+	case 0xCF:
+		c.compare(c.a, c.readM(c.long()), c.m8())
+	case 0xDF:
+		c.compare(c.a, c.readM(c.longX()), c.m8())
+	case 0xE0:
+		c.compare(c.x, c.immX(), c.x8())
+	case 0xEC:
+		c.compare(c.x, c.readX(c.abs()), c.x8())
+	case 0xC0:
+		c.compare(c.y, c.immX(), c.x8())
+	case 0xCC:
+		c.compare(c.y, c.readX(c.abs()), c.x8())
+
+	// increments and decrements:
+	case 0x1A:
+		c.setA(c.getA() + 1)
+	case 0x3A:
+		c.setA(c.getA() - 1)
+	case 0xE6:
+		c.incdec(c.dp(), 1)
+	case 0xEE:
+		c.incdec(c.abs(), 1)
+	case 0xC6:
+		c.incdec(c.dp(), 0xFFFF)
+	case 0xCE:
+		c.incdec(c.abs(), 0xFFFF)
+	case 0xE8:
+		c.setIndex(&c.x, c.x+1)
+	case 0xC8:
+		c.setIndex(&c.y, c.y+1)
+	case 0xCA:
+		c.setIndex(&c.x, c.x-1)
+	case 0x88:
+		c.setIndex(&c.y, c.y-1)
+
+	// transfers:
+	case 0xAA: // TAX
+		c.setIndex(&c.x, c.a)
+	case 0xA8: // TAY
+		c.setIndex(&c.y, c.a)
+	case 0x8A: // TXA
+		c.setA(c.x)
+	case 0x98: // TYA
+		c.setA(c.y)
+	case 0x5B: // TCD
+		c.d = c.a
+		c.setNZ(c.d, false)
+	case 0x7B: // TDC
+		c.a = c.d
+		c.setNZ(c.a, false)
+
+	// stack:
+	case 0x48:
+		c.pushW(c.a, c.m8())
+	case 0xDA:
+		c.pushW(c.x, c.x8())
+	case 0x5A:
+		c.pushW(c.y, c.x8())
+	case 0x08:
+		c.push8(uint8(c.p))
+	case 0x0B:
+		c.push16(c.d)
+	case 0x8B:
+		c.push8(c.dbr)
+	case 0x4B:
+		c.push8(c.pbr)
+	case 0x68:
+		c.setA(c.pullW(c.m8()))
+	case 0xFA:
+		c.setIndex(&c.x, c.pullW(c.x8()))
+	case 0x7A:
+		c.setIndex(&c.y, c.pullW(c.x8()))
+	case 0x28:
+		c.p = asm.Flags(c.pull8())
+		c.fixIndex()
+	case 0x2B:
+		c.d = c.pull16()
+		c.setNZ(c.d, false)
+	case 0xAB:
+		c.dbr = c.pull8()
+		c.setNZ(uint16(c.dbr), true)
+
+	// branches and jumps:
+	case 0x10:
+		c.branch(c.p&asm.Negative == 0)
+	case 0x30:
+		c.branch(c.p&asm.Negative != 0)
+	case 0x50:
+		c.branch(c.p&asm.Overflow == 0)
+	case 0x70:
+		c.branch(c.p&asm.Overflow != 0)
+	case 0x90:
+		c.branch(c.p&asm.Carry == 0)
+	case 0xB0:
+		c.branch(c.p&asm.Carry != 0)
+	case 0xD0:
+		c.branch(c.p&asm.Zero == 0)
+	case 0xF0:
+		c.branch(c.p&asm.Zero != 0)
+	case 0x80:
+		c.branch(true)
+	case 0x4C: // JMP abs
+		c.pc = c.fetch16()
+	case 0x5C: // JML long
+		addr := c.fetch24()
+		c.pbr, c.pc = uint8(addr>>16), uint16(addr)
+	case 0x6C: // JMP (abs)
+		ptr := c.fetch16()
+		if ptr == 0xFFEA {
+			// jumping to the original NMI vector ends the NMI EXE hook:
+			return true, nil
+		}
+		c.pc = c.read16(uint32(ptr))
+	case 0x20: // JSR abs
+		addr := c.fetch16()
+		c.push16(c.pc - 1)
+		c.pc = addr
+	case 0x60: // RTS
+		c.pc = c.pull16() + 1
+	case 0x22: // JSL long
+		addr := c.fetch24()
+		c.push8(c.pbr)
+		c.push16(c.pc - 1)
+		c.pbr, c.pc = uint8(addr>>16), uint16(addr)
+	case 0x6B: // RTL
+		c.pc = c.pull16() + 1
+		c.pbr = c.pull8()
+	case 0x40: // RTI
+		return true, nil
+
+	// block moves:
+	case 0x54: // MVN
+		c.blockMove(1)
+	case 0x44: // MVP
+		c.blockMove(0xFFFF)
+
+	default:
+		return false, fmt.Errorf("unsupported opcode $%02x at $%02x:%04x", op, pbr, pc)
+	}
+
+	return false, nil
+}
@@ -0,0 +1,312 @@
+package fxpakpro
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"net/url"
+	"sni/protos/sni"
+	"sni/snes"
+	"sni/snes/snestest"
+	"testing"
+)
+
+func openSimulatedDevice(t *testing.T) *Device {
+	d := &Device{f: NewSimulator()}
+	t.Cleanup(func() { _ = d.Close() })
+	return d
+}
+
+// bootSimulatedDevice opens a simulated device and boots the test ROM so that NMI EXE runs
+func bootSimulatedDevice(t *testing.T) *Device {
+	ctx := context.Background()
+	d := openSimulatedDevice(t)
+	rom := snestest.LoROM(0x20000)
+	if _, err := d.PutFile(ctx, "test.sfc", uint32(len(rom)), bytes.NewReader(rom), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.BootFile(ctx, "test.sfc"); err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestSimulator_filesystem(t *testing.T) {
+	ctx := context.Background()
+	d := openSimulatedDevice(t)
+
+	if err := d.MakeDirectory(ctx, "unittest"); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.MakeDirectory(ctx, "unittest"); err == nil {
+		t.Fatal("expected error making an existing directory")
+	}
+
+	sizes := map[string]int{"unittest/empty.sfc": 0, "unittest/test1.sfc": 1023, "unittest/test2.sfc": 1024*17 + 599}
+	for path, size := range sizes {
+		data := make([]byte, size)
+		for i := range data {
+			data[i] = byte(i * 7)
+		}
+		n, err := d.PutFile(ctx, path, uint32(size), bytes.NewReader(data), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != uint32(size) {
+			t.Fatalf("%s: put %d bytes; expected %d", path, n, size)
+		}
+
+		w := &bytes.Buffer{}
+		received, err := d.GetFile(ctx, path, w, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if received != uint32(size) || !bytes.Equal(w.Bytes(), data) {
+			t.Fatalf("%s: got %d bytes back that do not match what was put", path, received)
+		}
+	}
+
+	if _, err := d.PutFile(ctx, "missing/test.sfc", 1, bytes.NewReader([]byte{1}), nil); err == nil {
+		t.Fatal("expected error putting a file into a missing directory")
+	}
+
+	if err := d.RenameFile(ctx, "unittest/test1.sfc", "renamed.sfc"); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.RemoveFile(ctx, "unittest/test2.sfc"); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.RemoveFile(ctx, "unittest/test2.sfc"); err == nil {
+		t.Fatal("expected error removing a missing file")
+	} else if snes.IsFatal(err) {
+		t.Fatalf("expected non-fatal error; got %v", err)
+	}
+
+	entries, err := d.ReadDirectory(ctx, "unittest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []snes.DirEntry{
+		{Name: ".", Type: sni.DirEntryType_Directory},
+		{Name: "..", Type: sni.DirEntryType_Directory},
+		{Name: "empty.sfc", Type: sni.DirEntryType_File},
+		{Name: "renamed.sfc", Type: sni.DirEntryType_File},
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("expected %v; got %v", expected, entries)
+	}
+	for i := range expected {
+		if entries[i] != expected[i] {
+			t.Fatalf("expected %v; got %v", expected, entries)
+		}
+	}
+
+	if err := d.RemoveFile(ctx, "unittest"); err == nil {
+		t.Fatal("expected error removing a non-empty directory")
+	}
+}
+
+func TestSimulator_readDirectoryManyFiles(t *testing.T) {
+	ctx := context.Background()
+	d := openSimulatedDevice(t)
+
+	// enough entries to span several LS response packets:
+	const count = 100
+	for i := 0; i < count; i++ {
+		name := "a rather long file name for testing the ls response packets " + string(rune('A'+i/26)) + string(rune('a'+i%26)) + ".sfc"
+		if _, err := d.PutFile(ctx, name, 1, bytes.NewReader([]byte{0}), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := d.ReadDirectory(ctx, "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != count {
+		t.Fatalf("expected %d entries; got %d", count, len(entries))
+	}
+}
+
+func TestSimulator_memory(t *testing.T) {
+	ctx := context.Background()
+	d := bootSimulatedDevice(t)
+
+	writes := []snes.MemoryWriteRequest{
+		// SRAM is written with VPUT:
+		{RequestAddress: snestest.PakAddress(0xE00010), Data: []byte{0x01, 0x02, 0x03}},
+		// WRAM is written with NMI EXE:
+		{RequestAddress: snestest.PakAddress(0xF50010), Data: []byte{0x04, 0x05}},
+		{RequestAddress: snestest.PakAddress(0xF61000), Data: bytes.Repeat([]byte{0xAA}, 300)},
+	}
+	if _, err := d.MultiWriteMemory(ctx, writes...); err != nil {
+		t.Fatal(err)
+	}
+	for _, write := range writes {
+		if actual := snestest.MustReadPak(t, d, write.RequestAddress.Address, len(write.Data)); !bytes.Equal(actual, write.Data) {
+			t.Fatalf("$%06x: expected %x; got %x", write.RequestAddress.Address, write.Data, actual)
+		}
+	}
+
+	// the ROM title is readable:
+	if actual := snestest.MustReadPak(t, d, 0x7FC0, 9); string(actual) != "THE LEGEN" {
+		t.Fatalf("unexpected title: %q", actual)
+	}
+
+	// GET responds with padded data:
+	data, err := d.get(ctx, SpaceSNES, 0xF61000, 1100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 1100 || data[299] != 0xAA || data[300] != 0x00 {
+		t.Fatalf("unexpected GET data")
+	}
+	// and the port is left ready for the next command:
+	if actual := snestest.MustReadPak(t, d, 0xF50010, 2); !bytes.Equal(actual, []byte{0x04, 0x05}) {
+		t.Fatalf("expected 0405; got %x", actual)
+	}
+}
+
+func TestSimulator_conditionalWrite(t *testing.T) {
+	ctx := context.Background()
+	d := bootSimulatedDevice(t)
+
+	if _, err := d.MultiWriteMemory(ctx, snes.MemoryWriteRequest{RequestAddress: snestest.PakAddress(0xF50010), Data: []byte{0x05}}); err != nil {
+		t.Fatal(err)
+	}
+
+	write := snes.MemoryWriteRequest{RequestAddress: snestest.PakAddress(0xF50011), Data: []byte{0x06}}
+	tests := []struct {
+		name     string
+		expected byte
+		written  bool
+	}{
+		{"mismatch", 0x04, false},
+		{"match", 0x05, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsp, err := d.ConditionalWriteMemory(
+				ctx,
+				[]snes.MemoryCondition{{RequestAddress: snestest.PakAddress(0xF50010), Expected: []byte{tt.expected}}},
+				[]snes.MemoryWriteRequest{write},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rsp.Written != tt.written {
+				t.Fatalf("expected written=%v; got %v", tt.written, rsp.Written)
+			}
+			if actual, expected := snestest.MustReadPak(t, d, 0xF50011, 1)[0] == 0x06, tt.written; actual != expected {
+				t.Fatalf("expected write applied=%v; got %v", expected, actual)
+			}
+		})
+	}
+}
+
+func TestSimulator_conditionalWriteEmpty(t *testing.T) {
+	ctx := context.Background()
+	d := bootSimulatedDevice(t)
+
+	if _, err := d.MultiWriteMemory(ctx, snes.MemoryWriteRequest{RequestAddress: snestest.PakAddress(0xF50020), Data: []byte{0x07}}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := d.ConditionalWriteMemory(
+		ctx,
+		[]snes.MemoryCondition{{RequestAddress: snestest.PakAddress(0xF50020), Expected: []byte{0x07}}},
+		[]snes.MemoryWriteRequest{{RequestAddress: snestest.PakAddress(0xF50021)}},
+	)
+	var coded *snes.CodedError
+	if !errors.As(err, &coded) || coded.Code != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument; got %v", err)
+	}
+	if actual := snestest.MustReadPak(t, d, 0xF50020, 1)[0]; actual != 0x07 {
+		t.Fatalf("expected WRAM untouched; got $%02x", actual)
+	}
+}
+
+func TestSimulator_ExecuteASM(t *testing.T) {
+	ctx := context.Background()
+	d := bootSimulatedDevice(t)
+
+	// copy the data to $7E:0020 using X pointing to the data and Y holding its size:
+	// REP #$30; TYA; DEC; LDY #$0020; MVN $7E,$00; RTL
+	code := []byte{0xC2, 0x30, 0x98, 0x3A, 0xA0, 0x20, 0x00, 0x54, 0x7E, 0x00, 0x6B}
+	data := []byte{0x11, 0x22, 0x33}
+	if err := d.ExecuteASM(ctx, code, data); err != nil {
+		t.Fatal(err)
+	}
+	if actual := snestest.MustReadPak(t, d, 0xF50020, 3); !bytes.Equal(actual, data) {
+		t.Fatalf("expected %x; got %x", data, actual)
+	}
+}
+
+func TestSimulator_control(t *testing.T) {
+	ctx := context.Background()
+	d := bootSimulatedDevice(t)
+
+	values, err := d.FetchFields(ctx, snes.Field_DeviceName, snes.Field_DeviceVersion, snes.Field_RomFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values[0].Value != simDeviceName || values[1].Value != simVersion || values[2].Value != "/test.sfc" {
+		t.Fatalf("unexpected fields: %v", values)
+	}
+
+	if err = d.ResetToMenu(ctx); err != nil {
+		t.Fatal(err)
+	}
+	values, err = d.FetchFields(ctx, snes.Field_RomFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values[0].Value != simMenuROMName {
+		t.Fatalf("expected menu; got %q", values[0].Value)
+	}
+}
+
+func TestDriver_simulatorDisabled(t *testing.T) {
+	d := &Driver{}
+	d.container = snes.NewDeviceDriverContainer(d.openDevice)
+
+	uri, _ := url.Parse("fxpakpro://sim")
+	_, err := d.Device(uri).MultiReadMemory(context.Background(), snes.MemoryReadRequest{RequestAddress: snestest.PakAddress(0xF50000), Size: 1})
+	var coded *snes.CodedError
+	if !errors.As(err, &coded) || coded.Code != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition opening a disabled simulator; got %v", err)
+	}
+}
+
+func TestDriver_simulator(t *testing.T) {
+	ctx := context.Background()
+	uri, _ := url.Parse("fxpakpro://sim")
+
+	// as with SNI_FXPAKPRO_SIM=1:
+	driver.listSimulator = true
+	defer func() { driver.listSimulator = false }()
+
+	_, d, err := snes.DeviceByUri(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	rom := snestest.LoROM(0x20000)
+	if _, err = d.PutFile(ctx, "test.sfc", uint32(len(rom)), bytes.NewReader(rom), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = d.BootFile(ctx, "test.sfc"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = d.MultiWriteMemory(ctx, snes.MemoryWriteRequest{RequestAddress: snestest.PakAddress(0xF50100), Data: []byte{0x42}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the simulator keeps its state when the device is reopened:
+	driver.DisconnectAll()
+	if actual := snestest.MustReadPak(t, d, 0xF50100, 1); actual[0] != 0x42 {
+		t.Fatalf("expected $42; got $%02x", actual[0])
+	}
+}
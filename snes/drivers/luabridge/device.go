@@ -120,18 +120,18 @@ func (d *Device) CheckVersion() (err error) {
 	return
 }
 
-func (d *Device) FetchFields(ctx context.Context, fields ...snes.Field) (values []string, err error) {
+func (d *Device) FetchFields(ctx context.Context, fields ...snes.Field) (values []snes.FieldValue, err error) {
 	for _, field := range fields {
 		switch field {
 		case snes.Field_DeviceName:
-			values = append(values, d.clientName+"|"+d.host)
+			values = append(values, snes.FieldValue{Value: d.clientName + "|" + d.host})
 			break
 		case snes.Field_DeviceVersion:
-			values = append(values, d.version)
+			values = append(values, snes.FieldValue{Value: d.version})
 			break
 		default:
-			// unknown value; append empty string to maintain index association:
-			values = append(values, "")
+			// unsupported value; append marker to maintain index association:
+			values = append(values, snes.FieldUnsupported)
 			break
 		}
 	}
@@ -53,6 +53,7 @@ var driverCapabilities = []sni.DeviceCapability{
 	sni.DeviceCapability_ResetSystem,
 	sni.DeviceCapability_PauseUnpauseEmulation,
 	sni.DeviceCapability_PauseToggleEmulation,
+	sni.DeviceCapability_FetchFields,
 }
 
 func (d *Driver) HasCapabilities(capabilities ...sni.DeviceCapability) (bool, error) {
@@ -228,6 +229,9 @@ func DriverInit() {
 			time.Sleep(time.Second)
 		}
 
+		// emulator reads are cheap so only coalesce when configured:
+		snes.ConfigureReadCache(driverName, "SNI_LUABRIDGE_READ_CACHE_FRAMES", 0)
+
 		// finally register the driver:
 		snes.Register(driverName, driver)
 	}()
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"google.golang.org/grpc/codes"
 	"log"
 	"sni/cmd/sni/config"
 	"sni/protos/sni"
@@ -15,11 +16,58 @@ import (
 
 const readWriteTimeout = time.Second * 15
 
+// bizhawkDomains maps memory types to the names of BizHawk's SNES memory domains:
+var bizhawkDomains = map[mapping.MemoryType]string{
+	mapping.MemoryTypeROM:   "CARTROM",
+	mapping.MemoryTypeSRAM:  "CARTRAM",
+	mapping.MemoryTypeWRAM:  "WRAM",
+	mapping.MemoryTypeVRAM:  "VRAM",
+	mapping.MemoryTypeAPU:   "APURAM",
+	mapping.MemoryTypeCGRAM: "CGRAM",
+	mapping.MemoryTypeOAM:   "OAM",
+}
+
+// memoryDomain returns the BizHawk memory domain to access for the given memory type
+func memoryDomain(memoryType mapping.MemoryType) string {
+	if domain, ok := bizhawkDomains[memoryType]; ok {
+		return domain
+	}
+	return string(memoryType)
+}
+
+// checkMemoryType fails for memory the emulator's lua API cannot reach so that e.g. a VRAM read is not answered
+// with WRAM contents. Only BizHawk exposes memory that is not on the SNES A-bus and neither emulator exposes the
+// MISC, PPUREG and CPUREG snapshots the FX Pak Pro keeps.
+func (d *Device) checkMemoryType(a *snes.AddressTuple) error {
+	memoryType, _, _ := mapping.MemoryTypeFor(a)
+	switch memoryType {
+	case mapping.MemoryTypeVRAM, mapping.MemoryTypeAPU, mapping.MemoryTypeCGRAM, mapping.MemoryTypeOAM:
+		if d.isBizHawk {
+			return nil
+		}
+	case mapping.MemoryTypeMISC, mapping.MemoryTypePPUREG, mapping.MemoryTypeCPUREG:
+		// not accessible from any emulator:
+	default:
+		return nil
+	}
+	return snes.WithCode(
+		codes.Unimplemented,
+		fmt.Errorf("luabridge: %s memory is not accessible from this emulator", memoryType),
+	)
+}
+
 func (d *Device) DefaultAddressSpace(context.Context) (sni.AddressSpace, error) {
 	return defaultAddressSpace, nil
 }
 
 func (d *Device) MultiReadMemory(ctx context.Context, reads ...snes.MemoryReadRequest) (rsp []snes.MemoryReadResponse, err error) {
+	// reject inaccessible memory before any errors close the device:
+	for _, read := range reads {
+		if err = d.checkMemoryType(&read.RequestAddress); err != nil {
+			return
+		}
+	}
+
 	defer func() {
 		if err != nil {
 			rsp = nil
@@ -42,13 +90,11 @@ func (d *Device) MultiReadMemory(ctx context.Context, reads ...snes.MemoryReadRe
 
 		sb := bytes.NewBuffer(make([]byte, 0, 64))
 		if d.isBizHawk {
-			var domain mapping.MemoryType
+			var memoryType mapping.MemoryType
 			var offset uint32
 			addressSpace = sni.AddressSpace_FxPakPro
-			domain, addr, offset = mapping.MemoryTypeFor(&read.RequestAddress)
-			if domain == "SRAM" {
-				domain = "CARTRAM"
-			}
+			memoryType, addr, offset = mapping.MemoryTypeFor(&read.RequestAddress)
+			domain := memoryDomain(memoryType)
 			_, _ = fmt.Fprintf(sb, "Read|%d|%d|%s\n", offset, read.Size, domain)
 		} else {
 			addressSpace = sni.AddressSpace_SnesABus
@@ -105,6 +151,13 @@ func (d *Device) MultiReadMemory(ctx context.Context, reads ...snes.MemoryReadRe
 }
 
 func (d *Device) MultiWriteMemory(ctx context.Context, writes ...snes.MemoryWriteRequest) (rsp []snes.MemoryWriteResponse, err error) {
+	// reject inaccessible memory before any errors close the device:
+	for _, write := range writes {
+		if err = d.checkMemoryType(&write.RequestAddress); err != nil {
+			return
+		}
+	}
+
 	defer func() {
 		if err != nil {
 			rsp = nil
@@ -128,13 +181,11 @@ func (d *Device) MultiWriteMemory(ctx context.Context, writes ...snes.MemoryWrit
 		// preallocate enough space to write the whole command:
 		sb := bytes.NewBuffer(make([]byte, 0, 24+4*len(write.Data)))
 		if d.isBizHawk {
-			var domain mapping.MemoryType
+			var memoryType mapping.MemoryType
 			var offset uint32
 			addressSpace = sni.AddressSpace_FxPakPro
-			domain, addr, offset = mapping.MemoryTypeFor(&write.RequestAddress)
-			if domain == "SRAM" {
-				domain = "CARTRAM"
-			}
+			memoryType, addr, offset = mapping.MemoryTypeFor(&write.RequestAddress)
+			domain := memoryDomain(memoryType)
 			_, _ = fmt.Fprintf(sb, "Write|%d|%s", offset, domain)
 		} else {
 			addressSpace = sni.AddressSpace_SnesABus
@@ -0,0 +1,234 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"sni/protos/sni"
+	"sni/snes"
+	"sni/snes/mapping"
+	"sni/snes/timing"
+	"sync"
+	"time"
+)
+
+const (
+	romStart  = 0x000000
+	sramStart = 0xE00000
+	sramEnd   = 0xF00000
+	wramStart = 0xF50000
+	wramEnd   = 0xF70000
+)
+
+// Console is a virtual SNES with a cartridge slot. Its memory is laid out in the FX Pak Pro address space:
+// ROM contents at $00_0000, SRAM at $E0_0000 and WRAM at $F5_0000. The console keeps running while devices
+// connected to it are closed and reopened.
+type Console struct {
+	lock sync.Mutex
+
+	Memory [0x1000000]byte
+	WRAM   []byte
+	// SRAM is sized according to the loaded ROM's header and is empty if the ROM has no SRAM
+	SRAM []byte
+
+	rom     *snes.ROM
+	romCRC  uint32
+	mapping sni.MemoryMapping
+	paused  bool
+
+	frameTicker *time.Ticker
+	stop        chan struct{}
+}
+
+func NewConsole() *Console {
+	c := &Console{stop: make(chan struct{})}
+	c.WRAM = c.Memory[wramStart:wramEnd]
+	c.SRAM = c.Memory[sramStart:sramStart]
+
+	// 5,369,317.5/89,341.5 ~= 60.0988 frames / sec ~= 16,639,265.605 ns / frame
+	c.frameTicker = time.NewTicker(timing.Frame)
+	go c.run()
+
+	return c
+}
+
+func (c *Console) run() {
+	for {
+		select {
+		case <-c.frameTicker.C:
+			c.lock.Lock()
+			if !c.paused {
+				// increment frame timer:
+				c.WRAM[0x1A]++
+			}
+			c.lock.Unlock()
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+// Stop stops the console from running frames.
+func (c *Console) Stop() {
+	c.frameTicker.Stop()
+	close(c.stop)
+}
+
+// parseROM reads the ROM's best scoring header, defaulting to LoROM if no header candidate looks valid.
+func parseROM(name string, contents []byte) (rom *snes.ROM, memoryMapping sni.MemoryMapping, err error) {
+	rom, err = snes.NewROM(name, contents)
+	if err != nil {
+		return nil, sni.MemoryMapping_Unknown, err
+	}
+	if len(rom.Contents) > sramStart {
+		return nil, sni.MemoryMapping_Unknown, fmt.Errorf("mock: ROM too large: $%x bytes", len(rom.Contents))
+	}
+
+	if rom.MemoryMapping == sni.MemoryMapping_Unknown {
+		memoryMapping = sni.MemoryMapping_LoROM
+		return
+	}
+
+	// the header's map mode distinguishes mappings sharing a header location, e.g. SA1 from LoROM:
+	header := rom.Contents[rom.HeaderOffset : rom.HeaderOffset+0x50]
+	memoryMapping, _, _, err = mapping.Detect(context.Background(), nil, &rom.MemoryMapping, header)
+	if err != nil {
+		return nil, sni.MemoryMapping_Unknown, err
+	}
+	return
+}
+
+// LoadROM inserts the ROM into the console and resets it. SRAM is cleared and sized according to the ROM header.
+func (c *Console) LoadROM(name string, contents []byte) (err error) {
+	var rom *snes.ROM
+	var memoryMapping sni.MemoryMapping
+	rom, memoryMapping, err = parseROM(name, contents)
+	if err != nil {
+		return
+	}
+
+	sramSize := uint32(0)
+	if rom.Header.RAMSize > 0 {
+		sramSize = rom.Header.RAMSizeBytes()
+		if sramSize > sramEnd-sramStart {
+			sramSize = sramEnd - sramStart
+		}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// clear ROM and SRAM:
+	c.clear(romStart, wramStart)
+	copy(c.Memory[romStart:sramStart], rom.Contents)
+	c.SRAM = c.Memory[sramStart : sramStart+sramSize]
+
+	c.rom = rom
+	c.romCRC = crc32.ChecksumIEEE(rom.Contents)
+	c.mapping = memoryMapping
+	c.reset()
+	return
+}
+
+// clear zeroes memory in [start, end); c.lock must be held.
+func (c *Console) clear(start, end uint32) {
+	m := c.Memory[start:end]
+	for i := range m {
+		m[i] = 0
+	}
+}
+
+// reset clears WRAM and unpauses as the reset button does; c.lock must be held.
+func (c *Console) reset() {
+	c.clear(wramStart, wramEnd)
+	c.paused = false
+}
+
+// Reset presses the reset button; ROM and SRAM are kept.
+func (c *Console) Reset() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.reset()
+}
+
+// ResetToMenu ejects the ROM and returns to the menu. SRAM is cleared along with the ROM.
+func (c *Console) ResetToMenu() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.clear(romStart, sramEnd)
+	c.SRAM = c.Memory[sramStart:sramStart]
+	c.rom = nil
+	c.romCRC = 0
+	c.mapping = sni.MemoryMapping_Unknown
+	c.reset()
+}
+
+// SetPaused pauses or unpauses the console and returns the new paused state.
+func (c *Console) SetPaused(paused bool) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.paused = paused
+	return c.paused
+}
+
+// TogglePaused toggles the paused state.
+func (c *Console) TogglePaused() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.paused = !c.paused
+}
+
+// Mapping returns the memory mapping of the loaded ROM or Unknown if no ROM is loaded.
+func (c *Console) Mapping() sni.MemoryMapping {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.mapping
+}
+
+// Status describes the console as "menu", "paused" or "running"
+func (c *Console) Status() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.rom == nil {
+		return "menu"
+	}
+	if c.paused {
+		return "paused"
+	}
+	return "running"
+}
+
+// ROMInfo returns the name and CRC32 of the loaded ROM; ok is false if no ROM is loaded.
+func (c *Console) ROMInfo() (name string, crc uint32, ok bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.rom == nil {
+		return
+	}
+	return c.rom.Name, c.romCRC, true
+}
+
+// read copies memory at the FX Pak Pro address into p.
+func (c *Console) read(pakAddress uint32, p []byte) error {
+	if uint64(pakAddress)+uint64(len(p)) > uint64(len(c.Memory)) {
+		return fmt.Errorf("mock: read $%x bytes at $%06x is out of range", len(p), pakAddress)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	copy(p, c.Memory[pakAddress:])
+	return nil
+}
+
+// write copies p to memory at the FX Pak Pro address.
+func (c *Console) write(pakAddress uint32, p []byte) error {
+	if uint64(pakAddress)+uint64(len(p)) > uint64(len(c.Memory)) {
+		return fmt.Errorf("mock: write $%x bytes at $%06x is out of range", len(p), pakAddress)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	copy(c.Memory[pakAddress:], p)
+	return nil
+}
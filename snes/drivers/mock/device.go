@@ -2,103 +2,190 @@ package mock
 
 import (
 	"context"
+	"fmt"
 	"sni/protos/sni"
 	"sni/snes"
-	"sni/snes/timing"
+	"sni/snes/mapping"
+	"strconv"
 	"sync"
 	"time"
 )
 
+// Device is a connection to a virtual SNES Console.
 type Device struct {
-	lock sync.Mutex
+	lock   sync.Mutex
+	closed bool
 
-	frameTicker *time.Ticker
-
-	WRAM   []byte
-	Memory [0x1000000]byte
-}
-
-func (d *Device) Init() {
-	// 5,369,317.5/89,341.5 ~= 60.0988 frames / sec ~= 16,639,265.605 ns / frame
-	d.frameTicker = time.NewTicker(timing.Frame)
-
-	go func() {
-		for range d.frameTicker.C {
-			// increment frame timer:
-			d.WRAM[0x1A]++
-		}
-	}()
+	console *Console
+	fs      *filesystem
 }
 
 func (d *Device) IsClosed() bool {
-	return false
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.closed
 }
 
 func (d *Device) Close() error {
-	panic("implement me")
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.closed = true
+	return nil
+}
+
+func (d *Device) checkOpen() error {
+	if d.IsClosed() {
+		return snes.DeviceFatal("mock: device closed", nil)
+	}
+	return nil
 }
 
 func (d *Device) DefaultAddressSpace(context.Context) (space sni.AddressSpace, err error) {
-	return sni.AddressSpace_SnesABus, nil
+	return sni.AddressSpace_FxPakPro, nil
 }
 
-func (d *Device) MultiReadMemory(context context.Context, reads ...snes.MemoryReadRequest) (mrsps []snes.MemoryReadResponse, err error) {
-	// wait 1ms before returning response to simulate the delay of FX Pak Pro device:
-	<-time.After(time.Millisecond * 1)
+// delay simulates the latency of an FX Pak Pro device
+func delay(ctx context.Context) error {
+	t := time.NewTimer(time.Millisecond * 1)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
 
-	if len(reads) == 0 {
+func (d *Device) MultiReadMemory(ctx context.Context, reads ...snes.MemoryReadRequest) (mrsps []snes.MemoryReadResponse, err error) {
+	if err = d.checkOpen(); err != nil {
+		return
+	}
+	if err = delay(ctx); err != nil {
 		return
 	}
 
 	mrsps = make([]snes.MemoryReadResponse, 0, len(reads))
 	for _, read := range reads {
-		data := make([]byte, read.Size)
-		copy(data, d.Memory[read.RequestAddress.Address:int(read.RequestAddress.Address)+read.Size])
-		mrsps = append(mrsps, snes.MemoryReadResponse{
+		rsp := snes.MemoryReadResponse{
 			RequestAddress: read.RequestAddress,
-			Data:           data,
-		})
+			DeviceAddress: snes.AddressTuple{
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: read.RequestAddress.MemoryMapping,
+			},
+			Data: make([]byte, read.Size),
+		}
+
+		rsp.DeviceAddress.Address, err = mapping.TranslateAddress(read.RequestAddress, sni.AddressSpace_FxPakPro)
+		if err != nil {
+			return nil, snes.DeviceNonFatal(fmt.Sprintf("mock: %v", err), err)
+		}
+		if err = d.console.read(rsp.DeviceAddress.Address, rsp.Data); err != nil {
+			return nil, snes.DeviceNonFatal(err.Error(), err)
+		}
+
+		mrsps = append(mrsps, rsp)
 	}
 
 	return
 }
 
-func (d *Device) MultiWriteMemory(context context.Context, writes ...snes.MemoryWriteRequest) (mrsps []snes.MemoryWriteResponse, err error) {
-	// wait 1ms before returning response to simulate the delay of FX Pak Pro device:
-	<-time.After(time.Millisecond * 1)
-
-	if len(writes) == 0 {
+func (d *Device) MultiWriteMemory(ctx context.Context, writes ...snes.MemoryWriteRequest) (mrsps []snes.MemoryWriteResponse, err error) {
+	if err = d.checkOpen(); err != nil {
+		return
+	}
+	if err = delay(ctx); err != nil {
 		return
 	}
 
 	mrsps = make([]snes.MemoryWriteResponse, 0, len(writes))
 	for _, write := range writes {
-		data := write.Data
-		dataLen := len(data)
+		rsp := snes.MemoryWriteResponse{
+			RequestAddress: write.RequestAddress,
+			DeviceAddress: snes.AddressTuple{
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: write.RequestAddress.MemoryMapping,
+			},
+			Size: len(write.Data),
+		}
 
-		copy(d.Memory[write.RequestAddress.Address:int(write.RequestAddress.Address)+dataLen], data)
+		rsp.DeviceAddress.Address, err = mapping.TranslateAddress(write.RequestAddress, sni.AddressSpace_FxPakPro)
+		if err != nil {
+			return nil, snes.DeviceNonFatal(fmt.Sprintf("mock: %v", err), err)
+		}
+		if err = d.console.write(rsp.DeviceAddress.Address, write.Data); err != nil {
+			return nil, snes.DeviceNonFatal(err.Error(), err)
+		}
 
-		mrsps = append(mrsps, snes.MemoryWriteResponse{
-			RequestAddress: write.RequestAddress,
-			Size:           dataLen,
-		})
+		mrsps = append(mrsps, rsp)
 	}
 
 	return
 }
 
 func (d *Device) ResetSystem(ctx context.Context) error {
-	panic("implement me")
+	if err := d.checkOpen(); err != nil {
+		return err
+	}
+	d.console.Reset()
+	return nil
 }
 
 func (d *Device) ResetToMenu(ctx context.Context) error {
-	panic("implement me")
+	if err := d.checkOpen(); err != nil {
+		return err
+	}
+	d.console.ResetToMenu()
+	return nil
 }
 
 func (d *Device) PauseUnpause(ctx context.Context, pausedState bool) (bool, error) {
-	panic("implement me")
+	if err := d.checkOpen(); err != nil {
+		return false, err
+	}
+	return d.console.SetPaused(pausedState), nil
 }
 
 func (d *Device) PauseToggle(ctx context.Context) error {
-	panic("implement me")
+	if err := d.checkOpen(); err != nil {
+		return err
+	}
+	d.console.TogglePaused()
+	return nil
+}
+
+func (d *Device) FetchFields(ctx context.Context, fields ...snes.Field) (values []snes.FieldValue, err error) {
+	if err = d.checkOpen(); err != nil {
+		return
+	}
+
+	romName, romCRC, romLoaded := d.console.ROMInfo()
+	for _, field := range fields {
+		switch field {
+		case snes.Field_DeviceName:
+			values = append(values, snes.FieldValue{Value: "mock"})
+			break
+		case snes.Field_DeviceVersion:
+			values = append(values, snes.FieldValue{Value: "1.0"})
+			break
+		case snes.Field_DeviceStatus:
+			values = append(values, snes.FieldValue{Value: d.console.Status()})
+			break
+		case snes.Field_RomFileName:
+			values = append(values, snes.FieldValue{Value: romName})
+			break
+		case snes.Field_RomCRC32:
+			if !romLoaded {
+				values = append(values, snes.FieldUnsupported)
+				break
+			}
+			values = append(values, snes.FieldValue{Value: strconv.FormatUint(uint64(romCRC), 16)})
+			break
+		default:
+			// unsupported value; append marker to maintain index association:
+			values = append(values, snes.FieldUnsupported)
+			break
+		}
+	}
+
+	return
 }
@@ -0,0 +1,239 @@
+package mock
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sni/protos/sni"
+	"sni/snes"
+	"sni/snes/snestest"
+	"testing"
+	"time"
+)
+
+func newTestDevice(t *testing.T, root string) *Device {
+	c := NewConsole()
+	t.Cleanup(c.Stop)
+	d := &Device{console: c}
+	if root != "" {
+		d.fs = &filesystem{root: root}
+	}
+	return d
+}
+
+func TestConsole_LoadROM(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents []byte
+		mapping  sni.MemoryMapping
+		titleBus uint32
+	}{
+		{"LoROM", snestest.LoROM(0x20000), sni.MemoryMapping_LoROM, 0x00FFC0},
+		{"HiROM", snestest.ROM(0x20000, 0xFFB0, 0x21), sni.MemoryMapping_HiROM, 0xC0FFC0},
+		{"ExLoROM", snestest.ROM(0x408000, 0x407FB0, 0x32), sni.MemoryMapping_ExLoROM, 0x00FFC0},
+		{"SA1", snestest.ROM(0x20000, 0x7FB0, 0x23), sni.MemoryMapping_SA1, 0xC07FC0},
+		{"LoROM with copier header", append(make([]byte, 0x200), snestest.LoROM(0x20000)...), sni.MemoryMapping_LoROM, 0x00FFC0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newTestDevice(t, "")
+			if err := d.console.LoadROM("zelda.sfc", tt.contents); err != nil {
+				t.Fatal(err)
+			}
+			if got := d.console.Mapping(); got != tt.mapping {
+				t.Fatalf("expected mapping %v; got %v", tt.mapping, got)
+			}
+			if len(d.console.SRAM) != 0x2000 {
+				t.Fatalf("expected $2000 bytes of SRAM; got $%x", len(d.console.SRAM))
+			}
+
+			rsps, err := d.MultiReadMemory(context.Background(), snes.MemoryReadRequest{
+				RequestAddress: snestest.BusAddress(tt.titleBus, tt.mapping),
+				Size:           9,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(rsps[0].Data) != "THE LEGEN" {
+				t.Fatalf("unexpected title: %q", rsps[0].Data)
+			}
+		})
+	}
+}
+
+func TestDriver_Device(t *testing.T) {
+	c := NewConsole()
+	t.Cleanup(c.Stop)
+	d := &Driver{console: c, capabilities: driverCapabilities}
+	d.container = snes.NewDeviceDriverContainer(d.openDevice)
+	if err := c.LoadROM("zelda.sfc", snestest.LoROM(0x20000)); err != nil {
+		t.Fatal(err)
+	}
+
+	// the first read through the driver's device opens it in the device container:
+	done := make(chan error, 1)
+	go func() {
+		dev := d.Device(&url.URL{Scheme: driverName, Opaque: "mock"})
+		_, err := dev.MultiReadMemory(context.Background(), snes.MemoryReadRequest{
+			RequestAddress: snestest.BusAddress(0x00FFC0, sni.MemoryMapping_LoROM),
+			Size:           9,
+		})
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out opening the device")
+	}
+}
+
+func TestDevice_Reset(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDevice(t, "")
+	if err := d.console.LoadROM("zelda.sfc", snestest.LoROM(0x20000)); err != nil {
+		t.Fatal(err)
+	}
+
+	wram := snestest.BusAddress(0x7E0100, sni.MemoryMapping_LoROM)
+	sram := snestest.BusAddress(0x700000, sni.MemoryMapping_LoROM)
+	_, err := d.MultiWriteMemory(ctx,
+		snes.MemoryWriteRequest{RequestAddress: wram, Data: []byte{0xAA}},
+		snes.MemoryWriteRequest{RequestAddress: sram, Data: []byte{0x55}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.console.Memory[wramStart+0x100] != 0xAA || d.console.SRAM[0] != 0x55 {
+		t.Fatal("writes did not reach WRAM and SRAM")
+	}
+
+	// reset clears WRAM but keeps SRAM:
+	if err = d.ResetSystem(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if d.console.Memory[wramStart+0x100] != 0 || d.console.SRAM[0] != 0x55 {
+		t.Fatal("reset must clear WRAM and keep SRAM")
+	}
+
+	// reset to menu ejects the ROM:
+	if err = d.ResetToMenu(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if d.console.Mapping() != sni.MemoryMapping_Unknown || len(d.console.SRAM) != 0 || d.console.Status() != "menu" {
+		t.Fatal("reset to menu must eject the ROM")
+	}
+	values, err := d.FetchFields(ctx, snes.Field_RomFileName, snes.Field_RomCRC32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values[0].Value != "" || !values[1].Unsupported {
+		t.Fatalf("unexpected fields after reset to menu: %+v", values)
+	}
+}
+
+func TestDevice_Pause(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDevice(t, "")
+
+	paused, err := d.PauseUnpause(ctx, true)
+	if err != nil || !paused {
+		t.Fatalf("expected paused; got %v, %v", paused, err)
+	}
+	frame := d.console.WRAM[0x1A]
+	time.Sleep(3 * time.Millisecond * 17)
+	d.console.lock.Lock()
+	unchanged := d.console.WRAM[0x1A] == frame
+	d.console.lock.Unlock()
+	if !unchanged {
+		t.Fatal("frame counter must not advance while paused")
+	}
+
+	if err = d.PauseToggle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if status := d.console.Status(); status != "menu" {
+		t.Fatalf("expected menu status without a ROM; got %s", status)
+	}
+	if d.console.SetPaused(false) {
+		t.Fatal("expected unpaused")
+	}
+}
+
+func TestDevice_Filesystem(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	d := newTestDevice(t, root)
+
+	if err := d.MakeDirectory(ctx, "/roms"); err != nil {
+		t.Fatal(err)
+	}
+
+	rom := snestest.ROM(0x20000, 0xFFB0, 0x21)
+	var progressed uint32
+	n, err := d.PutFile(ctx, "/roms/game.sfc", uint32(len(rom)), bytes.NewReader(rom), func(current, total uint32) {
+		progressed = current
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != uint32(len(rom)) || progressed != n {
+		t.Fatalf("expected $%x bytes written; got $%x (progress $%x)", len(rom), n, progressed)
+	}
+
+	if err = d.RenameFile(ctx, "/roms/game.sfc", "zelda.sfc"); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := d.ReadDirectory(ctx, "/roms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 || entries[2].Name != "zelda.sfc" || entries[2].Type != sni.DirEntryType_File {
+		t.Fatalf("unexpected directory entries: %+v", entries)
+	}
+
+	got := &bytes.Buffer{}
+	if _, err = d.GetFile(ctx, "/roms/zelda.sfc", got, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Bytes(), rom) {
+		t.Fatal("GetFile data mismatch")
+	}
+
+	if err = d.BootFile(ctx, "/roms/zelda.sfc"); err != nil {
+		t.Fatal(err)
+	}
+	if d.console.Mapping() != sni.MemoryMapping_HiROM || d.console.Status() != "running" {
+		t.Fatal("BootFile must load the ROM")
+	}
+
+	// paths cannot escape the root directory:
+	if _, err = d.PutFile(ctx, "/../escape.txt", 1, bytes.NewReader([]byte{1}), nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(filepath.Join(root, "escape.txt")); err != nil {
+		t.Fatal("expected file to be written within the root directory")
+	}
+
+	if err = d.RemoveFile(ctx, "/missing.sfc"); !isCode(err, codes.NotFound) {
+		t.Fatalf("expected NotFound; got %v", err)
+	}
+}
+
+func TestDevice_FilesystemDisabled(t *testing.T) {
+	d := newTestDevice(t, "")
+	if _, err := d.ReadDirectory(context.Background(), "/"); !isCode(err, codes.Unimplemented) {
+		t.Fatalf("expected Unimplemented; got %v", err)
+	}
+}
+
+func isCode(err error, code codes.Code) bool {
+	var coded *snes.CodedError
+	return errors.As(err, &coded) && coded.Code == code
+}
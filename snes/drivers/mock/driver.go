@@ -1,8 +1,10 @@
 package mock
 
 import (
+	"io/ioutil"
 	"log"
 	"net/url"
+	"path/filepath"
 	"sni/protos/sni"
 	"sni/snes"
 	"sni/util"
@@ -13,6 +15,10 @@ const driverName = "mock"
 
 type Driver struct {
 	container snes.DeviceContainer
+
+	console      *Console
+	fs           *filesystem
+	capabilities []sni.DeviceCapability
 }
 
 var driver *Driver
@@ -34,10 +40,26 @@ func (d *Driver) Kind() string { return "mock" }
 var driverCapabilities = []sni.DeviceCapability{
 	sni.DeviceCapability_ReadMemory,
 	sni.DeviceCapability_WriteMemory,
+	sni.DeviceCapability_ResetSystem,
+	sni.DeviceCapability_ResetToMenu,
+	sni.DeviceCapability_PauseUnpauseEmulation,
+	sni.DeviceCapability_PauseToggleEmulation,
+	sni.DeviceCapability_FetchFields,
+}
+
+// filesystemCapabilities are only available when the virtual SD card is backed by SNI_MOCK_ROOT_DIR
+var filesystemCapabilities = []sni.DeviceCapability{
+	sni.DeviceCapability_ReadDirectory,
+	sni.DeviceCapability_MakeDirectory,
+	sni.DeviceCapability_RemoveFile,
+	sni.DeviceCapability_RenameFile,
+	sni.DeviceCapability_PutFile,
+	sni.DeviceCapability_GetFile,
+	sni.DeviceCapability_BootFile,
 }
 
 func (d *Driver) HasCapabilities(capabilities ...sni.DeviceCapability) (bool, error) {
-	return snes.CheckCapabilities(capabilities, driverCapabilities)
+	return snes.CheckCapabilities(capabilities, d.capabilities)
 }
 
 func (d *Driver) Detect() ([]snes.DeviceDescriptor, error) {
@@ -46,23 +68,16 @@ func (d *Driver) Detect() ([]snes.DeviceDescriptor, error) {
 			Uri:                 url.URL{Scheme: driverName, Opaque: "mock"},
 			DisplayName:         "Mock",
 			Kind:                d.Kind(),
-			Capabilities:        driverCapabilities[:],
-			DefaultAddressSpace: sni.AddressSpace_SnesABus,
+			Capabilities:        d.capabilities[:],
+			DefaultAddressSpace: sni.AddressSpace_FxPakPro,
 		},
 	}, nil
 }
 
+// openDevice is called by the device container with its lock held so it must not call back into the container.
 func (d *Driver) openDevice(uri *url.URL) (snes.Device, error) {
-	dev, ok := d.container.GetDevice(d.DeviceKey(uri))
-	if ok {
-		return dev, nil
-	}
-
-	mock := &Device{}
-	mock.WRAM = mock.Memory[0xF50000:0xF70000]
-	mock.Init()
-
-	return mock, nil
+	// all devices connect to the same console so its state survives reopening:
+	return &Device{console: d.console, fs: d.fs}, nil
 }
 
 func (d *Driver) Device(uri *url.URL) snes.AutoCloseableDevice {
@@ -85,7 +100,26 @@ func (d *Driver) DisconnectAll() {
 func DriverInit() {
 	if util.IsTruthy(env.GetOrDefault("SNI_MOCK_ENABLE", "0")) {
 		log.Printf("enabling mock snes driver\n")
-		driver = &Driver{}
+		driver = &Driver{
+			console:      NewConsole(),
+			capabilities: driverCapabilities,
+		}
+
+		if root := env.GetOrDefault("SNI_MOCK_ROOT_DIR", ""); root != "" {
+			driver.fs = &filesystem{root: root}
+			driver.capabilities = append(append([]sni.DeviceCapability(nil), driverCapabilities...), filesystemCapabilities...)
+		}
+
+		if romPath := env.GetOrDefault("SNI_MOCK_ROM", ""); romPath != "" {
+			contents, err := ioutil.ReadFile(romPath)
+			if err == nil {
+				err = driver.console.LoadROM(filepath.Base(romPath), contents)
+			}
+			if err != nil {
+				log.Printf("%s: could not load ROM: %v\n", driverName, err)
+			}
+		}
+
 		driver.container = snes.NewDeviceDriverContainer(driver.openDevice)
 		snes.Register(driverName, driver)
 	}
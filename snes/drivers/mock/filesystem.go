@@ -0,0 +1,206 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sni/protos/sni"
+	"sni/snes"
+	"strings"
+)
+
+// filesystem serves the virtual SD card from a host directory
+type filesystem struct {
+	root string
+}
+
+// hostPath maps an SD card path to a path under the root directory without allowing escape from it
+func (fs *filesystem) hostPath(p string) string {
+	return filepath.Join(fs.root, filepath.FromSlash(path.Clean("/"+p)))
+}
+
+func fsError(err error) error {
+	if os.IsNotExist(err) {
+		return snes.WithCode(codes.NotFound, err)
+	}
+	if os.IsExist(err) {
+		return snes.WithCode(codes.AlreadyExists, err)
+	}
+	return snes.DeviceNonFatal(err.Error(), err)
+}
+
+func (d *Device) filesystem() (*filesystem, error) {
+	if err := d.checkOpen(); err != nil {
+		return nil, err
+	}
+	if d.fs == nil {
+		return nil, snes.WithCode(codes.Unimplemented, fmt.Errorf("mock: filesystem disabled; set SNI_MOCK_ROOT_DIR"))
+	}
+	return d.fs, nil
+}
+
+func (d *Device) ReadDirectory(ctx context.Context, p string) (entries []snes.DirEntry, err error) {
+	var fs *filesystem
+	if fs, err = d.filesystem(); err != nil {
+		return
+	}
+
+	var infos []os.FileInfo
+	infos, err = ioutil.ReadDir(fs.hostPath(p))
+	if err != nil {
+		return nil, fsError(err)
+	}
+
+	entries = make([]snes.DirEntry, 0, len(infos)+2)
+	// the FX Pak Pro lists these too:
+	entries = append(entries,
+		snes.DirEntry{Name: ".", Type: sni.DirEntryType_Directory},
+		snes.DirEntry{Name: "..", Type: sni.DirEntryType_Directory},
+	)
+	for _, info := range infos {
+		entry := snes.DirEntry{Name: info.Name(), Type: sni.DirEntryType_File}
+		if info.IsDir() {
+			entry.Type = sni.DirEntryType_Directory
+		}
+		entries = append(entries, entry)
+	}
+	return
+}
+
+func (d *Device) MakeDirectory(ctx context.Context, p string) (err error) {
+	var fs *filesystem
+	if fs, err = d.filesystem(); err != nil {
+		return
+	}
+	if err = os.Mkdir(fs.hostPath(p), 0755); err != nil {
+		return fsError(err)
+	}
+	return
+}
+
+func (d *Device) RemoveFile(ctx context.Context, p string) (err error) {
+	var fs *filesystem
+	if fs, err = d.filesystem(); err != nil {
+		return
+	}
+	if err = os.Remove(fs.hostPath(p)); err != nil {
+		return fsError(err)
+	}
+	return
+}
+
+// RenameFile renames the file within its folder as the FX Pak Pro does; newFilename must not contain a path.
+func (d *Device) RenameFile(ctx context.Context, p, newFilename string) (err error) {
+	var fs *filesystem
+	if fs, err = d.filesystem(); err != nil {
+		return
+	}
+	if newFilename == "" || strings.ContainsAny(newFilename, "/\\") || newFilename == "." || newFilename == ".." {
+		return snes.WithCode(codes.InvalidArgument, fmt.Errorf("mock: invalid new filename '%s'", newFilename))
+	}
+
+	oldPath := fs.hostPath(p)
+	if err = os.Rename(oldPath, filepath.Join(filepath.Dir(oldPath), newFilename)); err != nil {
+		return fsError(err)
+	}
+	return
+}
+
+func (d *Device) PutFile(ctx context.Context, p string, size uint32, r io.Reader, progress snes.ProgressReportFunc) (n uint32, err error) {
+	var fs *filesystem
+	if fs, err = d.filesystem(); err != nil {
+		return
+	}
+
+	var f *os.File
+	f, err = os.Create(fs.hostPath(p))
+	if err != nil {
+		return 0, fsError(err)
+	}
+
+	buf := make([]byte, 4096)
+	for n < size {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+
+		chunk := buf
+		if remaining := size - n; remaining < uint32(len(chunk)) {
+			chunk = chunk[:remaining]
+		}
+
+		var m int
+		m, err = io.ReadFull(r, chunk)
+		if m > 0 {
+			if _, werr := f.Write(chunk[:m]); werr != nil && err == nil {
+				err = werr
+			}
+			n += uint32(m)
+			if progress != nil {
+				progress(n, size)
+			}
+		}
+		if err != nil {
+			break
+		}
+	}
+
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		// do not leave a partial file behind:
+		_ = os.Remove(fs.hostPath(p))
+		return n, snes.DeviceNonFatal(fmt.Sprintf("mock: putfile: %v", err), err)
+	}
+	return
+}
+
+func (d *Device) GetFile(ctx context.Context, p string, w io.Writer, sizeReceived snes.SizeReceivedFunc, progress snes.ProgressReportFunc) (size uint32, err error) {
+	var fs *filesystem
+	if fs, err = d.filesystem(); err != nil {
+		return
+	}
+
+	var data []byte
+	data, err = ioutil.ReadFile(fs.hostPath(p))
+	if err != nil {
+		return 0, fsError(err)
+	}
+
+	size = uint32(len(data))
+	if sizeReceived != nil {
+		sizeReceived(size)
+	}
+	if _, err = w.Write(data); err != nil {
+		return 0, snes.DeviceNonFatal(fmt.Sprintf("mock: getfile: %v", err), err)
+	}
+	if progress != nil {
+		progress(size, size)
+	}
+	return
+}
+
+// BootFile loads the ROM file from the virtual SD card into the console and resets it.
+func (d *Device) BootFile(ctx context.Context, p string) (err error) {
+	var fs *filesystem
+	if fs, err = d.filesystem(); err != nil {
+		return
+	}
+
+	var contents []byte
+	contents, err = ioutil.ReadFile(fs.hostPath(p))
+	if err != nil {
+		return fsError(err)
+	}
+
+	if err = d.console.LoadROM(path.Base(p), contents); err != nil {
+		return snes.WithCode(codes.InvalidArgument, err)
+	}
+	return
+}
@@ -0,0 +1,413 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"sni/protos/sni"
+	"sni/snes"
+	"sync"
+	"time"
+)
+
+// Recording is a recording loaded into memory.
+type Recording struct {
+	Header snes.RecordingHeader
+	Calls  []snes.RecordedCall
+}
+
+// LoadRecording loads the recording file at path.
+func LoadRecording(path string) (recording *Recording, err error) {
+	var f *os.File
+	f, err = os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	return ReadRecording(f)
+}
+
+// ReadRecording reads a recording from r.
+func ReadRecording(r io.Reader) (recording *Recording, err error) {
+	var rr *snes.RecordingReader
+	rr, err = snes.NewRecordingReader(r)
+	if err != nil {
+		return
+	}
+
+	recording = &Recording{Header: rr.Header}
+	for {
+		var call snes.RecordedCall
+		call, err = rr.Next()
+		if err == io.EOF {
+			err = nil
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("recording: call %d: %w", len(recording.Calls), err)
+		}
+		recording.Calls = append(recording.Calls, call)
+	}
+	return
+}
+
+// DefaultAddressSpace returns the first successfully recorded default address space of the device, otherwise the
+// address space of the first recorded memory read.
+func (r *Recording) DefaultAddressSpace() sni.AddressSpace {
+	for i := range r.Calls {
+		c := &r.Calls[i]
+		if c.Method == "DefaultAddressSpace" && c.Err == nil {
+			return c.AddressSpace
+		}
+	}
+	for i := range r.Calls {
+		c := &r.Calls[i]
+		if c.Method == "MultiReadMemory" && len(c.Reads) > 0 {
+			return c.Reads[0].RequestAddress.AddressSpace
+		}
+	}
+	return sni.AddressSpace_FxPakPro
+}
+
+// Device replays the calls of a recording. Each call is answered with the next recorded call of the same method and
+// arguments, wrapping around to the start of the recording when the end is reached. Memory reads that were not
+// recorded exactly are answered from the most recently recorded data covering them.
+type Device struct {
+	recording *Recording
+	realtime  bool
+
+	lock   sync.Mutex
+	next   int
+	closed bool
+}
+
+// NewDevice creates a device replaying the recording. If realtime is set each call takes as long as recorded.
+func NewDevice(recording *Recording, realtime bool) *Device {
+	return &Device{recording: recording, realtime: realtime}
+}
+
+func (d *Device) IsClosed() bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.closed
+}
+
+func (d *Device) Close() error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.closed = true
+	return nil
+}
+
+// find returns the next recorded call of the method that matches.
+func (d *Device) find(method string, match func(c *snes.RecordedCall) bool) (*snes.RecordedCall, bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	calls := d.recording.Calls
+	n := len(calls)
+	for i := 0; i < n; i++ {
+		j := (d.next + i) % n
+		c := &calls[j]
+		if c.Method != method || !match(c) {
+			continue
+		}
+		d.next = j + 1
+		return c, true
+	}
+	return nil, false
+}
+
+// replay waits for the recorded duration of the call if realtime and returns its recorded error
+func (d *Device) replay(ctx context.Context, c *snes.RecordedCall) error {
+	if d.realtime && c.Duration > 0 {
+		t := time.NewTimer(c.Duration)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		}
+	}
+	return c.Err.AsError()
+}
+
+func notRecorded(method string) error {
+	return snes.DeviceNonFatal(
+		fmt.Sprintf("replay: no recorded %s call matches", method),
+		errors.New("not recorded"),
+	)
+}
+
+func anyArgs(*snes.RecordedCall) bool { return true }
+
+func (d *Device) ResetSystem(ctx context.Context) error {
+	c, ok := d.find("ResetSystem", anyArgs)
+	if !ok {
+		return notRecorded("ResetSystem")
+	}
+	return d.replay(ctx, c)
+}
+
+func (d *Device) ResetToMenu(ctx context.Context) error {
+	c, ok := d.find("ResetToMenu", anyArgs)
+	if !ok {
+		return notRecorded("ResetToMenu")
+	}
+	return d.replay(ctx, c)
+}
+
+func (d *Device) PauseUnpause(ctx context.Context, pausedState bool) (bool, error) {
+	c, ok := d.find("PauseUnpause", func(c *snes.RecordedCall) bool { return c.PausedState == pausedState })
+	if !ok {
+		return false, notRecorded("PauseUnpause")
+	}
+	return c.Ok, d.replay(ctx, c)
+}
+
+func (d *Device) PauseToggle(ctx context.Context) error {
+	c, ok := d.find("PauseToggle", anyArgs)
+	if !ok {
+		return notRecorded("PauseToggle")
+	}
+	return d.replay(ctx, c)
+}
+
+func (d *Device) DefaultAddressSpace(ctx context.Context) (sni.AddressSpace, error) {
+	c, ok := d.find("DefaultAddressSpace", anyArgs)
+	if !ok {
+		return d.recording.DefaultAddressSpace(), nil
+	}
+	return c.AddressSpace, d.replay(ctx, c)
+}
+
+func sameReads(a, b []snes.MemoryReadRequest) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sameWrites(a, b []snes.MemoryWriteRequest) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].RequestAddress != b[i].RequestAddress || !bytes.Equal(a[i].Data, b[i].Data) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameConditions(a, b []snes.MemoryCondition) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].RequestAddress != b[i].RequestAddress ||
+			!bytes.Equal(a[i].Expected, b[i].Expected) ||
+			!bytes.Equal(a[i].Mask, b[i].Mask) {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *Device) MultiReadMemory(ctx context.Context, reads ...snes.MemoryReadRequest) ([]snes.MemoryReadResponse, error) {
+	c, ok := d.find("MultiReadMemory", func(c *snes.RecordedCall) bool { return sameReads(c.Reads, reads) })
+	if ok {
+		return c.ReadResponses, d.replay(ctx, c)
+	}
+
+	rsps := make([]snes.MemoryReadResponse, 0, len(reads))
+	for _, read := range reads {
+		rsp, ok := d.coveringRead(read)
+		if !ok {
+			return nil, notRecorded("MultiReadMemory")
+		}
+		rsps = append(rsps, rsp)
+	}
+	return rsps, nil
+}
+
+// coveringRead slices the data of the most recent successful recorded read response that covers the read, searching
+// backwards from the current position.
+func (d *Device) coveringRead(read snes.MemoryReadRequest) (rsp snes.MemoryReadResponse, ok bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	calls := d.recording.Calls
+	n := len(calls)
+	start := read.RequestAddress.Address
+	end := start + uint32(read.Size)
+	for i := 1; i <= n; i++ {
+		c := &calls[((d.next-i)%n+n)%n]
+		if c.Method != "MultiReadMemory" || c.Err != nil {
+			continue
+		}
+		for _, r := range c.ReadResponses {
+			if r.RequestAddress.AddressSpace != read.RequestAddress.AddressSpace ||
+				r.RequestAddress.MemoryMapping != read.RequestAddress.MemoryMapping {
+				continue
+			}
+			rStart := r.RequestAddress.Address
+			if start < rStart || end > rStart+uint32(len(r.Data)) {
+				continue
+			}
+
+			offs := start - rStart
+			rsp = snes.MemoryReadResponse{
+				RequestAddress: read.RequestAddress,
+				DeviceAddress:  r.DeviceAddress,
+				Data:           make([]byte, read.Size),
+			}
+			rsp.DeviceAddress.Address += offs
+			copy(rsp.Data, r.Data[offs:])
+			return rsp, true
+		}
+	}
+	return
+}
+
+func (d *Device) MultiWriteMemory(ctx context.Context, writes ...snes.MemoryWriteRequest) ([]snes.MemoryWriteResponse, error) {
+	c, ok := d.find("MultiWriteMemory", func(c *snes.RecordedCall) bool { return sameWrites(c.Writes, writes) })
+	if !ok {
+		return nil, notRecorded("MultiWriteMemory")
+	}
+	return c.WriteResponses, d.replay(ctx, c)
+}
+
+func (d *Device) ConditionalWriteMemory(ctx context.Context, conditions []snes.MemoryCondition, writes []snes.MemoryWriteRequest) (snes.ConditionalWriteResponse, error) {
+	c, ok := d.find("ConditionalWriteMemory", func(c *snes.RecordedCall) bool {
+		return sameConditions(c.Conditions, conditions) && sameWrites(c.Writes, writes)
+	})
+	if !ok {
+		return snes.ConditionalWriteResponse{}, notRecorded("ConditionalWriteMemory")
+	}
+	return c.ConditionalWrite, d.replay(ctx, c)
+}
+
+func (d *Device) FetchFields(ctx context.Context, fields ...snes.Field) ([]snes.FieldValue, error) {
+	c, ok := d.find("FetchFields", func(c *snes.RecordedCall) bool {
+		if len(c.Fields) != len(fields) {
+			return false
+		}
+		for i := range fields {
+			if c.Fields[i] != fields[i] {
+				return false
+			}
+		}
+		return true
+	})
+	if !ok {
+		return nil, notRecorded("FetchFields")
+	}
+	return c.FieldValues, d.replay(ctx, c)
+}
+
+func (d *Device) ExecuteASM(ctx context.Context, code []byte, data []byte) error {
+	c, ok := d.find("ExecuteASM", func(c *snes.RecordedCall) bool {
+		return bytes.Equal(c.Code, code) && bytes.Equal(c.Data, data)
+	})
+	if !ok {
+		return notRecorded("ExecuteASM")
+	}
+	return d.replay(ctx, c)
+}
+
+func (d *Device) ReadDirectory(ctx context.Context, path string) ([]snes.DirEntry, error) {
+	c, ok := d.find("ReadDirectory", func(c *snes.RecordedCall) bool { return c.Path == path })
+	if !ok {
+		return nil, notRecorded("ReadDirectory")
+	}
+	return c.DirEntries, d.replay(ctx, c)
+}
+
+func (d *Device) MakeDirectory(ctx context.Context, path string) error {
+	c, ok := d.find("MakeDirectory", func(c *snes.RecordedCall) bool { return c.Path == path })
+	if !ok {
+		return notRecorded("MakeDirectory")
+	}
+	return d.replay(ctx, c)
+}
+
+func (d *Device) RemoveFile(ctx context.Context, path string) error {
+	c, ok := d.find("RemoveFile", func(c *snes.RecordedCall) bool { return c.Path == path })
+	if !ok {
+		return notRecorded("RemoveFile")
+	}
+	return d.replay(ctx, c)
+}
+
+func (d *Device) RenameFile(ctx context.Context, path, newFilename string) error {
+	c, ok := d.find("RenameFile", func(c *snes.RecordedCall) bool {
+		return c.Path == path && c.NewPath == newFilename
+	})
+	if !ok {
+		return notRecorded("RenameFile")
+	}
+	return d.replay(ctx, c)
+}
+
+func (d *Device) PutFile(ctx context.Context, path string, size uint32, r io.Reader, progress snes.ProgressReportFunc) (n uint32, err error) {
+	h := crc32.NewIEEE()
+	var written int64
+	written, err = io.Copy(h, io.LimitReader(r, int64(size)))
+	if err != nil {
+		return
+	}
+	n = uint32(written)
+	if progress != nil {
+		progress(n, size)
+	}
+
+	crc := h.Sum32()
+	c, ok := d.find("PutFile", func(c *snes.RecordedCall) bool {
+		return c.Path == path && c.Size == n && c.CRC32 == crc
+	})
+	if !ok {
+		return 0, notRecorded("PutFile")
+	}
+	return c.Size, d.replay(ctx, c)
+}
+
+func (d *Device) GetFile(ctx context.Context, path string, w io.Writer, sizeReceived snes.SizeReceivedFunc, progress snes.ProgressReportFunc) (size uint32, err error) {
+	c, ok := d.find("GetFile", func(c *snes.RecordedCall) bool { return c.Path == path })
+	if !ok {
+		return 0, notRecorded("GetFile")
+	}
+	if err = d.replay(ctx, c); err != nil {
+		return
+	}
+
+	size = uint32(len(c.Data))
+	if sizeReceived != nil {
+		sizeReceived(size)
+	}
+	if _, err = w.Write(c.Data); err != nil {
+		return
+	}
+	if progress != nil {
+		progress(size, size)
+	}
+	return
+}
+
+func (d *Device) BootFile(ctx context.Context, path string) error {
+	c, ok := d.find("BootFile", func(c *snes.RecordedCall) bool { return c.Path == path })
+	if !ok {
+		return notRecorded("BootFile")
+	}
+	return d.replay(ctx, c)
+}
@@ -0,0 +1,100 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"sni/protos/sni"
+	"sni/snes"
+	"testing"
+)
+
+func testRecording(t *testing.T, calls ...snes.RecordedCall) *Recording {
+	buf := &bytes.Buffer{}
+	rw, err := snes.NewRecordingWriter(buf, snes.RecordingHeader{URI: "test:device"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range calls {
+		if err = rw.Write(&calls[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = rw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	recording, err := ReadRecording(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return recording
+}
+
+func readCall(address uint32, data ...byte) snes.RecordedCall {
+	a := snes.AddressTuple{Address: address, AddressSpace: sni.AddressSpace_SnesABus, MemoryMapping: sni.MemoryMapping_LoROM}
+	return snes.RecordedCall{
+		Method:        "MultiReadMemory",
+		Reads:         []snes.MemoryReadRequest{{RequestAddress: a, Size: len(data)}},
+		ReadResponses: []snes.MemoryReadResponse{{RequestAddress: a, DeviceAddress: a, Data: data}},
+	}
+}
+
+func TestDevice_MultiReadMemory(t *testing.T) {
+	d := NewDevice(testRecording(t,
+		readCall(0x7E0010, 1, 2, 3, 4),
+		readCall(0x7E0010, 5, 6, 7, 8),
+	), false)
+
+	if space := d.recording.DefaultAddressSpace(); space != sni.AddressSpace_SnesABus {
+		t.Fatalf("expected SnesABus default address space; got %v", space)
+	}
+
+	ctx := context.Background()
+	read := readCall(0x7E0010, 0, 0, 0, 0).Reads[0]
+
+	// exact matches are replayed in order and wrap around:
+	for _, expected := range [][]byte{{1, 2, 3, 4}, {5, 6, 7, 8}, {1, 2, 3, 4}} {
+		rsps, err := d.MultiReadMemory(ctx, read)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(rsps[0].Data, expected) {
+			t.Fatalf("expected %v; got %v", expected, rsps[0].Data)
+		}
+	}
+
+	// reads within recorded data are served from the most recent data before the current position:
+	read.RequestAddress.Address = 0x7E0011
+	read.Size = 2
+	rsps, err := d.MultiReadMemory(ctx, read)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(rsps[0].Data, []byte{2, 3}) || rsps[0].DeviceAddress.Address != 0x7E0011 {
+		t.Fatalf("unexpected covering read response: %+v", rsps[0])
+	}
+
+	// reads outside recorded data fail:
+	read.RequestAddress.Address = 0x7E0100
+	if _, err = d.MultiReadMemory(ctx, read); err == nil || snes.IsFatal(err) {
+		t.Fatalf("expected non-fatal error; got %v", err)
+	}
+}
+
+func TestDevice_Errors(t *testing.T) {
+	d := NewDevice(testRecording(t,
+		snes.RecordedCall{Method: "BootFile", Path: "/a.sfc"},
+		snes.RecordedCall{Method: "BootFile", Path: "/b.sfc", Err: &snes.RecordedError{Message: "boot failed", Device: true, Fatal: true}},
+	), false)
+
+	ctx := context.Background()
+	if err := d.BootFile(ctx, "/b.sfc"); err == nil || !snes.IsFatal(err) {
+		t.Fatalf("expected recorded fatal error; got %v", err)
+	}
+	if err := d.BootFile(ctx, "/a.sfc"); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.BootFile(ctx, "/c.sfc"); err == nil {
+		t.Fatal("expected error for unrecorded call")
+	}
+}
@@ -0,0 +1,183 @@
+package replay
+
+import (
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sni/protos/sni"
+	"sni/snes"
+	"sni/util"
+	"sni/util/env"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const driverName = "replay"
+
+// Driver serves device calls recorded with SNI_RECORD_DIR back to clients. Devices are recording files addressed as
+// `replay://./path/to/recording.snirec`; recordings found in SNI_REPLAY_DIR are detected automatically.
+type Driver struct {
+	container snes.DeviceContainer
+
+	dir string
+
+	// cache recording summaries by path to avoid decoding recordings on every detection:
+	summariesMu sync.Mutex
+	summaries   map[string]recordingSummary
+}
+
+type recordingSummary struct {
+	modTime time.Time
+	size    int64
+
+	defaultAddressSpace sni.AddressSpace
+}
+
+var driver *Driver
+
+func (d *Driver) DisplayOrder() int {
+	return 900
+}
+
+func (d *Driver) DisplayName() string {
+	return "Replay"
+}
+
+func (d *Driver) DisplayDescription() string {
+	return "Replay device traffic recorded by SNI"
+}
+
+func (d *Driver) Kind() string { return "replay" }
+
+// driverCapabilities includes every capability since recordings may come from any device
+var driverCapabilities = func() (capabilities []sni.DeviceCapability) {
+	for value := range sni.DeviceCapability_name {
+		if value == int32(sni.DeviceCapability_None) {
+			continue
+		}
+		capabilities = append(capabilities, sni.DeviceCapability(value))
+	}
+	sort.Slice(capabilities, func(i, j int) bool { return capabilities[i] < capabilities[j] })
+	return
+}()
+
+func (d *Driver) HasCapabilities(capabilities ...sni.DeviceCapability) (bool, error) {
+	return snes.CheckCapabilities(capabilities, driverCapabilities)
+}
+
+func (d *Driver) Detect() (devices []snes.DeviceDescriptor, err error) {
+	if d.dir == "" {
+		return
+	}
+
+	var infos []os.FileInfo
+	infos, err = ioutil.ReadDir(d.dir)
+	if err != nil {
+		return
+	}
+
+	for _, info := range infos {
+		if info.IsDir() || !strings.HasSuffix(info.Name(), snes.RecordingFileExt) {
+			continue
+		}
+
+		path := filepath.Join(d.dir, info.Name())
+		summary, serr := d.summarize(path, info)
+		if serr != nil {
+			log.Printf("%s: %s: %v\n", driverName, path, serr)
+			continue
+		}
+
+		devices = append(devices, snes.DeviceDescriptor{
+			Uri:                 recordingURI(path),
+			DisplayName:         "Replay " + strings.TrimSuffix(info.Name(), snes.RecordingFileExt),
+			Kind:                d.Kind(),
+			Capabilities:        driverCapabilities[:],
+			DefaultAddressSpace: summary.defaultAddressSpace,
+		})
+	}
+
+	return
+}
+
+func (d *Driver) summarize(path string, info os.FileInfo) (summary recordingSummary, err error) {
+	d.summariesMu.Lock()
+	defer d.summariesMu.Unlock()
+
+	summary, ok := d.summaries[path]
+	if ok && summary.modTime.Equal(info.ModTime()) && summary.size == info.Size() {
+		return
+	}
+
+	var recording *Recording
+	recording, err = LoadRecording(path)
+	if err != nil {
+		return
+	}
+
+	summary = recordingSummary{
+		modTime:             info.ModTime(),
+		size:                info.Size(),
+		defaultAddressSpace: recording.DefaultAddressSpace(),
+	}
+	d.summaries[path] = summary
+	return
+}
+
+// recordingURI returns the device URI for the recording file
+func recordingURI(path string) url.URL {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	return url.URL{Scheme: driverName, Host: ".", Path: filepath.ToSlash(path)}
+}
+
+// recordingPath returns the recording file path of the device URI
+func recordingPath(uri *url.URL) string {
+	path := uri.Path
+	// windows paths look like `/C:/...`:
+	if len(path) >= 3 && path[0] == '/' && path[2] == ':' {
+		path = path[1:]
+	}
+	return filepath.FromSlash(path)
+}
+
+func (d *Driver) openDevice(uri *url.URL) (snes.Device, error) {
+	recording, err := LoadRecording(recordingPath(uri))
+	if err != nil {
+		return nil, err
+	}
+
+	realtime := util.IsTruthy(uri.Query().Get("realtime"))
+	return NewDevice(recording, realtime), nil
+}
+
+func (d *Driver) Device(uri *url.URL) snes.AutoCloseableDevice {
+	return snes.NewAutoCloseableDevice(d.container, uri, d.DeviceKey(uri))
+}
+
+func (d *Driver) DeviceKey(uri *url.URL) string { return recordingPath(uri) }
+
+func (d *Driver) DisconnectAll() {
+	for _, deviceKey := range d.container.AllDeviceKeys() {
+		device, ok := d.container.GetDevice(deviceKey)
+		if ok {
+			log.Printf("%s: disconnecting device '%s'\n", driverName, deviceKey)
+			_ = device.Close()
+			d.container.DeleteDevice(deviceKey)
+		}
+	}
+}
+
+func DriverInit() {
+	driver = &Driver{
+		dir:       env.GetOrDefault("SNI_REPLAY_DIR", ""),
+		summaries: make(map[string]recordingSummary),
+	}
+	driver.container = snes.NewDeviceDriverContainer(driver.openDevice)
+	snes.Register(driverName, driver)
+}
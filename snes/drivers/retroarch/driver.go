@@ -62,6 +62,7 @@ var driverCapabilities = []sni.DeviceCapability{
 	sni.DeviceCapability_WriteMemory,
 	sni.DeviceCapability_ResetSystem,
 	sni.DeviceCapability_PauseToggleEmulation,
+	sni.DeviceCapability_FetchFields,
 }
 
 func (d *Driver) HasCapabilities(capabilities ...sni.DeviceCapability) (bool, error) {
@@ -226,6 +227,9 @@ func DriverInit() {
 		log.Printf("enabling retroarch detector logging")
 	}
 
+	// emulator reads are cheap so only coalesce when configured:
+	snes.ConfigureReadCache(driverName, "SNI_RETROARCH_READ_CACHE_FRAMES", 0)
+
 	// register the driver:
 	driver = NewDriver(addresses)
 	snes.Register(driverName, driver)
@@ -0,0 +1,369 @@
+package retroarch
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/url"
+	"os"
+	"sni/protos/sni"
+	"sni/snes"
+	"sni/snes/drivers/retroarch/raemu"
+	"sni/snes/snestest"
+	"testing"
+	"time"
+)
+
+var testVersions = []string{"1.9.0", "1.10.3"}
+
+// testROM returns a 128KiB LoROM ROM with a title
+func testROM() []byte {
+	contents := make([]byte, 0x20000)
+	for i := range contents {
+		contents[i] = byte(i * 3)
+	}
+	copy(contents[0x7FC0:], "THE LEGEND OF ZELDA  ")
+	return contents
+}
+
+func startEmulator(t *testing.T, version string) *raemu.Emulator {
+	e, err := raemu.Listen("127.0.0.1:0", version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = e.Close() })
+	if err = e.LoadROM("/roms/test.sfc", testROM(), sni.MemoryMapping_LoROM); err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func newTestDriver(t *testing.T, e *raemu.Emulator) *Driver {
+	d := NewDriver([]*net.UDPAddr{e.Addr()})
+	t.Cleanup(func() {
+		d.DisconnectAll()
+		for _, detector := range d.detectors {
+			_ = detector.Close()
+		}
+	})
+	return d
+}
+
+func openTestDevice(t *testing.T, e *raemu.Emulator) snes.AutoCloseableDevice {
+	d := newTestDriver(t, e)
+	return d.Device(&url.URL{Scheme: driverName, Host: e.Addr().String()})
+}
+
+func countCommand(commands []string, command string) (n int) {
+	for _, c := range commands {
+		if c == command {
+			n++
+		}
+	}
+	return
+}
+
+func TestDriverInit_readCacheFrames(t *testing.T) {
+	// other tests expect the default of no read cache:
+	t.Cleanup(func() { snes.ConfigureReadCache(driverName, "SNI_RETROARCH_READ_CACHE_FRAMES", 0) })
+
+	// the env var documented in the README:
+	for name, value := range map[string]string{
+		"SNI_RETROARCH_HOSTS":             "127.0.0.1:55355",
+		"SNI_RETROARCH_READ_CACHE_FRAMES": "3",
+	} {
+		if err := os.Setenv(name, value); err != nil {
+			t.Fatal(err)
+		}
+		defer os.Unsetenv(name)
+	}
+
+	DriverInit()
+	if got := snes.ReadCacheFrames(driverName); got != 3 {
+		t.Errorf("ReadCacheFrames(%q) = %d, want %d", driverName, got, 3)
+	}
+}
+
+func TestDriver_Detect(t *testing.T) {
+	for _, version := range testVersions {
+		t.Run(version, func(t *testing.T) {
+			e := startEmulator(t, version)
+			d := newTestDriver(t, e)
+
+			// the first VERSION reply is lost so the detector times out:
+			dropped := false
+			e.SetFaults(func(command string) raemu.Fault {
+				if command == "VERSION" && !dropped {
+					dropped = true
+					return raemu.FaultDrop
+				}
+				return raemu.FaultNone
+			})
+			devices, err := d.Detect()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(devices) != 0 {
+				t.Fatalf("expected no devices; got %v", devices)
+			}
+
+			// the detector recovers on the next attempt:
+			devices, err = d.Detect()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(devices) != 1 {
+				t.Fatalf("expected 1 device; got %v", devices)
+			}
+			expected := "RetroArch v" + version + " (" + e.Addr().String() + ")"
+			if devices[0].DisplayName != expected {
+				t.Fatalf("expected %q; got %q", expected, devices[0].DisplayName)
+			}
+			if devices[0].Uri.Host != e.Addr().String() {
+				t.Fatalf("unexpected uri %v", devices[0].Uri)
+			}
+		})
+	}
+}
+
+func TestRAClient_memory(t *testing.T) {
+	for _, version := range testVersions {
+		t.Run(version, func(t *testing.T) {
+			ctx := context.Background()
+			e := startEmulator(t, version)
+			d := openTestDevice(t, e)
+
+			writes := []snes.MemoryWriteRequest{
+				{RequestAddress: snestest.PakAddress(0xF50010), Data: []byte{0x01, 0x02, 0x03}},
+				{RequestAddress: snestest.PakAddress(0xE00000), Data: []byte{0x04}},
+				// spans more than one command:
+				{RequestAddress: snestest.PakAddress(0xF51000), Data: bytes.Repeat([]byte{0xAA}, maxReadSize+100)},
+			}
+			if _, err := d.MultiWriteMemory(ctx, writes...); err != nil {
+				t.Fatal(err)
+			}
+			for _, write := range writes {
+				actual, err := snestest.ReadPak(ctx, d, write.RequestAddress.Address, len(write.Data))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(actual, write.Data) {
+					t.Fatalf("$%06x: expected %x; got %x", write.RequestAddress.Address, write.Data, actual)
+				}
+			}
+			if actual, _ := e.ReadMemory(0x7E0010, 3); !bytes.Equal(actual, []byte{0x01, 0x02, 0x03}) {
+				t.Fatalf("expected WRAM to hold 010203; got %x", actual)
+			}
+
+			// read ROM across commands within a bank:
+			rom := testROM()
+			actual, err := snestest.ReadPak(ctx, d, 0x0000, 0x1000+5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(actual, rom[0x0000:0x1005]) {
+				t.Fatalf("ROM read mismatch")
+			}
+
+			// the command set depends on the version:
+			commands := e.Commands()
+			readCommand, writeCommand := "READ_CORE_MEMORY", "WRITE_CORE_MEMORY"
+			if version == "1.9.0" {
+				readCommand, writeCommand = "READ_CORE_RAM", "WRITE_CORE_RAM"
+			}
+			if countCommand(commands, readCommand) == 0 || countCommand(commands, writeCommand) == 0 {
+				t.Fatalf("expected %s and %s; got %v", readCommand, writeCommand, commands)
+			}
+		})
+	}
+}
+
+func TestRAClient_errorReplies(t *testing.T) {
+	for _, version := range testVersions {
+		t.Run(version, func(t *testing.T) {
+			ctx := context.Background()
+			e := startEmulator(t, version)
+			d := openTestDevice(t, e)
+
+			if _, err := d.MultiWriteMemory(ctx, snes.MemoryWriteRequest{RequestAddress: snestest.PakAddress(0xF50000), Data: []byte{0x55}}); err != nil {
+				t.Fatal(err)
+			}
+
+			// `-1` replies fill the response with zeros:
+			e.SetFaults(func(command string) raemu.Fault {
+				if command == "READ_CORE_MEMORY" || command == "READ_CORE_RAM" {
+					return raemu.FaultError
+				}
+				return raemu.FaultNone
+			})
+			actual, err := snestest.ReadPak(ctx, d, 0xF50000, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(actual, []byte{0x00}) {
+				t.Fatalf("expected 00; got %x", actual)
+			}
+			e.SetFaults(nil)
+
+			// unmapped addresses are errors too:
+			rsps, err := d.MultiReadMemory(ctx, snes.MemoryReadRequest{
+				RequestAddress: snes.AddressTuple{Address: 0x002100, AddressSpace: sni.AddressSpace_SnesABus, MemoryMapping: sni.MemoryMapping_LoROM},
+				Size:           2,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(rsps[0].Data, []byte{0x00, 0x00}) {
+				t.Fatalf("expected 0000; got %x", rsps[0].Data)
+			}
+
+			// the device stays usable:
+			actual, err = snestest.ReadPak(ctx, d, 0xF50000, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(actual, []byte{0x55}) {
+				t.Fatalf("expected 55; got %x", actual)
+			}
+		})
+	}
+
+	t.Run("readonly", func(t *testing.T) {
+		ctx := context.Background()
+		e := startEmulator(t, "1.10.3")
+		d := openTestDevice(t, e)
+
+		_, err := d.MultiWriteMemory(ctx, snes.MemoryWriteRequest{RequestAddress: snestest.PakAddress(0x000000), Data: []byte{0x55}})
+		if err == nil {
+			t.Fatal("expected error writing to ROM")
+		}
+		if snes.IsFatal(err) {
+			t.Fatalf("expected non-fatal error; got %v", err)
+		}
+	})
+}
+
+func TestRAClient_FetchFields(t *testing.T) {
+	ctx := context.Background()
+	e := startEmulator(t, "1.10.3")
+	e.SetCoreName("bsnes-mercury")
+	d := openTestDevice(t, e)
+
+	fields := []snes.Field{snes.Field_DeviceVersion, snes.Field_DeviceStatus, snes.Field_CoreName, snes.Field_RomFileName, snes.Field_RomCRC32}
+	values, err := d.FetchFields(ctx, fields...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual := make([]string, len(values))
+	for i := range values {
+		actual[i] = values[i].Value
+	}
+	expected := []string{"1.10.3", "PLAYING", "bsnes-mercury", "test"}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("expected %v; got %v", expected, actual)
+		}
+	}
+	if actual[4] == "" || actual[4] == "0" {
+		t.Fatalf("expected a CRC32; got %v", actual)
+	}
+
+	if err = d.PauseToggle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	values, err = d.FetchFields(ctx, snes.Field_DeviceStatus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values[0].Value != "PAUSED" || !e.Paused() {
+		t.Fatalf("expected PAUSED; got %v", values[0].Value)
+	}
+
+	// without content there are no args after the status:
+	e.Unload()
+	values, err = d.FetchFields(ctx, snes.Field_DeviceStatus, snes.Field_RomFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values[0].Value != "CONTENTLESS" || values[1].Value != "" {
+		t.Fatalf("expected CONTENTLESS; got %v", values)
+	}
+	actualData, err := snestest.ReadPak(ctx, d, 0xF50000, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(actualData, []byte{0x00, 0x00}) {
+		t.Fatalf("expected 0000; got %x", actualData)
+	}
+}
+
+func TestRAClient_faults(t *testing.T) {
+	tests := []struct {
+		name  string
+		fault raemu.Fault
+	}{
+		{"drop", raemu.FaultDrop},
+		{"reorder", raemu.FaultReorder},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := startEmulator(t, "1.10.3")
+			d := openTestDevice(t, e)
+
+			injected := false
+			e.SetFaults(func(command string) raemu.Fault {
+				if command == "READ_CORE_MEMORY" && !injected {
+					injected = true
+					return tt.fault
+				}
+				return raemu.FaultNone
+			})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+			defer cancel()
+			_, err := d.MultiReadMemory(
+				ctx,
+				snes.MemoryReadRequest{RequestAddress: snestest.PakAddress(0xF50000), Size: 16},
+				snes.MemoryReadRequest{RequestAddress: snestest.PakAddress(0xF50100), Size: 16},
+			)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !snes.IsFatal(err) {
+				t.Fatalf("expected fatal error; got %v", err)
+			}
+
+			// the device is reopened on next use:
+			if _, err = snestest.ReadPak(context.Background(), d, 0xF50000, 16); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+
+	t.Run("GET_STATUS", func(t *testing.T) {
+		e := startEmulator(t, "1.9.0")
+		d := openTestDevice(t, e)
+
+		injected := false
+		e.SetFaults(func(command string) raemu.Fault {
+			if command == "GET_STATUS" && !injected {
+				injected = true
+				return raemu.FaultDrop
+			}
+			return raemu.FaultNone
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+		defer cancel()
+		if _, err := d.FetchFields(ctx, snes.Field_DeviceStatus); !snes.IsFatal(err) {
+			t.Fatalf("expected fatal error; got %v", err)
+		}
+		values, err := d.FetchFields(context.Background(), snes.Field_CoreName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if values[0].Value != "super_nes" {
+			t.Fatalf("expected super_nes; got %q", values[0].Value)
+		}
+	})
+}
@@ -4,7 +4,6 @@ import (
 	"bufio"
 	"bytes"
 	"context"
-	"errors"
 	"fmt"
 	"log"
 	"net"
@@ -60,6 +59,7 @@ type RAClient struct {
 	expectationLock  sync.Mutex
 	outgoing         chan *rwRequest
 	expectedIncoming chan *rwRequest
+	closeOnce        sync.Once
 
 	version string
 	useRCR  bool
@@ -73,14 +73,6 @@ func (c *RAClient) NonFatalError(cause error) snes.DeviceError {
 	return snes.DeviceNonFatal(fmt.Sprintf("retroarch: %v", cause), cause)
 }
 
-// isCloseWorthy returns true if the error should close the connection
-func isCloseWorthy(err error) bool {
-	if errors.Is(err, net.ErrClosed) {
-		return false
-	}
-	return snes.IsFatal(err)
-}
-
 func NewRAClient(addr *net.UDPAddr, name string, timeout time.Duration) *RAClient {
 	c := &RAClient{
 		addr:             addr,
@@ -100,8 +92,11 @@ func (c *RAClient) IsClosed() bool { return c.UDPClient.IsClosed() }
 
 func (c *RAClient) Close() (err error) {
 	err = c.UDPClient.Close()
-	close(c.outgoing)
-	close(c.expectedIncoming)
+	// the device container and the request handlers may both close the client:
+	c.closeOnce.Do(func() {
+		close(c.outgoing)
+		close(c.expectedIncoming)
+	})
 	return
 }
 
@@ -196,18 +191,25 @@ func (d *RAClient) GetStatus(ctx context.Context) (raStatus, coreName, romFileNa
 	}
 	rsp, err = d.WriteThenRead(req, deadline)
 	if err != nil {
+		// the connection is closed after a failed read so the device must be reopened:
+		err = d.FatalError(err)
 		return
 	}
 	if config.VerboseLogging {
 		log.Printf("retroarch: < %s", rsp)
 	}
 
-	// parse the response:
-	var args string
-	_, err = fmt.Fscanf(bytes.NewReader(rsp), "GET_STATUS %s %s", &raStatus, &args)
-	if err != nil {
+	// parse the response; CONTENTLESS has no args and ROM names may contain spaces:
+	parts := strings.SplitN(strings.TrimSpace(string(rsp)), " ", 3)
+	if len(parts) < 2 || parts[0] != "GET_STATUS" {
+		err = d.NonFatalError(fmt.Errorf("unexpected GET_STATUS response: `%s`", string(rsp)))
+		return
+	}
+	raStatus = parts[1]
+	if len(parts) < 3 {
 		return
 	}
+	args := parts[2]
 
 	// split the second arg by commas:
 	argsArr := strings.Split(args, ",")
@@ -234,7 +236,7 @@ func (d *RAClient) GetStatus(ctx context.Context) (raStatus, coreName, romFileNa
 	return
 }
 
-func (d *RAClient) FetchFields(ctx context.Context, fields ...snes.Field) (values []string, err error) {
+func (d *RAClient) FetchFields(ctx context.Context, fields ...snes.Field) (values []snes.FieldValue, err error) {
 	var raStatus string
 	var coreName string
 	var romFileName string
@@ -248,26 +250,26 @@ func (d *RAClient) FetchFields(ctx context.Context, fields ...snes.Field) (value
 	for _, field := range fields {
 		switch field {
 		case snes.Field_DeviceName:
-			values = append(values, "retroarch")
+			values = append(values, snes.FieldValue{Value: "retroarch"})
 			break
 		case snes.Field_DeviceVersion:
-			values = append(values, d.version)
+			values = append(values, snes.FieldValue{Value: d.version})
 			break
 		case snes.Field_DeviceStatus:
-			values = append(values, raStatus)
+			values = append(values, snes.FieldValue{Value: raStatus})
 			break
 		case snes.Field_CoreName:
-			values = append(values, coreName)
+			values = append(values, snes.FieldValue{Value: coreName})
 			break
 		case snes.Field_RomFileName:
-			values = append(values, romFileName)
+			values = append(values, snes.FieldValue{Value: romFileName})
 			break
 		case snes.Field_RomCRC32:
-			values = append(values, strconv.FormatUint(uint64(romCRC32), 16))
+			values = append(values, snes.FieldValue{Value: strconv.FormatUint(uint64(romCRC32), 16)})
 			break
 		default:
-			// unknown value; append empty string to maintain index association:
-			values = append(values, "")
+			// unsupported value; append marker to maintain index association:
+			values = append(values, snes.FieldUnsupported)
 			break
 		}
 	}
@@ -350,8 +352,9 @@ func (c *RAClient) MultiReadMemory(ctx context.Context, reads ...snes.MemoryRead
 						AddressSpace:  rsp.DeviceAddress.AddressSpace,
 						MemoryMapping: rsp.DeviceAddress.MemoryMapping,
 					},
-					RequestSize:  maxReadSize,
-					ResponseData: rsp.Data,
+					RequestSize: maxReadSize,
+					// each chunk reads into its own part of the response data:
+					ResponseData: rsp.Data[offs : offs : offs+maxReadSize],
 				},
 			})
 			offs += maxReadSize
@@ -376,15 +379,15 @@ func (c *RAClient) MultiReadMemory(ctx context.Context, reads ...snes.MemoryRead
 						MemoryMapping: rsp.DeviceAddress.MemoryMapping,
 					},
 					RequestSize:  size,
-					ResponseData: rsp.Data,
+					ResponseData: rsp.Data[offs : offs : int(offs)+size],
 				},
 			})
 		}
 	}
 
-	// make a channel to receive response errors:
+	// make a channel to receive response errors; it is not closed since the handlers may still report errors
+	// after an early return:
 	responses := make(chan error, len(outgoing))
-	defer close(responses)
 
 	// fire off all commands:
 	for _, rwreq := range outgoing {
@@ -412,7 +415,12 @@ func (c *RAClient) MultiReadMemory(ctx context.Context, reads ...snes.MemoryRead
 			}
 		}
 
-		mrsp[rwreq.index].Data = rwreq.Read.ResponseData
+		// extend the response data to cover this chunk:
+		rsp := &mrsp[rwreq.index]
+		end := int(rwreq.Read.DeviceAddress.Address-rsp.DeviceAddress.Address) + len(rwreq.Read.ResponseData)
+		if end > len(rsp.Data) {
+			rsp.Data = rsp.Data[:end]
+		}
 	}
 
 	return
@@ -506,9 +514,9 @@ func (c *RAClient) MultiWriteMemory(ctx context.Context, writes ...snes.MemoryWr
 		}
 	}
 
-	// make a channel to receive response errors:
+	// make a channel to receive response errors; it is not closed since the handlers may still report errors
+	// after an early return:
 	responses := make(chan error, len(outgoing))
-	defer close(responses)
 
 	// fire off all commands:
 	for _, rwreq := range outgoing {
@@ -567,11 +575,9 @@ func (c *RAClient) handleOutgoing() {
 			err := c.WriteWithDeadline([]byte(reqStr), rwreq.deadline)
 			if err != nil {
 				c.expectationLock.Unlock()
-				rwreq.R <- err
-				if isCloseWorthy(err) {
-					_ = c.Close()
-				}
-				return
+				// the connection is closed after a failed write so the device must be reopened:
+				rwreq.R <- c.FatalError(err)
+				continue
 			}
 
 			if c.useRCR && rwreq.isWrite {
@@ -590,11 +596,10 @@ func (c *RAClient) handleIncoming() {
 	for rwreq := range c.expectedIncoming {
 		rsp, err := c.ReadWithDeadline(rwreq.deadline)
 		if err != nil {
-			rwreq.R <- err
-			if isCloseWorthy(err) {
-				_ = c.Close()
-			}
-			break
+			// the connection is closed after a failed read so the device must be reopened; keep draining
+			// expectations so that no request waits forever:
+			rwreq.R <- c.FatalError(err)
+			continue
 		}
 
 		if config.VerboseLogging {
@@ -0,0 +1,423 @@
+// Package raemu emulates the UDP network command interface of RetroArch running a SNES core so that the retroarch
+// driver can be tested without RetroArch. Replies mimic RetroArch 1.9.0 and later versions and faults can be
+// injected to drop, reorder or fail replies.
+package raemu
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"hash/crc32"
+	"net"
+	"path"
+	"sni/protos/sni"
+	"sni/snes"
+	"sni/snes/mapping"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	romEnd    = 0xE00000
+	sramStart = 0xE00000
+	sramEnd   = 0xF00000
+	wramStart = 0xF50000
+	wramEnd   = 0xF70000
+)
+
+// Fault is injected into the reply to a command
+type Fault int
+
+const (
+	// FaultNone replies normally
+	FaultNone Fault = iota
+	// FaultDrop sends no reply
+	FaultDrop
+	// FaultError replies with `-1` as if the command failed; only memory commands are affected
+	FaultError
+	// FaultReorder holds back the reply until the reply to the next command has been sent
+	FaultReorder
+)
+
+// Emulator answers RetroArch network commands on a UDP socket. Memory is laid out in the FX Pak Pro address space:
+// ROM at $00_0000, SRAM at $E0_0000 and WRAM at $F5_0000, and commands address it via the SNES A-bus according to
+// the loaded ROM's memory mapping.
+type Emulator struct {
+	lock sync.Mutex
+	conn *net.UDPConn
+
+	version             string
+	major, minor, patch int
+
+	Memory   [0x1000000]byte
+	romName  string
+	romCRC   uint32
+	mapping  sni.MemoryMapping
+	loaded   bool
+	paused   bool
+	coreName string
+
+	faults   func(command string) Fault
+	held     []byte
+	heldAddr *net.UDPAddr
+	commands []string
+
+	done chan struct{}
+}
+
+// Listen creates an emulator of the given RetroArch version, e.g. "1.9.0", listening on the UDP address, e.g.
+// "127.0.0.1:0" to pick any free port. No content is loaded.
+func Listen(address string, version string) (e *Emulator, err error) {
+	e = &Emulator{
+		version:  version,
+		coreName: "bsnes-mercury",
+		mapping:  sni.MemoryMapping_Unknown,
+		done:     make(chan struct{}),
+	}
+	if _, err = fmt.Sscanf(version, "%d.%d.%d", &e.major, &e.minor, &e.patch); err != nil {
+		return nil, fmt.Errorf("raemu: invalid version '%s': %w", version, err)
+	}
+
+	var addr *net.UDPAddr
+	addr, err = net.ResolveUDPAddr("udp", address)
+	if err != nil {
+		return nil, err
+	}
+	e.conn, err = net.ListenUDP("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	go e.serve()
+	return
+}
+
+// Addr returns the address the emulator is listening on.
+func (e *Emulator) Addr() *net.UDPAddr {
+	return e.conn.LocalAddr().(*net.UDPAddr)
+}
+
+// Close stops the emulator.
+func (e *Emulator) Close() error {
+	err := e.conn.Close()
+	<-e.done
+	return err
+}
+
+// hasReadCoreMemory reports whether the version supports READ_CORE_MEMORY and WRITE_CORE_MEMORY which were added
+// after 1.9.0
+func (e *Emulator) hasReadCoreMemory() bool {
+	if e.major != 1 {
+		return e.major > 1
+	}
+	if e.minor != 9 {
+		return e.minor > 9
+	}
+	return e.patch >= 1
+}
+
+// SetCoreName sets the core name reported by GET_STATUS for versions after 1.9.0.
+func (e *Emulator) SetCoreName(name string) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.coreName = name
+}
+
+// LoadROM loads content into the emulator with the given memory mapping. WRAM and SRAM are cleared.
+func (e *Emulator) LoadROM(name string, contents []byte, memoryMapping sni.MemoryMapping) error {
+	if len(contents) > romEnd {
+		return fmt.Errorf("raemu: ROM too large: $%x bytes", len(contents))
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.clear(0, len(e.Memory))
+	copy(e.Memory[:romEnd], contents)
+	e.romName = strings.TrimSuffix(path.Base(name), path.Ext(name))
+	e.romCRC = crc32.ChecksumIEEE(contents)
+	e.mapping = memoryMapping
+	e.loaded = true
+	e.paused = false
+	return nil
+}
+
+// Unload closes the content so the emulator is CONTENTLESS.
+func (e *Emulator) Unload() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.clear(0, len(e.Memory))
+	e.romName, e.romCRC = "", 0
+	e.mapping = sni.MemoryMapping_Unknown
+	e.loaded = false
+	e.paused = false
+}
+
+// clear zeroes memory in [start, end); e.lock must be held.
+func (e *Emulator) clear(start, end int) {
+	m := e.Memory[start:end]
+	for i := range m {
+		m[i] = 0
+	}
+}
+
+// Paused reports whether emulation is paused.
+func (e *Emulator) Paused() bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.paused
+}
+
+// ReadMemory returns a copy of memory at the SNES A-bus address.
+func (e *Emulator) ReadMemory(busAddress uint32, size int) (data []byte, err error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	data = make([]byte, size)
+	for i := range data {
+		var pakAddress uint32
+		pakAddress, err = e.translate(busAddress + uint32(i))
+		if err != nil {
+			return nil, err
+		}
+		data[i] = e.Memory[pakAddress]
+	}
+	return
+}
+
+// SetFaults sets the function deciding which fault to inject into the reply to each command; nil disables faults.
+func (e *Emulator) SetFaults(faults func(command string) Fault) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.faults = faults
+}
+
+// Commands returns the names of all commands received so far in order.
+func (e *Emulator) Commands() []string {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return append([]string(nil), e.commands...)
+}
+
+func (e *Emulator) serve() {
+	defer close(e.done)
+
+	b := make([]byte, 65536)
+	for {
+		n, addr, err := e.conn.ReadFromUDP(b)
+		if err != nil {
+			return
+		}
+
+		s := bufio.NewScanner(bytes.NewReader(b[:n]))
+		s.Buffer(make([]byte, 65536), 65536)
+		for s.Scan() {
+			line := strings.TrimSpace(s.Text())
+			if line == "" {
+				continue
+			}
+			e.handle(line, addr)
+		}
+	}
+}
+
+// handle executes a single command and sends its reply, if any, with any fault injected.
+func (e *Emulator) handle(line string, addr *net.UDPAddr) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	args := strings.Fields(line)
+	command := args[0]
+	e.commands = append(e.commands, command)
+
+	fault := FaultNone
+	if e.faults != nil {
+		fault = e.faults(command)
+	}
+
+	reply := e.execute(command, args[1:], fault == FaultError)
+	if reply == nil {
+		return
+	}
+
+	switch fault {
+	case FaultDrop:
+		return
+	case FaultReorder:
+		if e.held == nil {
+			e.held, e.heldAddr = reply, addr
+			return
+		}
+	}
+
+	_, _ = e.conn.WriteToUDP(reply, addr)
+	if e.held != nil {
+		_, _ = e.conn.WriteToUDP(e.held, e.heldAddr)
+		e.held, e.heldAddr = nil, nil
+	}
+}
+
+// execute runs the command and returns its reply or nil if RetroArch would not reply; e.lock must be held.
+func (e *Emulator) execute(command string, args []string, fail bool) []byte {
+	switch command {
+	case "VERSION":
+		return []byte(e.version + "\n")
+	case "GET_STATUS":
+		return []byte(e.status())
+	case "PAUSE_TOGGLE":
+		if e.loaded {
+			e.paused = !e.paused
+		}
+		return nil
+	case "RESET":
+		if e.loaded {
+			e.clear(wramStart, wramEnd)
+			e.paused = false
+		}
+		return nil
+	case "READ_CORE_RAM":
+		return e.read(command, args, fail)
+	case "WRITE_CORE_RAM":
+		// WRITE_CORE_RAM never replies:
+		e.write(command, args, fail)
+		return nil
+	case "READ_CORE_MEMORY":
+		if !e.hasReadCoreMemory() {
+			return nil
+		}
+		return e.read(command, args, fail)
+	case "WRITE_CORE_MEMORY":
+		if !e.hasReadCoreMemory() {
+			return nil
+		}
+		return e.write(command, args, fail)
+	default:
+		// RetroArch ignores unknown commands:
+		return nil
+	}
+}
+
+// status formats the GET_STATUS reply; e.lock must be held.
+func (e *Emulator) status() string {
+	if !e.loaded {
+		return "GET_STATUS CONTENTLESS\n"
+	}
+
+	state := "PLAYING"
+	if e.paused {
+		state = "PAUSED"
+	}
+	// 1.9.0 reports the system id instead of the core name:
+	coreName := "super_nes"
+	if e.hasReadCoreMemory() {
+		coreName = e.coreName
+	}
+	return fmt.Sprintf("GET_STATUS %s %s,%s,crc32=%08x\n", state, coreName, e.romName, e.romCRC)
+}
+
+// translate maps the SNES A-bus address to memory; e.lock must be held.
+func (e *Emulator) translate(busAddress uint32) (pakAddress uint32, err error) {
+	pakAddress, err = mapping.TranslateAddress(
+		snes.AddressTuple{Address: busAddress, AddressSpace: sni.AddressSpace_SnesABus, MemoryMapping: e.mapping},
+		sni.AddressSpace_FxPakPro,
+	)
+	if err != nil {
+		return
+	}
+	if pakAddress >= uint32(len(e.Memory)) {
+		err = fmt.Errorf("raemu: address $%06x out of range", pakAddress)
+	}
+	return
+}
+
+// memoryError describes why memory cannot be accessed in the words of READ_CORE_MEMORY; e.lock must be held.
+func (e *Emulator) memoryError(busAddress uint32, size int, writing bool) string {
+	if !e.loaded {
+		return "no memory map defined"
+	}
+	for i := 0; i < size; i++ {
+		pakAddress, err := e.translate(busAddress + uint32(i))
+		if err != nil {
+			return "no descriptor for address"
+		}
+		if writing && pakAddress < romEnd {
+			return "descriptor data is readonly"
+		}
+		if pakAddress >= wramEnd || (pakAddress >= sramEnd && pakAddress < wramStart) {
+			return "no data for descriptor"
+		}
+	}
+	return ""
+}
+
+// errorReply formats a failure reply; READ_CORE_RAM does not describe the error.
+func errorReply(command string, busAddress uint32, text string) []byte {
+	if strings.HasSuffix(command, "_CORE_RAM") {
+		return []byte(fmt.Sprintf("%s %x -1\n", command, busAddress))
+	}
+	return []byte(fmt.Sprintf("%s %x -1 %s\n", command, busAddress, text))
+}
+
+func (e *Emulator) read(command string, args []string, fail bool) []byte {
+	if len(args) < 2 {
+		return nil
+	}
+	busAddress, err := strconv.ParseUint(args[0], 16, 32)
+	if err != nil {
+		return nil
+	}
+	size, err := strconv.Atoi(args[1])
+	if err != nil || size < 0 {
+		return nil
+	}
+
+	if fail {
+		return errorReply(command, uint32(busAddress), "no descriptor for address")
+	}
+	if text := e.memoryError(uint32(busAddress), size, false); text != "" {
+		return errorReply(command, uint32(busAddress), text)
+	}
+
+	var sb strings.Builder
+	_, _ = fmt.Fprintf(&sb, "%s %x", command, busAddress)
+	for i := 0; i < size; i++ {
+		pakAddress, _ := e.translate(uint32(busAddress) + uint32(i))
+		_, _ = fmt.Fprintf(&sb, " %02X", e.Memory[pakAddress])
+	}
+	sb.WriteByte('\n')
+	return []byte(sb.String())
+}
+
+func (e *Emulator) write(command string, args []string, fail bool) []byte {
+	if len(args) < 1 {
+		return nil
+	}
+	busAddress, err := strconv.ParseUint(args[0], 16, 32)
+	if err != nil {
+		return nil
+	}
+	data := make([]byte, 0, len(args)-1)
+	for _, arg := range args[1:] {
+		var v uint64
+		v, err = strconv.ParseUint(arg, 16, 8)
+		if err != nil {
+			return nil
+		}
+		data = append(data, byte(v))
+	}
+
+	if fail {
+		return errorReply(command, uint32(busAddress), "no descriptor for address")
+	}
+	if text := e.memoryError(uint32(busAddress), len(data), true); text != "" {
+		return errorReply(command, uint32(busAddress), text)
+	}
+
+	for i, b := range data {
+		pakAddress, _ := e.translate(uint32(busAddress) + uint32(i))
+		e.Memory[pakAddress] = b
+	}
+	return []byte(fmt.Sprintf("%s %x %d\n", command, busAddress, len(data)))
+}
@@ -0,0 +1,10 @@
+package snes
+
+import "context"
+
+// DeviceExecuteASM is implemented by devices that can execute custom 65816 machine code on the SNES
+type DeviceExecuteASM interface {
+	// ExecuteASM runs the 65816 routine in code once with the data payload placed immediately after it in memory.
+	// The routine is called with JSL and must return with RTL.
+	ExecuteASM(ctx context.Context, code []byte, data []byte) error
+}
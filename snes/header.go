@@ -58,6 +58,48 @@ var RegionNames = map[Region]string{
 	0x14: "Other (3)",
 }
 
+// Coprocessor is the enhancement chip on the cartridge decoded from the header's cartridge type byte
+type Coprocessor uint8
+
+const (
+	CoprocessorNone Coprocessor = iota
+	CoprocessorDSP
+	CoprocessorSuperFX
+	CoprocessorOBC1
+	CoprocessorSA1
+	CoprocessorSDD1
+	CoprocessorSRTC
+	CoprocessorOther
+	CoprocessorSPC7110
+	CoprocessorST010
+	CoprocessorST018
+	CoprocessorCX4
+	CoprocessorUnknown
+)
+
+var CoprocessorNames = map[Coprocessor]string{
+	CoprocessorNone:    "None",
+	CoprocessorDSP:     "DSP",
+	CoprocessorSuperFX: "SuperFX",
+	CoprocessorOBC1:    "OBC1",
+	CoprocessorSA1:     "SA-1",
+	CoprocessorSDD1:    "S-DD1",
+	CoprocessorSRTC:    "S-RTC",
+	CoprocessorOther:   "Other",
+	CoprocessorSPC7110: "SPC7110",
+	CoprocessorST010:   "ST010/ST011",
+	CoprocessorST018:   "ST018",
+	CoprocessorCX4:     "CX4",
+	CoprocessorUnknown: "Unknown",
+}
+
+func (c Coprocessor) String() string {
+	if name, ok := CoprocessorNames[c]; ok {
+		return name
+	}
+	return CoprocessorNames[CoprocessorUnknown]
+}
+
 // Header starts at $FFB0 to accommodate version 2 and 3 headers
 type Header struct {
 	version int // 1, 2, or 3
@@ -185,7 +227,7 @@ func (h *Header) Score(addr uint32) (score int) {
 	}
 
 	// Valid ranges:
-	if h.CartridgeType < 0x08 {
+	if h.cartridgeTypeValid() {
 		score++
 	}
 	if h.ROMSize < 0x10 {
@@ -208,7 +250,15 @@ func (h *Header) Score(addr uint32) (score int) {
 		score += 2
 	}
 	// 0x22 is usually ExLoROM
-	if addr == 0x007fb0 && mapper == 0x22 {
+	if (addr == 0x007fb0 || addr == 0x407fb0) && mapper == 0x22 {
+		score += 2
+	}
+	// 0x23 is SA-1
+	if addr == 0x007fb0 && mapper == 0x23 {
+		score += 2
+	}
+	// 0x2A is SPC7110
+	if addr == 0x00ffb0 && mapper == 0x2a && h.Coprocessor() == CoprocessorSPC7110 {
 		score += 2
 	}
 	// 0x25 is usually ExHiROM
@@ -221,6 +271,72 @@ func (h *Header) Score(addr uint32) (score int) {
 	return
 }
 
+// Coprocessor decodes the high nibble of the cartridge type byte at $FFD6. Custom chips ($Fx) are identified by the
+// chipset subtype byte at $FFBF which only version 2 and 3 headers have.
+func (h *Header) Coprocessor() Coprocessor {
+	if h.CartridgeType&0x0F < 0x03 {
+		return CoprocessorNone
+	}
+
+	switch h.CartridgeType >> 4 {
+	case 0x0:
+		return CoprocessorDSP
+	case 0x1:
+		return CoprocessorSuperFX
+	case 0x2:
+		return CoprocessorOBC1
+	case 0x3:
+		return CoprocessorSA1
+	case 0x4:
+		return CoprocessorSDD1
+	case 0x5:
+		return CoprocessorSRTC
+	case 0xE:
+		return CoprocessorOther
+	case 0xF:
+		switch h.CoCPUType {
+		case 0x00:
+			return CoprocessorSPC7110
+		case 0x01:
+			return CoprocessorST010
+		case 0x02:
+			return CoprocessorST018
+		case 0x10:
+			return CoprocessorCX4
+		}
+	}
+	return CoprocessorUnknown
+}
+
+func (h *Header) cartridgeTypeValid() bool {
+	switch kind := h.CartridgeType & 0x0F; {
+	case kind < 0x03:
+		// no coprocessor:
+		return h.CartridgeType>>4 == 0
+	case kind < 0x07, kind == 0x09:
+		return h.Coprocessor() != CoprocessorUnknown
+	}
+	return false
+}
+
+// HasRAM returns true if the cartridge type byte declares cartridge RAM.
+func (h *Header) HasRAM() bool {
+	switch h.CartridgeType & 0x0F {
+	case 0x01, 0x02, 0x04, 0x05, 0x09:
+		return true
+	}
+	return false
+}
+
+// HasBattery returns true if the cartridge type byte declares a battery. Type $x9 also has an RTC.
+func (h *Header) HasBattery() bool {
+	switch h.CartridgeType & 0x0F {
+	case 0x02, 0x05, 0x06, 0x09:
+		return true
+	}
+	return false
+}
+
 func (h *Header) ROMSizeBytes() uint32 {
 	return 1024 << h.ROMSize
 }
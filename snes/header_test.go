@@ -0,0 +1,47 @@
+package snes
+
+import "testing"
+
+func TestHeader_Coprocessor(t *testing.T) {
+	tests := []struct {
+		cartridgeType byte
+		coCPUType     byte
+		coprocessor   Coprocessor
+		ram           bool
+		battery       bool
+		valid         bool
+	}{
+		{0x00, 0x00, CoprocessorNone, false, false, true},
+		{0x02, 0x00, CoprocessorNone, true, true, true},
+		{0x03, 0x00, CoprocessorDSP, false, false, true},
+		{0x05, 0x00, CoprocessorDSP, true, true, true},
+		{0x13, 0x00, CoprocessorSuperFX, false, false, true},
+		{0x15, 0x00, CoprocessorSuperFX, true, true, true},
+		{0x1A, 0x00, CoprocessorSuperFX, false, false, false},
+		{0x25, 0x00, CoprocessorOBC1, true, true, true},
+		{0x35, 0x00, CoprocessorSA1, true, true, true},
+		{0x43, 0x00, CoprocessorSDD1, false, false, true},
+		{0x55, 0x00, CoprocessorSRTC, true, true, true},
+		{0xE3, 0x00, CoprocessorOther, false, false, true},
+		{0xF9, 0x00, CoprocessorSPC7110, true, true, true},
+		{0xF5, 0x00, CoprocessorSPC7110, true, true, true},
+		{0xF6, 0x01, CoprocessorST010, false, true, true},
+		{0xF5, 0x02, CoprocessorST018, true, true, true},
+		{0xF3, 0x10, CoprocessorCX4, false, false, true},
+		{0xF3, 0x20, CoprocessorUnknown, false, false, false},
+		{0x73, 0x00, CoprocessorUnknown, false, false, false},
+		{0x10, 0x00, CoprocessorNone, false, false, false},
+	}
+	for _, tt := range tests {
+		h := Header{CartridgeType: tt.cartridgeType, CoCPUType: tt.coCPUType}
+		if got := h.Coprocessor(); got != tt.coprocessor {
+			t.Errorf("$%02x/$%02x: expected coprocessor %v; got %v", tt.cartridgeType, tt.coCPUType, tt.coprocessor, got)
+		}
+		if h.HasRAM() != tt.ram || h.HasBattery() != tt.battery {
+			t.Errorf("$%02x: expected RAM %v battery %v", tt.cartridgeType, tt.ram, tt.battery)
+		}
+		if h.cartridgeTypeValid() != tt.valid {
+			t.Errorf("$%02x/$%02x: expected valid %v", tt.cartridgeType, tt.coCPUType, tt.valid)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package snes
 
 import "context"
 
+// Field values match those of sni.Field
 type Field int
 
 const (
@@ -13,6 +14,19 @@ const (
 	Field_RomCRC32
 )
 
+// FieldValue is the value of a Field fetched from a device. Unsupported is set when the device cannot supply the
+// value for the Field, in which case Value is empty.
+type FieldValue struct {
+	Value       string
+	Unsupported bool
+}
+
+// FieldUnsupported marks a Field that the device cannot supply a value for.
+var FieldUnsupported = FieldValue{Unsupported: true}
+
+func (v FieldValue) String() string { return v.Value }
+
 type DeviceInfo interface {
-	FetchFields(ctx context.Context, fields ...Field) (values []string, err error)
+	// FetchFields returns one value per requested field in the same order as requested
+	FetchFields(ctx context.Context, fields ...Field) (values []FieldValue, err error)
 }
@@ -0,0 +1,156 @@
+package snes
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sync"
+	"time"
+)
+
+// Lease grants its holder exclusive write access to a device until it expires or is released.
+type Lease struct {
+	ID       string
+	Duration time.Duration
+	Expires  time.Time
+}
+
+const (
+	DefaultLeaseDuration = time.Second
+	MaxLeaseDuration     = time.Minute
+)
+
+type leaseIDKeyType int
+
+var leaseIDKey leaseIDKeyType
+
+var (
+	leasesMu sync.Mutex
+	leases   = make(map[string]Lease)
+)
+
+// WithLeaseID returns a context that identifies the caller as the holder of the lease with the given ID.
+func WithLeaseID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, leaseIDKey, id)
+}
+
+// LeaseIDFromContext returns the lease ID set by WithLeaseID, if any.
+func LeaseIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(leaseIDKey).(string)
+	return id
+}
+
+func clampLeaseDuration(duration time.Duration) time.Duration {
+	if duration <= 0 {
+		return DefaultLeaseDuration
+	}
+	if duration > MaxLeaseDuration {
+		return MaxLeaseDuration
+	}
+	return duration
+}
+
+// activeLease returns the unexpired lease held on the device; leasesMu must be held.
+func activeLease(key string) (lease Lease, ok bool) {
+	lease, ok = leases[key]
+	if !ok {
+		return
+	}
+	if time.Now().After(lease.Expires) {
+		delete(leases, key)
+		return Lease{}, false
+	}
+	return
+}
+
+// AcquireLease acquires an exclusive lease on the device for the given duration. Durations are clamped to
+// MaxLeaseDuration and default to DefaultLeaseDuration if not positive. Fails if another unexpired lease is held.
+func AcquireLease(device AutoCloseableDevice, duration time.Duration) (lease Lease, err error) {
+	key := uniqueDeviceKey(device)
+
+	var b [16]byte
+	if _, err = rand.Read(b[:]); err != nil {
+		return
+	}
+
+	leasesMu.Lock()
+	defer leasesMu.Unlock()
+
+	if held, ok := activeLease(key); ok {
+		err = WithCode(codes.FailedPrecondition, fmt.Errorf(
+			"device is leased by another client for %v",
+			time.Until(held.Expires).Round(time.Millisecond),
+		))
+		return
+	}
+
+	duration = clampLeaseDuration(duration)
+	lease = Lease{
+		ID:       hex.EncodeToString(b[:]),
+		Duration: duration,
+		Expires:  time.Now().Add(duration),
+	}
+	leases[key] = lease
+	return
+}
+
+// RenewLease extends the lease with the given ID to expire after duration from now.
+func RenewLease(device AutoCloseableDevice, id string, duration time.Duration) (lease Lease, err error) {
+	key := uniqueDeviceKey(device)
+
+	leasesMu.Lock()
+	defer leasesMu.Unlock()
+
+	held, ok := activeLease(key)
+	if !ok || held.ID != id {
+		err = WithCode(codes.NotFound, fmt.Errorf("lease not held"))
+		return
+	}
+
+	duration = clampLeaseDuration(duration)
+	lease = Lease{
+		ID:       id,
+		Duration: duration,
+		Expires:  time.Now().Add(duration),
+	}
+	leases[key] = lease
+	return
+}
+
+// ReleaseLease releases the lease with the given ID.
+func ReleaseLease(device AutoCloseableDevice, id string) (err error) {
+	key := uniqueDeviceKey(device)
+
+	leasesMu.Lock()
+	defer leasesMu.Unlock()
+
+	held, ok := activeLease(key)
+	if !ok || held.ID != id {
+		err = WithCode(codes.NotFound, fmt.Errorf("lease not held"))
+		return
+	}
+
+	delete(leases, key)
+	return
+}
+
+// CheckLease fails if the device is leased by anyone other than the lease holder identified in ctx.
+func CheckLease(ctx context.Context, device AutoCloseableDevice) (err error) {
+	key := uniqueDeviceKey(device)
+
+	leasesMu.Lock()
+	defer leasesMu.Unlock()
+
+	held, ok := activeLease(key)
+	if !ok || held.ID == LeaseIDFromContext(ctx) {
+		return
+	}
+
+	err = WithCode(codes.FailedPrecondition, fmt.Errorf(
+		"device is leased by another client for %v",
+		time.Until(held.Expires).Round(time.Millisecond),
+	))
+	return
+}
@@ -0,0 +1,67 @@
+package snes
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestLease(t *testing.T) {
+	uri := &url.URL{Scheme: "test", Opaque: "lease"}
+	device := NewAutoCloseableDevice(NewDeviceDriverContainer(nil), uri, "lease")
+
+	lease, err := AcquireLease(device, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ReleaseLease(device, lease.ID)
+
+	// a second acquire must fail:
+	_, err = AcquireLease(device, time.Second)
+	var coded *CodedError
+	if !errors.As(err, &coded) || coded.Code != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition but got: %v", err)
+	}
+
+	// other clients are rejected, the holder is not:
+	if err = CheckLease(context.Background(), device); err == nil {
+		t.Fatal("expected CheckLease to fail for other clients")
+	}
+	if err = CheckLease(WithLeaseID(context.Background(), lease.ID), device); err != nil {
+		t.Fatal(err)
+	}
+
+	// renew with a wrong ID must fail:
+	if _, err = RenewLease(device, "wrong", time.Second); err == nil {
+		t.Fatal("expected RenewLease to fail for wrong lease ID")
+	}
+	if _, err = RenewLease(device, lease.ID, MaxLeaseDuration*2); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = ReleaseLease(device, lease.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err = CheckLease(context.Background(), device); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLease_Expires(t *testing.T) {
+	uri := &url.URL{Scheme: "test", Opaque: "expires"}
+	device := NewAutoCloseableDevice(NewDeviceDriverContainer(nil), uri, "expires")
+
+	_, err := AcquireLease(device, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(time.Millisecond * 5)
+
+	if err = CheckLease(context.Background(), device); err != nil {
+		t.Fatalf("expected expired lease to be ignored but got: %v", err)
+	}
+}
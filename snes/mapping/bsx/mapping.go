@@ -0,0 +1,81 @@
+package bsx
+
+import "sni/snes/mapping/util"
+
+// https://problemkaputt.de/fullsnes.htm#snescartsatellaviewbsx
+// The BS-X cartridge's memory controller can remap its memories at run-time; this translation assumes the usual
+// configuration for running a memory pack program. The FX Pak Pro keeps the 1MiB memory pack at $00:0000, the
+// 512KiB of PSRAM at $40:0000 and the 32KiB of battery-backed SRAM in its SRAM area.
+
+const (
+	packSize  = 0x100000
+	psramPak  = 0x400000
+	psramSize = 0x80000
+	sramSize  = 0x8000
+)
+
+func BusAddressToPak(busAddr uint32) (pakAddr uint32, err error) {
+	if busAddr >= 0xC00000 && busAddr < 0xE00000 {
+		// memory pack access:     $C0:0000-$DF:FFFF
+		pack := busAddr & (packSize - 1)
+		return pack, nil
+	} else if busAddr >= 0x7E0000 && busAddr < 0x800000 {
+		// WRAM access:
+		wram := (busAddr - 0x7E0000) + 0xF50000
+		return wram, nil
+	} else if busAddr >= 0x600000 && busAddr < 0x780000 {
+		// PSRAM access:           $60:0000-$6F:FFFF
+		//                         $70:0000-$77:FFFF
+		psram := (busAddr & (psramSize - 1)) + psramPak
+		return psram, nil
+	} else if busAddr >= 0x400000 && busAddr < 0x600000 {
+		// memory pack access:     $40:0000-$5F:FFFF
+		pack := busAddr & (packSize - 1)
+		return pack, nil
+	} else if (busAddr >= 0x800000 && busAddr < 0xC00000) || busAddr < 0x400000 {
+		bank := (busAddr >> 16) & 0x7F
+		offs := busAddr & 0xFFFF
+		if offs >= 0x8000 {
+			// memory pack access: $00:8000-$3F:FFFF
+			//                     $80:8000-$BF:FFFF
+			pack := util.BankToLinear(busAddr&0x3F7FFF) & (packSize - 1)
+			return pack, nil
+		} else if offs >= 0x5000 && offs < 0x6000 && bank >= 0x10 && bank < 0x18 {
+			// SRAM access in 4KiB pages:
+			//                     $10:5000-$17:5FFF
+			sram := ((bank - 0x10) << 12) + (offs & 0x0FFF) + 0xE00000
+			return sram, nil
+		} else if offs < 0x2000 {
+			// Lower 8KiB of WRAM: $00:0000-$3F:1FFF
+			//                     $80:0000-$BF:1FFF
+			wram := (offs & 0x1FFF) + 0xF50000
+			return wram, nil
+		}
+	}
+	return 0, util.ErrUnmappedAddress
+}
+
+func PakAddressToBus(pakAddr uint32) (busAddr uint32, err error) {
+	if pakAddr >= 0xF50000 {
+		// WRAM is easy:
+		// mirror bank $F7..FF back down into WRAM because these banks in FX Pak Pro space
+		// are not available on the SNES bus; they are copies of otherwise inaccessible memory
+		// like VRAM, CGRAM, OAM, etc.:
+		busAddr = ((pakAddr - 0xF50000) & 0x01FFFF) + 0x7E0000
+		return
+	} else if pakAddr >= 0xE00000 && pakAddr < 0xE00000+sramSize {
+		// SRAM is split into 4KiB pages at $10:5000-5FFF to $17:5000-5FFF:
+		offs := pakAddr - 0xE00000
+		busAddr = ((0x10 + (offs >> 12)) << 16) + (offs & 0x0FFF) + 0x5000
+		return
+	} else if pakAddr >= psramPak && pakAddr < psramPak+psramSize {
+		// PSRAM is linearly mapped to $60:0000-$67:FFFF:
+		busAddr = (pakAddr - psramPak) + 0x600000
+		return
+	} else if pakAddr < packSize {
+		// the memory pack is linearly mapped to $C0:0000-$CF:FFFF:
+		busAddr = pakAddr + 0xC00000
+		return
+	}
+	return 0, util.ErrUnmappedAddress
+}
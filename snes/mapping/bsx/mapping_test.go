@@ -0,0 +1,302 @@
+package bsx
+
+import (
+	"sni/snes/mapping/util"
+	"testing"
+)
+
+func TestPakAddressToBus(t *testing.T) {
+	type args struct {
+		pakAddr uint32
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint32
+	}{
+		{
+			name: "memory pack first byte",
+			args: args{
+				pakAddr: 0x000000,
+			},
+			want: 0xC00000,
+		},
+		{
+			name: "memory pack header",
+			args: args{
+				pakAddr: 0x007FC0,
+			},
+			want: 0xC07FC0,
+		},
+		{
+			name: "memory pack last byte",
+			args: args{
+				pakAddr: 0x0FFFFF,
+			},
+			want: 0xCFFFFF,
+		},
+		{
+			name: "PSRAM first byte",
+			args: args{
+				pakAddr: 0x400000,
+			},
+			want: 0x600000,
+		},
+		{
+			name: "PSRAM last byte",
+			args: args{
+				pakAddr: 0x47FFFF,
+			},
+			want: 0x67FFFF,
+		},
+		{
+			name: "SRAM first byte",
+			args: args{
+				pakAddr: 0xE00000,
+			},
+			want: 0x105000,
+		},
+		{
+			name: "SRAM first page last byte",
+			args: args{
+				pakAddr: 0xE00FFF,
+			},
+			want: 0x105FFF,
+		},
+		{
+			name: "SRAM second page",
+			args: args{
+				pakAddr: 0xE01000,
+			},
+			want: 0x115000,
+		},
+		{
+			name: "SRAM last byte",
+			args: args{
+				pakAddr: 0xE07FFF,
+			},
+			want: 0x175FFF,
+		},
+		{
+			name: "WRAM first byte",
+			args: args{
+				pakAddr: 0xF50000,
+			},
+			want: 0x7E0000,
+		},
+		{
+			name: "WRAM last byte",
+			args: args{
+				pakAddr: 0xF6FFFF,
+			},
+			want: 0x7FFFFF,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := PakAddressToBus(tt.args.pakAddr); got != tt.want {
+				t.Errorf("PakAddressToBus() = 0x%06x, want 0x%06x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusAddressToPak(t *testing.T) {
+	type args struct {
+		busAddr uint32
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint32
+	}{
+		{
+			name: "memory pack bank $00:8000",
+			args: args{
+				busAddr: 0x008000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "memory pack bank $00:FFFF",
+			args: args{
+				busAddr: 0x00FFFF,
+			},
+			want: 0x007FFF,
+		},
+		{
+			name: "memory pack bank $1F:FFFF",
+			args: args{
+				busAddr: 0x1FFFFF,
+			},
+			want: 0x0FFFFF,
+		},
+		{
+			name: "memory pack bank $20:8000 mirror",
+			args: args{
+				busAddr: 0x208000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "memory pack bank $80:FFC0",
+			args: args{
+				busAddr: 0x80FFC0,
+			},
+			want: 0x007FC0,
+		},
+		{
+			name: "memory pack bank $40:0000",
+			args: args{
+				busAddr: 0x400000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "memory pack bank $4F:FFFF",
+			args: args{
+				busAddr: 0x4FFFFF,
+			},
+			want: 0x0FFFFF,
+		},
+		{
+			name: "memory pack bank $C0:FFC0",
+			args: args{
+				busAddr: 0xC0FFC0,
+			},
+			want: 0x00FFC0,
+		},
+		{
+			name: "memory pack bank $CF:FFFF",
+			args: args{
+				busAddr: 0xCFFFFF,
+			},
+			want: 0x0FFFFF,
+		},
+		{
+			name: "memory pack bank $D0:0000 mirror",
+			args: args{
+				busAddr: 0xD00000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "PSRAM bank $60:0000",
+			args: args{
+				busAddr: 0x600000,
+			},
+			want: 0x400000,
+		},
+		{
+			name: "PSRAM bank $67:FFFF",
+			args: args{
+				busAddr: 0x67FFFF,
+			},
+			want: 0x47FFFF,
+		},
+		{
+			name: "PSRAM bank $68:0000 mirror",
+			args: args{
+				busAddr: 0x680000,
+			},
+			want: 0x400000,
+		},
+		{
+			name: "PSRAM bank $70:0000",
+			args: args{
+				busAddr: 0x700000,
+			},
+			want: 0x400000,
+		},
+		{
+			name: "PSRAM bank $77:FFFF",
+			args: args{
+				busAddr: 0x77FFFF,
+			},
+			want: 0x47FFFF,
+		},
+		{
+			name: "SRAM bank $10:5000",
+			args: args{
+				busAddr: 0x105000,
+			},
+			want: 0xE00000,
+		},
+		{
+			name: "SRAM bank $10:5FFF",
+			args: args{
+				busAddr: 0x105FFF,
+			},
+			want: 0xE00FFF,
+		},
+		{
+			name: "SRAM bank $17:5FFF",
+			args: args{
+				busAddr: 0x175FFF,
+			},
+			want: 0xE07FFF,
+		},
+		{
+			name: "WRAM bank $7E:0000",
+			args: args{
+				busAddr: 0x7E0000,
+			},
+			want: 0xF50000,
+		},
+		{
+			name: "WRAM bank $7F:FFFF",
+			args: args{
+				busAddr: 0x7FFFFF,
+			},
+			want: 0xF6FFFF,
+		},
+		{
+			name: "WRAM bank $00:0000",
+			args: args{
+				busAddr: 0x000000,
+			},
+			want: 0xF50000,
+		},
+		{
+			name: "WRAM bank $3F:1FFF",
+			args: args{
+				busAddr: 0x3F1FFF,
+			},
+			want: 0xF51FFF,
+		},
+		{
+			name: "WRAM bank $80:1FFF",
+			args: args{
+				busAddr: 0x801FFF,
+			},
+			want: 0xF51FFF,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := BusAddressToPak(tt.args.busAddr); got != tt.want {
+				t.Errorf("BusAddressToPak() = 0x%06x, want 0x%06x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusAddressToPak_unmapped(t *testing.T) {
+	tests := []struct {
+		name    string
+		busAddr uint32
+	}{
+		{"I/O bank $00:2100", 0x002100},
+		{"memory controller bank $01:5000", 0x015000},
+		{"SRAM page past end bank $18:5000", 0x185000},
+		{"bank $78:0000", 0x780000},
+		{"bank $E0:0000", 0xE00000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := BusAddressToPak(tt.busAddr); err != util.ErrUnmappedAddress {
+				t.Errorf("BusAddressToPak() error = %v, want %v", err, util.ErrUnmappedAddress)
+			}
+		})
+	}
+}
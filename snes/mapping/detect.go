@@ -57,19 +57,34 @@ func Detect(
 		header.MapMode&0b1110_1111,
 	)
 
+	coprocessor := header.Coprocessor()
+	log.Printf(
+		"detect: coprocessor %s\n",
+		coprocessor,
+	)
+
 	confidence = true
 
 	// mask off SlowROM vs FastROM bit:
-	switch header.MapMode & 0b1110_1111 {
-	case 0x20: // LoROM
-		mapping = sni.MemoryMapping_LoROM
-	case 0x21: // HiROM
-		mapping = sni.MemoryMapping_HiROM
-	case 0x22: // ExLoROM
+	switch mapMode := header.MapMode & 0b1110_1111; {
+	case isBSXHeader(&header):
+		mapping = sni.MemoryMapping_BSX
+	// enhancement chips with their own bus layouts:
+	case coprocessor == snes.CoprocessorSuperFX:
+		mapping = sni.MemoryMapping_SuperFX
+	case coprocessor == snes.CoprocessorSDD1:
+		mapping = sni.MemoryMapping_SDD1
+	case coprocessor == snes.CoprocessorSPC7110:
+		mapping = sni.MemoryMapping_SPC7110
+	case mapMode == 0x20: // LoROM
 		mapping = sni.MemoryMapping_LoROM
-	case 0x23: // SA-1
+	case mapMode == 0x21: // HiROM
 		mapping = sni.MemoryMapping_HiROM
-	case 0x25: // ExHiROM
+	case mapMode == 0x22: // ExLoROM
+		mapping = sni.MemoryMapping_ExLoROM
+	case mapMode == 0x23: // SA-1
+		mapping = sni.MemoryMapping_SA1
+	case mapMode == 0x25: // ExHiROM
 		mapping = sni.MemoryMapping_ExHiROM
 	default:
 		confidence = false
@@ -99,16 +114,46 @@ func Detect(
 	return
 }
 
+// isBSXHeader detects a Satellaview memory pack header. Its layout differs from the standard header after the
+// 16-byte title: $FFD5 is the high byte of the limited starts mask, $FFD6-7 is the broadcast month and day, the map
+// mode moves to $FFD8 and $FFDA is fixed to $33.
+func isBSXHeader(header *snes.Header) bool {
+	if header.OldMakerCode != 0x33 && header.OldMakerCode != 0xFF {
+		return false
+	}
+
+	limitedStarts := header.MapMode
+	if limitedStarts != 0 && limitedStarts&0x83 != 0x80 {
+		return false
+	}
+
+	month, day := header.CartridgeType, header.ROMSize
+	if !(month == 0 && day == 0) && !(month == 0xFF && day == 0xFF) {
+		if month&0x0F != 0 || month>>4 < 1 || month>>4 > 12 {
+			return false
+		}
+	}
+
+	// map mode:
+	switch header.RAMSize & 0b1110_1111 {
+	case 0x20, 0x21:
+		return true
+	}
+	return false
+}
+
 func detectHeader(ctx context.Context, memory snes.DeviceMemory) (outHeaderBytes []byte, err error) {
-	addresses := [3]uint32{
+	addresses := [4]uint32{
 		uint32(0x007FB0),
 		uint32(0x00FFB0),
 		uint32(0x40FFB0),
+		uint32(0x407FB0),
 	}
 	mappings := []sni.MemoryMapping{
 		sni.MemoryMapping_LoROM,
 		sni.MemoryMapping_HiROM,
 		sni.MemoryMapping_ExHiROM,
+		sni.MemoryMapping_ExLoROM,
 	}
 
 	defaultAddressSpace, _ := memory.DefaultAddressSpace(nil)
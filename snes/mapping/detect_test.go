@@ -0,0 +1,57 @@
+package mapping
+
+import (
+	"context"
+	"encoding/hex"
+	"sni/protos/sni"
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	header := func(title string, rest string) []byte {
+		b, err := hex.DecodeString(
+			"018d2401e2306bffffffffffffffff00" + title + rest +
+				"ffffffff2c82ffff2c82c9800080d882" +
+				"ffffffff2c822c822c822c820080d882",
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	// "THE LEGEND OF ZELDA  ":
+	const title = "544845204c4547454e44204f46205a454c44412020"
+	// "BS ZELDA        ":
+	const bsTitle = "4253205a454c44412020202020202020"
+
+	tests := []struct {
+		name       string
+		header     []byte
+		mapping    sni.MemoryMapping
+		confidence bool
+	}{
+		{"LoROM", header(title, "20020a03010100f2500daf"), sni.MemoryMapping_LoROM, true},
+		{"FastROM HiROM", header(title, "31020a03010100f2500daf"), sni.MemoryMapping_HiROM, true},
+		{"FastROM ExLoROM", header(title, "32020a03010100f2500daf"), sni.MemoryMapping_ExLoROM, true},
+		{"SA-1", header(title, "23350a03010100f2500daf"), sni.MemoryMapping_SA1, true},
+		{"SuperFX", header(title, "20150a03010100f2500daf"), sni.MemoryMapping_SuperFX, true},
+		{"S-DD1", header(title, "32430a03010100f2500daf"), sni.MemoryMapping_SDD1, true},
+		{"SPC7110", header(title, "3af90a03013300f2500daf"), sni.MemoryMapping_SPC7110, true},
+		{"DSP LoROM", header(title, "20050a03010100f2500daf"), sni.MemoryMapping_LoROM, true},
+		{"ExHiROM", header(title, "25020a03010100f2500daf"), sni.MemoryMapping_ExHiROM, true},
+		{"BS-X", header(bsTitle, "00000000"+"0000"+"0000"+"2010"+"3301f2500daf"), sni.MemoryMapping_BSX, true},
+		{"BS-X dated HiROM", header(bsTitle, "ffff00ff"+"0080"+"4015"+"3180"+"3301f2500daf"), sni.MemoryMapping_BSX, true},
+		{"unknown", header(title, "4f020a03010100f2500daf"), sni.MemoryMapping_HiROM, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapping, confidence, _, err := Detect(context.Background(), nil, nil, tt.header)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if mapping != tt.mapping || confidence != tt.confidence {
+				t.Fatalf("expected %v confidence %v; got %v confidence %v", tt.mapping, tt.confidence, mapping, confidence)
+			}
+		})
+	}
+}
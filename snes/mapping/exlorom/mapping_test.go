@@ -0,0 +1,400 @@
+package exlorom
+
+import (
+	"sni/snes/mapping/util"
+	"testing"
+)
+
+func TestPakAddressToBus(t *testing.T) {
+	type args struct {
+		pakAddr uint32
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint32
+	}{
+		{
+			name: "ROM header",
+			args: args{
+				pakAddr: 0x007FC0,
+			},
+			want: 0x80FFC0,
+		},
+		{
+			name: "ROM first byte",
+			args: args{
+				pakAddr: 0x000000,
+			},
+			want: 0x808000,
+		},
+		{
+			name: "ROM first bank last byte",
+			args: args{
+				pakAddr: 0x007FFF,
+			},
+			want: 0x80FFFF,
+		},
+		{
+			name: "ROM second bank first byte",
+			args: args{
+				pakAddr: 0x008000,
+			},
+			want: 0x818000,
+		},
+		{
+			name: "ROM last byte of first 4MiB",
+			args: args{
+				pakAddr: 0x3FFFFF,
+			},
+			want: 0xFFFFFF,
+		},
+		{
+			name: "ROM extended header",
+			args: args{
+				pakAddr: 0x407FC0,
+			},
+			want: 0x00FFC0,
+		},
+		{
+			name: "ROM extended first byte",
+			args: args{
+				pakAddr: 0x400000,
+			},
+			want: 0x008000,
+		},
+		{
+			name: "ROM extended bank $3F last byte",
+			args: args{
+				pakAddr: 0x5FFFFF,
+			},
+			want: 0x3FFFFF,
+		},
+		{
+			name: "ROM extended bank $40 first byte",
+			args: args{
+				pakAddr: 0x600000,
+			},
+			want: 0x408000,
+		},
+		{
+			name: "ROM extended bank $7D last byte",
+			args: args{
+				pakAddr: 0x7EFFFF,
+			},
+			want: 0x7DFFFF,
+		},
+		{
+			name: "SRAM $0 bank",
+			args: args{
+				pakAddr: 0xE00000,
+			},
+			want: 0xF00000,
+		},
+		{
+			name: "SRAM $0 bank last byte",
+			args: args{
+				pakAddr: 0xE07FFF,
+			},
+			want: 0xF07FFF,
+		},
+		{
+			name: "SRAM $1 bank first byte",
+			args: args{
+				pakAddr: 0xE08000,
+			},
+			want: 0xF10000,
+		},
+		{
+			name: "SRAM $F bank last byte",
+			args: args{
+				pakAddr: 0xE7FFFF,
+			},
+			want: 0xFF7FFF,
+		},
+		{
+			name: "SRAM mirror $0 bank",
+			args: args{
+				pakAddr: 0xE80000,
+			},
+			want: 0xF00000,
+		},
+		{
+			name: "WRAM $00000",
+			args: args{
+				pakAddr: 0xF50000,
+			},
+			want: 0x7E0000,
+		},
+		{
+			name: "WRAM $1FFFF",
+			args: args{
+				pakAddr: 0xF6FFFF,
+			},
+			want: 0x7FFFFF,
+		},
+		{
+			name: "WRAM mirror 1",
+			args: args{
+				pakAddr: 0xF70000,
+			},
+			want: 0x7E0000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := PakAddressToBus(tt.args.pakAddr); got != tt.want {
+				t.Errorf("PakAddressToBus() = 0x%06x, want 0x%06x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusAddressToPak(t *testing.T) {
+	type args struct {
+		busAddr uint32
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint32
+	}{
+		{
+			name: "ROM bank $80:8000",
+			args: args{
+				busAddr: 0x808000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "ROM bank $80:FFC0",
+			args: args{
+				busAddr: 0x80FFC0,
+			},
+			want: 0x007FC0,
+		},
+		{
+			name: "ROM bank $81:8000",
+			args: args{
+				busAddr: 0x818000,
+			},
+			want: 0x008000,
+		},
+		{
+			name: "ROM bank $BF:FFFF",
+			args: args{
+				busAddr: 0xBFFFFF,
+			},
+			want: 0x1FFFFF,
+		},
+		{
+			name: "ROM bank $C0:8000",
+			args: args{
+				busAddr: 0xC08000,
+			},
+			want: 0x200000,
+		},
+		{
+			name: "ROM bank $EF:FFFF",
+			args: args{
+				busAddr: 0xEFFFFF,
+			},
+			want: 0x37FFFF,
+		},
+		{
+			name: "ROM bank $F0:8000",
+			args: args{
+				busAddr: 0xF08000,
+			},
+			want: 0x380000,
+		},
+		{
+			name: "ROM bank $FF:FFFF",
+			args: args{
+				busAddr: 0xFFFFFF,
+			},
+			want: 0x3FFFFF,
+		},
+		{
+			name: "ROM bank $00:8000",
+			args: args{
+				busAddr: 0x008000,
+			},
+			want: 0x400000,
+		},
+		{
+			name: "ROM bank $00:FFC0",
+			args: args{
+				busAddr: 0x00FFC0,
+			},
+			want: 0x407FC0,
+		},
+		{
+			name: "ROM bank $3F:FFFF",
+			args: args{
+				busAddr: 0x3FFFFF,
+			},
+			want: 0x5FFFFF,
+		},
+		{
+			name: "ROM bank $40:8000",
+			args: args{
+				busAddr: 0x408000,
+			},
+			want: 0x600000,
+		},
+		{
+			name: "ROM bank $6F:FFFF",
+			args: args{
+				busAddr: 0x6FFFFF,
+			},
+			want: 0x77FFFF,
+		},
+		{
+			name: "ROM bank $70:8000",
+			args: args{
+				busAddr: 0x708000,
+			},
+			want: 0x780000,
+		},
+		{
+			name: "ROM bank $7D:FFFF",
+			args: args{
+				busAddr: 0x7DFFFF,
+			},
+			want: 0x7EFFFF,
+		},
+		{
+			name: "SRAM bank $70:0000",
+			args: args{
+				busAddr: 0x700000,
+			},
+			want: 0xE00000,
+		},
+		{
+			name: "SRAM bank $70:7FFF",
+			args: args{
+				busAddr: 0x707FFF,
+			},
+			want: 0xE07FFF,
+		},
+		{
+			name: "SRAM bank $71:0000",
+			args: args{
+				busAddr: 0x710000,
+			},
+			want: 0xE08000,
+		},
+		{
+			name: "SRAM bank $7D:7FFF",
+			args: args{
+				busAddr: 0x7D7FFF,
+			},
+			want: 0xE6FFFF,
+		},
+		{
+			name: "SRAM bank $F0:0000",
+			args: args{
+				busAddr: 0xF00000,
+			},
+			want: 0xE00000,
+		},
+		{
+			name: "SRAM bank $FF:7FFF",
+			args: args{
+				busAddr: 0xFF7FFF,
+			},
+			want: 0xE7FFFF,
+		},
+		{
+			name: "WRAM bank $7E:0000",
+			args: args{
+				busAddr: 0x7E0000,
+			},
+			want: 0xF50000,
+		},
+		{
+			name: "WRAM bank $7F:FFFF",
+			args: args{
+				busAddr: 0x7FFFFF,
+			},
+			want: 0xF6FFFF,
+		},
+		{
+			name: "WRAM bank $00:0000",
+			args: args{
+				busAddr: 0x000000,
+			},
+			want: 0xF50000,
+		},
+		{
+			name: "WRAM bank $00:1FFF",
+			args: args{
+				busAddr: 0x001FFF,
+			},
+			want: 0xF51FFF,
+		},
+		{
+			name: "WRAM bank $3F:1FFF",
+			args: args{
+				busAddr: 0x3F1FFF,
+			},
+			want: 0xF51FFF,
+		},
+		{
+			name: "WRAM bank $80:0000",
+			args: args{
+				busAddr: 0x800000,
+			},
+			want: 0xF50000,
+		},
+		{
+			name: "WRAM bank $BF:1FFF",
+			args: args{
+				busAddr: 0xBF1FFF,
+			},
+			want: 0xF51FFF,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := BusAddressToPak(tt.args.busAddr); got != tt.want {
+				t.Errorf("BusAddressToPak() = 0x%06x, want 0x%06x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	ranges := []struct {
+		name  string
+		start uint32
+		end   uint32
+	}{
+		{"ROM", 0x000000, 0x7F0000},
+		{"SRAM", 0xE00000, 0xE80000},
+		{"WRAM", 0xF50000, 0xF70000},
+	}
+	for _, r := range ranges {
+		t.Run(r.name, func(t *testing.T) {
+			for pakAddr := r.start; pakAddr < r.end; pakAddr += 0x123 {
+				busAddr, err := PakAddressToBus(pakAddr)
+				if err != nil {
+					t.Fatalf("PakAddressToBus(0x%06x) error = %v", pakAddr, err)
+				}
+				got, err := BusAddressToPak(busAddr)
+				if err != nil {
+					t.Fatalf("BusAddressToPak(0x%06x) error = %v", busAddr, err)
+				}
+				if got != pakAddr {
+					t.Fatalf("BusAddressToPak(PakAddressToBus(0x%06x)) = 0x%06x", pakAddr, got)
+				}
+			}
+		})
+	}
+
+	// ROM past 8MiB less the WRAM banks is not mapped:
+	if _, err := PakAddressToBus(0x7F0000); err != util.ErrUnmappedAddress {
+		t.Errorf("PakAddressToBus() error = %v, want %v", err, util.ErrUnmappedAddress)
+	}
+}
@@ -3,9 +3,15 @@ package mapping
 import (
 	"sni/protos/sni"
 	"sni/snes"
+	"sni/snes/mapping/bsx"
 	"sni/snes/mapping/exhirom"
+	"sni/snes/mapping/exlorom"
 	"sni/snes/mapping/hirom"
 	"sni/snes/mapping/lorom"
+	"sni/snes/mapping/sa1"
+	"sni/snes/mapping/sdd1"
+	"sni/snes/mapping/spc7110"
+	"sni/snes/mapping/superfx"
 )
 
 type MemoryType string
@@ -16,8 +22,25 @@ const (
 	MemoryTypeROM     MemoryType = "CARTROM"
 	MemoryTypeSRAM    MemoryType = "SRAM"
 	MemoryTypeWRAM    MemoryType = "WRAM"
+	MemoryTypeVRAM    MemoryType = "VRAM"
+	MemoryTypeAPU     MemoryType = "APURAM"
+	MemoryTypeCGRAM   MemoryType = "CGRAM"
+	MemoryTypeOAM     MemoryType = "OAM"
+	MemoryTypeMISC    MemoryType = "MISC"
+	MemoryTypePPUREG  MemoryType = "PPUREG"
+	MemoryTypeCPUREG  MemoryType = "CPUREG"
 )
 
+func init() {
+	snes.RegisterMemoryRegionFunc(memoryRegion)
+}
+
+// memoryRegion lets the read cache check that merged reads stay within one type of memory.
+func memoryRegion(a snes.AddressTuple) (memoryType string, pakAddress uint32, ok bool) {
+	t, pakAddress, _ := MemoryTypeFor(&a)
+	return string(t), pakAddress, t != MemoryTypeUnknown
+}
+
 func MemoryTypeFor(a *snes.AddressTuple) (memoryType MemoryType, pakAddress uint32, offset uint32) {
 	var err error
 
@@ -33,6 +56,18 @@ func MemoryTypeFor(a *snes.AddressTuple) (memoryType MemoryType, pakAddress uint
 			pakAddress, err = hirom.BusAddressToPak(a.Address)
 		case sni.MemoryMapping_ExHiROM:
 			pakAddress, err = exhirom.BusAddressToPak(a.Address)
+		case sni.MemoryMapping_ExLoROM:
+			pakAddress, err = exlorom.BusAddressToPak(a.Address)
+		case sni.MemoryMapping_SA1:
+			pakAddress, err = sa1.BusAddressToPak(a.Address)
+		case sni.MemoryMapping_BSX:
+			pakAddress, err = bsx.BusAddressToPak(a.Address)
+		case sni.MemoryMapping_SuperFX:
+			pakAddress, err = superfx.BusAddressToPak(a.Address)
+		case sni.MemoryMapping_SDD1:
+			pakAddress, err = sdd1.BusAddressToPak(a.Address)
+		case sni.MemoryMapping_SPC7110:
+			pakAddress, err = spc7110.BusAddressToPak(a.Address)
 		}
 	case sni.AddressSpace_Raw:
 		err = ErrUnknownMapping
@@ -57,9 +92,22 @@ func MemoryTypeForPakAddress(pakAddress uint32) (memoryType MemoryType, offset u
 		memoryType, offset = MemoryTypeUnknown, pakAddress-0xF0_0000
 	} else if pakAddress < 0xF7_0000 {
 		memoryType, offset = MemoryTypeWRAM, pakAddress-0xF5_0000
+	} else if pakAddress < 0xF8_0000 {
+		memoryType, offset = MemoryTypeVRAM, pakAddress-0xF7_0000
+	} else if pakAddress < 0xF9_0000 {
+		memoryType, offset = MemoryTypeAPU, pakAddress-0xF8_0000
+	} else if pakAddress < 0xF9_0200 {
+		memoryType, offset = MemoryTypeCGRAM, pakAddress-0xF9_0000
+	} else if pakAddress < 0xF9_0420 {
+		memoryType, offset = MemoryTypeOAM, pakAddress-0xF9_0200
+	} else if pakAddress < 0xF9_0500 {
+		memoryType, offset = MemoryTypeMISC, pakAddress-0xF9_0420
+	} else if pakAddress < 0xF9_0700 {
+		memoryType, offset = MemoryTypePPUREG, pakAddress-0xF9_0500
+	} else if pakAddress < 0xF9_0900 {
+		memoryType, offset = MemoryTypeCPUREG, pakAddress-0xF9_0700
 	} else {
-		// TODO: VRAM, APU, CGRAM, OAM, MISC, PPUREG, CPUREG, etc.
-		memoryType, offset = MemoryTypeUnknown, pakAddress-0xF7_0000
+		memoryType, offset = MemoryTypeUnknown, pakAddress-0xF9_0900
 	}
 	return
 }
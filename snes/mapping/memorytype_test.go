@@ -0,0 +1,144 @@
+package mapping
+
+import (
+	"sni/protos/sni"
+	"sni/snes"
+	"testing"
+)
+
+func TestMemoryTypeForPakAddress(t *testing.T) {
+	tests := []struct {
+		pakAddress     uint32
+		wantMemoryType MemoryType
+		wantOffset     uint32
+	}{
+		{0x000000, MemoryTypeROM, 0x000000},
+		{0xDFFFFF, MemoryTypeROM, 0xDFFFFF},
+		{0xE00000, MemoryTypeSRAM, 0x000000},
+		{0xEFFFFF, MemoryTypeSRAM, 0x0FFFFF},
+		{0xF00000, MemoryTypeUnknown, 0x000000},
+		{0xF50000, MemoryTypeWRAM, 0x000000},
+		{0xF6FFFF, MemoryTypeWRAM, 0x01FFFF},
+		{0xF70000, MemoryTypeVRAM, 0x000000},
+		{0xF7FFFF, MemoryTypeVRAM, 0x00FFFF},
+		{0xF80000, MemoryTypeAPU, 0x000000},
+		{0xF8FFFF, MemoryTypeAPU, 0x00FFFF},
+		{0xF90000, MemoryTypeCGRAM, 0x000000},
+		{0xF901FF, MemoryTypeCGRAM, 0x0001FF},
+		{0xF90200, MemoryTypeOAM, 0x000000},
+		{0xF9041F, MemoryTypeOAM, 0x00021F},
+		{0xF90420, MemoryTypeMISC, 0x000000},
+		{0xF904FF, MemoryTypeMISC, 0x0000DF},
+		{0xF90500, MemoryTypePPUREG, 0x000000},
+		{0xF906FF, MemoryTypePPUREG, 0x0001FF},
+		{0xF90700, MemoryTypeCPUREG, 0x000000},
+		{0xF908FF, MemoryTypeCPUREG, 0x0001FF},
+		{0xF90900, MemoryTypeUnknown, 0x000000},
+	}
+	for _, tt := range tests {
+		gotMemoryType, gotOffset := MemoryTypeForPakAddress(tt.pakAddress)
+		if gotMemoryType != tt.wantMemoryType || gotOffset != tt.wantOffset {
+			t.Errorf(
+				"MemoryTypeForPakAddress(0x%06x) = (%s, 0x%06x), want (%s, 0x%06x)",
+				tt.pakAddress,
+				gotMemoryType,
+				gotOffset,
+				tt.wantMemoryType,
+				tt.wantOffset,
+			)
+		}
+	}
+}
+
+func TestMemoryTypeFor(t *testing.T) {
+	tests := []struct {
+		name           string
+		address        snes.AddressTuple
+		wantMemoryType MemoryType
+		wantPakAddress uint32
+		wantOffset     uint32
+	}{
+		{
+			name:           "OAM in FxPakPro space",
+			address:        snes.AddressTuple{Address: 0xF90210, AddressSpace: sni.AddressSpace_FxPakPro},
+			wantMemoryType: MemoryTypeOAM,
+			wantPakAddress: 0xF90210,
+			wantOffset:     0x10,
+		},
+		{
+			name: "WRAM in SNES A-bus space",
+			address: snes.AddressTuple{
+				Address:       0x7E0100,
+				AddressSpace:  sni.AddressSpace_SnesABus,
+				MemoryMapping: sni.MemoryMapping_LoROM,
+			},
+			wantMemoryType: MemoryTypeWRAM,
+			wantPakAddress: 0xF50100,
+			wantOffset:     0x100,
+		},
+		{
+			name:           "Raw space",
+			address:        snes.AddressTuple{Address: 0x000000, AddressSpace: sni.AddressSpace_Raw},
+			wantMemoryType: MemoryTypeUnknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMemoryType, gotPakAddress, gotOffset := MemoryTypeFor(&tt.address)
+			if gotMemoryType != tt.wantMemoryType {
+				t.Errorf("MemoryTypeFor() memoryType = %s, want %s", gotMemoryType, tt.wantMemoryType)
+			}
+			if gotPakAddress != tt.wantPakAddress {
+				t.Errorf("MemoryTypeFor() pakAddress = 0x%06x, want 0x%06x", gotPakAddress, tt.wantPakAddress)
+			}
+			if gotOffset != tt.wantOffset {
+				t.Errorf("MemoryTypeFor() offset = 0x%06x, want 0x%06x", gotOffset, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func Test_memoryRegion(t *testing.T) {
+	pak := func(address uint32) snes.AddressTuple {
+		return snes.AddressTuple{Address: address, AddressSpace: sni.AddressSpace_FxPakPro}
+	}
+	lorom := func(address uint32) snes.AddressTuple {
+		return snes.AddressTuple{Address: address, AddressSpace: sni.AddressSpace_SnesABus, MemoryMapping: sni.MemoryMapping_LoROM}
+	}
+
+	tests := []struct {
+		name           string
+		address        snes.AddressTuple
+		wantMemoryType string
+		wantPakAddress uint32
+		wantOk         bool
+	}{
+		{"end of WRAM", pak(0xF6FFFF), string(MemoryTypeWRAM), 0xF6FFFF, true},
+		{"start of VRAM", pak(0xF70000), string(MemoryTypeVRAM), 0xF70000, true},
+		{"A-bus low WRAM", lorom(0x001FFF), string(MemoryTypeWRAM), 0xF51FFF, true},
+		{"A-bus I/O", lorom(0x002000), "", 0, false},
+		{"A-bus ROM", lorom(0x018000), string(MemoryTypeROM), 0x008000, true},
+		{"unmapped pak", pak(0xF00000), "", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMemoryType, gotPakAddress, gotOk := memoryRegion(tt.address)
+			if !tt.wantOk {
+				if gotOk {
+					t.Errorf("memoryRegion() = (%s, 0x%06x, true), want not ok", gotMemoryType, gotPakAddress)
+				}
+				return
+			}
+			if gotMemoryType != tt.wantMemoryType || gotPakAddress != tt.wantPakAddress || !gotOk {
+				t.Errorf(
+					"memoryRegion() = (%s, 0x%06x, %v), want (%s, 0x%06x, true)",
+					gotMemoryType,
+					gotPakAddress,
+					gotOk,
+					tt.wantMemoryType,
+					tt.wantPakAddress,
+				)
+			}
+		})
+	}
+}
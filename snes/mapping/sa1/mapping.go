@@ -0,0 +1,68 @@
+package sa1
+
+import "sni/snes/mapping/util"
+
+// https://wiki.superfamicom.org/sa-1
+// The SA-1 super MMC can remap ROM banks at run-time; this translation assumes the power-on bank registers
+// (CXB=0, DXB=1, EXB=2, FXB=3) which nearly all games keep. BW-RAM is kept in the FX Pak Pro SRAM area. The 2KiB of
+// I-RAM at $00:3000-$00:37FF is inside the SA-1 chip and has no FX Pak Pro address so it is left unmapped.
+
+func BusAddressToPak(busAddr uint32) (pakAddr uint32, err error) {
+	if busAddr >= 0xC00000 && busAddr < 0x1_000000 {
+		// ROM access:             $C0:0000-$FF:FFFF
+		rom := (busAddr & 0x3FFFFF) + 0x000000
+		return rom, nil
+	} else if busAddr >= 0x7E0000 && busAddr < 0x800000 {
+		// WRAM access:
+		wram := (busAddr - 0x7E0000) + 0xF50000
+		return wram, nil
+	} else if busAddr >= 0x400000 && busAddr < 0x500000 {
+		// BW-RAM access:          $40:0000-$4F:FFFF
+		bwram := (busAddr - 0x400000) + 0xE00000
+		return bwram, nil
+	} else if (busAddr >= 0x800000 && busAddr < 0xC00000) || busAddr < 0x400000 {
+		offs := busAddr & 0xFFFF
+		if offs >= 0x8000 {
+			// ROM access:         $00:8000-$3F:FFFF
+			//                     $80:8000-$BF:FFFF
+			rom := util.BankToLinear(busAddr & 0x3F7FFF)
+			if busAddr >= 0x800000 {
+				rom += 0x200000
+			}
+			return rom, nil
+		} else if offs >= 0x6000 {
+			// BW-RAM 8KiB block selected by BMAPS, assumed to be block 0:
+			//                     $00:6000-$3F:7FFF
+			//                     $80:6000-$BF:7FFF
+			bwram := (offs & 0x1FFF) + 0xE00000
+			return bwram, nil
+		} else if offs < 0x2000 {
+			// Lower 8KiB of WRAM: $00:0000-$3F:1FFF
+			//                     $80:0000-$BF:1FFF
+			wram := (offs & 0x1FFF) + 0xF50000
+			return wram, nil
+		}
+	}
+	return 0, util.ErrUnmappedAddress
+}
+
+func PakAddressToBus(pakAddr uint32) (busAddr uint32, err error) {
+	if pakAddr >= 0xF50000 {
+		// WRAM is easy:
+		// mirror bank $F7..FF back down into WRAM because these banks in FX Pak Pro space
+		// are not available on the SNES bus; they are copies of otherwise inaccessible memory
+		// like VRAM, CGRAM, OAM, etc.:
+		busAddr = ((pakAddr - 0xF50000) & 0x01FFFF) + 0x7E0000
+		return
+	} else if pakAddr >= 0xE00000 && pakAddr < 0xF00000 {
+		// BW-RAM is linearly mapped to $40:0000-$4F:FFFF:
+		busAddr = (pakAddr - 0xE00000) + 0x400000
+		return
+	} else if pakAddr < 0xE00000 {
+		// ROM access:
+		// Starting at $C0 gets us the full linear mapping of the first 4MiB of ROM:
+		busAddr = 0xC00000 + (pakAddr & 0x3FFFFF)
+		return
+	}
+	return 0, util.ErrUnmappedAddress
+}
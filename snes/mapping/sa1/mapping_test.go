@@ -0,0 +1,308 @@
+package sa1
+
+import (
+	"sni/snes/mapping/util"
+	"testing"
+)
+
+func TestPakAddressToBus(t *testing.T) {
+	type args struct {
+		pakAddr uint32
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint32
+	}{
+		{
+			name: "ROM header",
+			args: args{
+				pakAddr: 0x007FC0,
+			},
+			want: 0xC07FC0,
+		},
+		{
+			name: "ROM header bank $40 mirror",
+			args: args{
+				pakAddr: 0x407FC0,
+			},
+			want: 0xC07FC0,
+		},
+		{
+			name: "ROM first byte",
+			args: args{
+				pakAddr: 0x000000,
+			},
+			want: 0xC00000,
+		},
+		{
+			name: "ROM bank $00 last byte",
+			args: args{
+				pakAddr: 0x00FFFF,
+			},
+			want: 0xC0FFFF,
+		},
+		{
+			name: "ROM last byte of 4MiB",
+			args: args{
+				pakAddr: 0x3FFFFF,
+			},
+			want: 0xFFFFFF,
+		},
+		{
+			name: "BW-RAM first byte",
+			args: args{
+				pakAddr: 0xE00000,
+			},
+			want: 0x400000,
+		},
+		{
+			name: "BW-RAM bank $00 last byte",
+			args: args{
+				pakAddr: 0xE0FFFF,
+			},
+			want: 0x40FFFF,
+		},
+		{
+			name: "BW-RAM last byte",
+			args: args{
+				pakAddr: 0xEFFFFF,
+			},
+			want: 0x4FFFFF,
+		},
+		{
+			name: "WRAM first byte",
+			args: args{
+				pakAddr: 0xF50000,
+			},
+			want: 0x7E0000,
+		},
+		{
+			name: "WRAM last byte",
+			args: args{
+				pakAddr: 0xF6FFFF,
+			},
+			want: 0x7FFFFF,
+		},
+		{
+			name: "VRAM mirrors WRAM",
+			args: args{
+				pakAddr: 0xF70000,
+			},
+			want: 0x7E0000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := PakAddressToBus(tt.args.pakAddr); got != tt.want {
+				t.Errorf("PakAddressToBus() = 0x%06x, want 0x%06x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusAddressToPak(t *testing.T) {
+	type args struct {
+		busAddr uint32
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint32
+	}{
+		{
+			name: "ROM bank $00:8000",
+			args: args{
+				busAddr: 0x008000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "ROM bank $00:FFFF",
+			args: args{
+				busAddr: 0x00FFFF,
+			},
+			want: 0x007FFF,
+		},
+		{
+			name: "ROM bank $01:8000",
+			args: args{
+				busAddr: 0x018000,
+			},
+			want: 0x008000,
+		},
+		{
+			name: "ROM bank $3F:FFFF",
+			args: args{
+				busAddr: 0x3FFFFF,
+			},
+			want: 0x1FFFFF,
+		},
+		{
+			name: "ROM bank $80:8000",
+			args: args{
+				busAddr: 0x808000,
+			},
+			want: 0x200000,
+		},
+		{
+			name: "ROM bank $BF:FFFF",
+			args: args{
+				busAddr: 0xBFFFFF,
+			},
+			want: 0x3FFFFF,
+		},
+		{
+			name: "ROM bank $C0:0000",
+			args: args{
+				busAddr: 0xC00000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "ROM bank $C0:FFB0",
+			args: args{
+				busAddr: 0xC0FFB0,
+			},
+			want: 0x00FFB0,
+		},
+		{
+			name: "ROM bank $FF:FFFF",
+			args: args{
+				busAddr: 0xFFFFFF,
+			},
+			want: 0x3FFFFF,
+		},
+		{
+			name: "BW-RAM bank $40:0000",
+			args: args{
+				busAddr: 0x400000,
+			},
+			want: 0xE00000,
+		},
+		{
+			name: "BW-RAM bank $41:2345",
+			args: args{
+				busAddr: 0x412345,
+			},
+			want: 0xE12345,
+		},
+		{
+			name: "BW-RAM bank $4F:FFFF",
+			args: args{
+				busAddr: 0x4FFFFF,
+			},
+			want: 0xEFFFFF,
+		},
+		{
+			name: "BW-RAM block bank $00:6000",
+			args: args{
+				busAddr: 0x006000,
+			},
+			want: 0xE00000,
+		},
+		{
+			name: "BW-RAM block bank $00:7FFF",
+			args: args{
+				busAddr: 0x007FFF,
+			},
+			want: 0xE01FFF,
+		},
+		{
+			name: "BW-RAM block bank $3F:6000",
+			args: args{
+				busAddr: 0x3F6000,
+			},
+			want: 0xE00000,
+		},
+		{
+			name: "BW-RAM block bank $80:7FFF",
+			args: args{
+				busAddr: 0x807FFF,
+			},
+			want: 0xE01FFF,
+		},
+		{
+			name: "WRAM bank $7E:0000",
+			args: args{
+				busAddr: 0x7E0000,
+			},
+			want: 0xF50000,
+		},
+		{
+			name: "WRAM bank $7F:FFFF",
+			args: args{
+				busAddr: 0x7FFFFF,
+			},
+			want: 0xF6FFFF,
+		},
+		{
+			name: "WRAM bank $00:0000",
+			args: args{
+				busAddr: 0x000000,
+			},
+			want: 0xF50000,
+		},
+		{
+			name: "WRAM bank $00:1FFF",
+			args: args{
+				busAddr: 0x001FFF,
+			},
+			want: 0xF51FFF,
+		},
+		{
+			name: "WRAM bank $3F:1FFF",
+			args: args{
+				busAddr: 0x3F1FFF,
+			},
+			want: 0xF51FFF,
+		},
+		{
+			name: "WRAM bank $80:0000",
+			args: args{
+				busAddr: 0x800000,
+			},
+			want: 0xF50000,
+		},
+		{
+			name: "WRAM bank $BF:1FFF",
+			args: args{
+				busAddr: 0xBF1FFF,
+			},
+			want: 0xF51FFF,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := BusAddressToPak(tt.args.busAddr); got != tt.want {
+				t.Errorf("BusAddressToPak() = 0x%06x, want 0x%06x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusAddressToPak_unmapped(t *testing.T) {
+	tests := []struct {
+		name    string
+		busAddr uint32
+	}{
+		{"I/O bank $00:2100", 0x002100},
+		{"I-RAM bank $00:3000", 0x003000},
+		{"I-RAM bank $80:37FF", 0x8037FF},
+		{"bitmap BW-RAM bank $60:0000", 0x600000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := BusAddressToPak(tt.busAddr); err != util.ErrUnmappedAddress {
+				t.Errorf("BusAddressToPak() error = %v, want %v", err, util.ErrUnmappedAddress)
+			}
+		})
+	}
+}
+
+func TestPakAddressToBus_unmapped(t *testing.T) {
+	// there is no FX Pak Pro address for I-RAM:
+	if _, err := PakAddressToBus(0xF00000); err != util.ErrUnmappedAddress {
+		t.Errorf("PakAddressToBus() error = %v, want %v", err, util.ErrUnmappedAddress)
+	}
+}
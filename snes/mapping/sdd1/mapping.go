@@ -0,0 +1,66 @@
+package sdd1
+
+import "sni/snes/mapping/util"
+
+// https://wiki.superfamicom.org/s-dd1
+// The S-DD1 maps the first 2MiB of ROM LoROM-style in banks $00-$3F and $80-$BF. Banks $C0-$FF are four 1MiB
+// windows into ROM selected by the $4804-$4807 bank registers; this translation assumes their power-on values
+// (0, 1, 2, 3) which map the first 4MiB of ROM linearly.
+
+func BusAddressToPak(busAddr uint32) (pakAddr uint32, err error) {
+	if busAddr >= 0xC00000 && busAddr < 0x1_000000 {
+		// ROM access:             $C0:0000-$FF:FFFF
+		rom := busAddr & 0x3FFFFF
+		return rom, nil
+	} else if busAddr >= 0x7E0000 && busAddr < 0x800000 {
+		// WRAM access:
+		wram := (busAddr - 0x7E0000) + 0xF50000
+		return wram, nil
+	} else if busAddr >= 0x700000 && busAddr < 0x7E0000 {
+		if busAddr&0x8000 != 0 {
+			// ROM access:         $70:8000-$7D:FFFF
+			rom := util.BankToLinear(busAddr & 0x3F7FFF)
+			return rom, nil
+		} else {
+			// SRAM access:        $70:0000-$7D:7FFF
+			sram := util.BankToLinear(busAddr-0x700000) + 0xE00000
+			return sram, nil
+		}
+	} else if busAddr < 0x700000 || (busAddr >= 0x800000 && busAddr < 0xC00000) {
+		if busAddr&0x8000 != 0 {
+			// ROM access:         $00:8000-$6F:FFFF
+			//                     $80:8000-$BF:FFFF
+			rom := util.BankToLinear(busAddr & 0x3F7FFF)
+			return rom, nil
+		} else if busAddr&0xFFFF < 0x2000 {
+			// Lower 8KiB of WRAM: $00:0000-$6F:1FFF
+			//                     $80:0000-$BF:1FFF
+			wram := (busAddr & 0x1FFF) + 0xF50000
+			return wram, nil
+		}
+	}
+	return 0, util.ErrUnmappedAddress
+}
+
+func PakAddressToBus(pakAddr uint32) (busAddr uint32, err error) {
+	if pakAddr >= 0xF50000 {
+		// WRAM is easy:
+		// mirror bank $F7..FF back down into WRAM because these banks in FX Pak Pro space
+		// are not available on the SNES bus; they are copies of otherwise inaccessible memory
+		// like VRAM, CGRAM, OAM, etc.:
+		busAddr = ((pakAddr - 0xF50000) & 0x01FFFF) + 0x7E0000
+		return
+	} else if pakAddr >= 0xE00000 && pakAddr < 0xE70000 {
+		// SRAM is mapped to the lower halves of banks $70-$7D:
+		busAddr = pakAddr - 0xE00000
+		offs := busAddr & 0x7FFF
+		bank := busAddr >> 15
+		busAddr = ((0x70 + bank) << 16) + offs
+		return
+	} else if pakAddr < 0x400000 {
+		// ROM is linearly mapped to $C0:0000-$FF:FFFF by the default bank registers:
+		busAddr = pakAddr + 0xC00000
+		return
+	}
+	return 0, util.ErrUnmappedAddress
+}
@@ -0,0 +1,229 @@
+package sdd1
+
+import (
+	"sni/snes/mapping/util"
+	"testing"
+)
+
+func TestPakAddressToBus(t *testing.T) {
+	type args struct {
+		pakAddr uint32
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint32
+	}{
+		{
+			name: "ROM header",
+			args: args{
+				pakAddr: 0x007FC0,
+			},
+			want: 0xC07FC0,
+		},
+		{
+			name: "ROM first byte",
+			args: args{
+				pakAddr: 0x000000,
+			},
+			want: 0xC00000,
+		},
+		{
+			name: "ROM last byte of 4MiB",
+			args: args{
+				pakAddr: 0x3FFFFF,
+			},
+			want: 0xFFFFFF,
+		},
+		{
+			name: "SRAM first byte",
+			args: args{
+				pakAddr: 0xE00000,
+			},
+			want: 0x700000,
+		},
+		{
+			name: "SRAM bank $70 last byte",
+			args: args{
+				pakAddr: 0xE07FFF,
+			},
+			want: 0x707FFF,
+		},
+		{
+			name: "SRAM bank $71 first byte",
+			args: args{
+				pakAddr: 0xE08000,
+			},
+			want: 0x710000,
+		},
+		{
+			name: "SRAM last byte",
+			args: args{
+				pakAddr: 0xE6FFFF,
+			},
+			want: 0x7D7FFF,
+		},
+		{
+			name: "WRAM first byte",
+			args: args{
+				pakAddr: 0xF50000,
+			},
+			want: 0x7E0000,
+		},
+		{
+			name: "WRAM last byte",
+			args: args{
+				pakAddr: 0xF6FFFF,
+			},
+			want: 0x7FFFFF,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := PakAddressToBus(tt.args.pakAddr); got != tt.want {
+				t.Errorf("PakAddressToBus() = 0x%06x, want 0x%06x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusAddressToPak(t *testing.T) {
+	type args struct {
+		busAddr uint32
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint32
+	}{
+		{
+			name: "ROM bank $00:8000",
+			args: args{
+				busAddr: 0x008000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "ROM bank $00:FFC0",
+			args: args{
+				busAddr: 0x00FFC0,
+			},
+			want: 0x007FC0,
+		},
+		{
+			name: "ROM bank $3F:FFFF",
+			args: args{
+				busAddr: 0x3FFFFF,
+			},
+			want: 0x1FFFFF,
+		},
+		{
+			name: "ROM bank $80:8000",
+			args: args{
+				busAddr: 0x808000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "ROM bank $BF:FFFF",
+			args: args{
+				busAddr: 0xBFFFFF,
+			},
+			want: 0x1FFFFF,
+		},
+		{
+			name: "ROM bank $C0:0000",
+			args: args{
+				busAddr: 0xC00000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "ROM bank $D0:0000",
+			args: args{
+				busAddr: 0xD00000,
+			},
+			want: 0x100000,
+		},
+		{
+			name: "ROM bank $FF:FFFF",
+			args: args{
+				busAddr: 0xFFFFFF,
+			},
+			want: 0x3FFFFF,
+		},
+		{
+			name: "SRAM bank $70:0000",
+			args: args{
+				busAddr: 0x700000,
+			},
+			want: 0xE00000,
+		},
+		{
+			name: "SRAM bank $70:7FFF",
+			args: args{
+				busAddr: 0x707FFF,
+			},
+			want: 0xE07FFF,
+		},
+		{
+			name: "SRAM bank $71:0000",
+			args: args{
+				busAddr: 0x710000,
+			},
+			want: 0xE08000,
+		},
+		{
+			name: "WRAM bank $7E:0000",
+			args: args{
+				busAddr: 0x7E0000,
+			},
+			want: 0xF50000,
+		},
+		{
+			name: "WRAM bank $7F:FFFF",
+			args: args{
+				busAddr: 0x7FFFFF,
+			},
+			want: 0xF6FFFF,
+		},
+		{
+			name: "WRAM bank $00:0000",
+			args: args{
+				busAddr: 0x000000,
+			},
+			want: 0xF50000,
+		},
+		{
+			name: "WRAM bank $BF:1FFF",
+			args: args{
+				busAddr: 0xBF1FFF,
+			},
+			want: 0xF51FFF,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := BusAddressToPak(tt.args.busAddr); got != tt.want {
+				t.Errorf("BusAddressToPak() = 0x%06x, want 0x%06x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusAddressToPak_unmapped(t *testing.T) {
+	tests := []struct {
+		name    string
+		busAddr uint32
+	}{
+		{"I/O bank $00:4800", 0x004800},
+		{"bank $00:6000", 0x006000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := BusAddressToPak(tt.busAddr); err != util.ErrUnmappedAddress {
+				t.Errorf("BusAddressToPak() error = %v, want %v", err, util.ErrUnmappedAddress)
+			}
+		})
+	}
+}
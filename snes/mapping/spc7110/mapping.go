@@ -0,0 +1,75 @@
+package spc7110
+
+import "sni/snes/mapping/util"
+
+// https://wiki.superfamicom.org/spc7110
+// The first 1MiB of ROM is the program ROM, mapped HiROM-style in banks $00-$0F and linearly in banks $40-$4F and
+// $C0-$CF. The data ROM after it is read through three 1MiB windows at banks $D0-$DF, $E0-$EF and $F0-$FF
+// selected by the $4831-$4833 bank registers; this translation assumes their power-on values (0, 1, 2). The 8KiB
+// of SRAM is at $6000-$7FFF of banks $00-$3F and $80-$BF.
+
+const (
+	programSize = 0x100000
+	dataSize    = 0x300000
+	sramSize    = 0x2000
+)
+
+func BusAddressToPak(busAddr uint32) (pakAddr uint32, err error) {
+	if busAddr >= 0xD00000 && busAddr < 0x1_000000 {
+		// data ROM access:        $D0:0000-$FF:FFFF
+		rom := (busAddr - 0xD00000) + programSize
+		return rom, nil
+	} else if busAddr >= 0x7E0000 && busAddr < 0x800000 {
+		// WRAM access:
+		wram := (busAddr - 0x7E0000) + 0xF50000
+		return wram, nil
+	} else if (busAddr >= 0x400000 && busAddr < 0x500000) || (busAddr >= 0xC00000 && busAddr < 0xD00000) {
+		// program ROM access:     $40:0000-$4F:FFFF
+		//                         $C0:0000-$CF:FFFF
+		rom := busAddr & (programSize - 1)
+		return rom, nil
+	} else if busAddr < 0x400000 || (busAddr >= 0x800000 && busAddr < 0xC00000) {
+		offs := busAddr & 0xFFFF
+		if offs >= 0x8000 {
+			// program ROM access: $00:8000-$3F:FFFF
+			//                     $80:8000-$BF:FFFF
+			rom := busAddr & (programSize - 1)
+			return rom, nil
+		} else if offs >= 0x6000 {
+			// SRAM access:        $00:6000-$3F:7FFF
+			//                     $80:6000-$BF:7FFF
+			sram := (offs & (sramSize - 1)) + 0xE00000
+			return sram, nil
+		} else if offs < 0x2000 {
+			// Lower 8KiB of WRAM: $00:0000-$3F:1FFF
+			//                     $80:0000-$BF:1FFF
+			wram := (offs & 0x1FFF) + 0xF50000
+			return wram, nil
+		}
+	}
+	return 0, util.ErrUnmappedAddress
+}
+
+func PakAddressToBus(pakAddr uint32) (busAddr uint32, err error) {
+	if pakAddr >= 0xF50000 {
+		// WRAM is easy:
+		// mirror bank $F7..FF back down into WRAM because these banks in FX Pak Pro space
+		// are not available on the SNES bus; they are copies of otherwise inaccessible memory
+		// like VRAM, CGRAM, OAM, etc.:
+		busAddr = ((pakAddr - 0xF50000) & 0x01FFFF) + 0x7E0000
+		return
+	} else if pakAddr >= 0xE00000 && pakAddr < 0xE00000+sramSize {
+		// SRAM at $00:6000-7FFF:
+		busAddr = (pakAddr - 0xE00000) + 0x006000
+		return
+	} else if pakAddr < programSize {
+		// program ROM is linearly mapped to $C0:0000-$CF:FFFF:
+		busAddr = pakAddr + 0xC00000
+		return
+	} else if pakAddr < programSize+dataSize {
+		// data ROM is linearly mapped to $D0:0000-$FF:FFFF by the default bank registers:
+		busAddr = (pakAddr - programSize) + 0xD00000
+		return
+	}
+	return 0, util.ErrUnmappedAddress
+}
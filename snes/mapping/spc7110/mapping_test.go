@@ -0,0 +1,258 @@
+package spc7110
+
+import (
+	"sni/snes/mapping/util"
+	"testing"
+)
+
+func TestPakAddressToBus(t *testing.T) {
+	type args struct {
+		pakAddr uint32
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint32
+	}{
+		{
+			name: "ROM header",
+			args: args{
+				pakAddr: 0x00FFC0,
+			},
+			want: 0xC0FFC0,
+		},
+		{
+			name: "program ROM first byte",
+			args: args{
+				pakAddr: 0x000000,
+			},
+			want: 0xC00000,
+		},
+		{
+			name: "program ROM last byte",
+			args: args{
+				pakAddr: 0x0FFFFF,
+			},
+			want: 0xCFFFFF,
+		},
+		{
+			name: "data ROM first byte",
+			args: args{
+				pakAddr: 0x100000,
+			},
+			want: 0xD00000,
+		},
+		{
+			name: "data ROM last byte",
+			args: args{
+				pakAddr: 0x3FFFFF,
+			},
+			want: 0xFFFFFF,
+		},
+		{
+			name: "SRAM first byte",
+			args: args{
+				pakAddr: 0xE00000,
+			},
+			want: 0x006000,
+		},
+		{
+			name: "SRAM last byte",
+			args: args{
+				pakAddr: 0xE01FFF,
+			},
+			want: 0x007FFF,
+		},
+		{
+			name: "WRAM first byte",
+			args: args{
+				pakAddr: 0xF50000,
+			},
+			want: 0x7E0000,
+		},
+		{
+			name: "WRAM last byte",
+			args: args{
+				pakAddr: 0xF6FFFF,
+			},
+			want: 0x7FFFFF,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := PakAddressToBus(tt.args.pakAddr); got != tt.want {
+				t.Errorf("PakAddressToBus() = 0x%06x, want 0x%06x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusAddressToPak(t *testing.T) {
+	type args struct {
+		busAddr uint32
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint32
+	}{
+		{
+			name: "program ROM bank $00:8000",
+			args: args{
+				busAddr: 0x008000,
+			},
+			want: 0x008000,
+		},
+		{
+			name: "program ROM bank $00:FFC0",
+			args: args{
+				busAddr: 0x00FFC0,
+			},
+			want: 0x00FFC0,
+		},
+		{
+			name: "program ROM bank $0F:FFFF",
+			args: args{
+				busAddr: 0x0FFFFF,
+			},
+			want: 0x0FFFFF,
+		},
+		{
+			name: "program ROM bank $10:8000 mirror",
+			args: args{
+				busAddr: 0x108000,
+			},
+			want: 0x008000,
+		},
+		{
+			name: "program ROM bank $80:FFC0",
+			args: args{
+				busAddr: 0x80FFC0,
+			},
+			want: 0x00FFC0,
+		},
+		{
+			name: "program ROM bank $40:0000",
+			args: args{
+				busAddr: 0x400000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "program ROM bank $4F:FFFF",
+			args: args{
+				busAddr: 0x4FFFFF,
+			},
+			want: 0x0FFFFF,
+		},
+		{
+			name: "program ROM bank $C0:0000",
+			args: args{
+				busAddr: 0xC00000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "program ROM bank $CF:FFFF",
+			args: args{
+				busAddr: 0xCFFFFF,
+			},
+			want: 0x0FFFFF,
+		},
+		{
+			name: "data ROM bank $D0:0000",
+			args: args{
+				busAddr: 0xD00000,
+			},
+			want: 0x100000,
+		},
+		{
+			name: "data ROM bank $E0:0000",
+			args: args{
+				busAddr: 0xE00000,
+			},
+			want: 0x200000,
+		},
+		{
+			name: "data ROM bank $FF:FFFF",
+			args: args{
+				busAddr: 0xFFFFFF,
+			},
+			want: 0x3FFFFF,
+		},
+		{
+			name: "SRAM bank $00:6000",
+			args: args{
+				busAddr: 0x006000,
+			},
+			want: 0xE00000,
+		},
+		{
+			name: "SRAM bank $30:7FFF",
+			args: args{
+				busAddr: 0x307FFF,
+			},
+			want: 0xE01FFF,
+		},
+		{
+			name: "SRAM bank $80:6000",
+			args: args{
+				busAddr: 0x806000,
+			},
+			want: 0xE00000,
+		},
+		{
+			name: "WRAM bank $7E:0000",
+			args: args{
+				busAddr: 0x7E0000,
+			},
+			want: 0xF50000,
+		},
+		{
+			name: "WRAM bank $7F:FFFF",
+			args: args{
+				busAddr: 0x7FFFFF,
+			},
+			want: 0xF6FFFF,
+		},
+		{
+			name: "WRAM bank $00:1FFF",
+			args: args{
+				busAddr: 0x001FFF,
+			},
+			want: 0xF51FFF,
+		},
+		{
+			name: "WRAM bank $BF:0000",
+			args: args{
+				busAddr: 0xBF0000,
+			},
+			want: 0xF50000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := BusAddressToPak(tt.args.busAddr); got != tt.want {
+				t.Errorf("BusAddressToPak() = 0x%06x, want 0x%06x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusAddressToPak_unmapped(t *testing.T) {
+	tests := []struct {
+		name    string
+		busAddr uint32
+	}{
+		{"I/O bank $00:4800", 0x004800},
+		{"decompression bank $50:0000", 0x500000},
+		{"bank $70:0000", 0x700000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := BusAddressToPak(tt.busAddr); err != util.ErrUnmappedAddress {
+				t.Errorf("BusAddressToPak() error = %v, want %v", err, util.ErrUnmappedAddress)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package superfx
+
+import "sni/snes/mapping/util"
+
+// https://wiki.superfamicom.org/superfx
+// Up to 2MiB of ROM is mapped LoROM-style in banks $00-$3F and linearly in banks $40-$5F. The GSU's game pak RAM
+// is kept in the FX Pak Pro SRAM area; its first 8KiB is also visible at $6000-$7FFF of banks $00-$3F.
+
+const ramSize = 0x20000
+
+func BusAddressToPak(busAddr uint32) (pakAddr uint32, err error) {
+	// banks $80-$FF mirror banks $00-$7F except for WRAM:
+	if busAddr >= 0x800000 {
+		busAddr -= 0x800000
+	} else if busAddr >= 0x7E0000 {
+		// WRAM access:
+		wram := (busAddr - 0x7E0000) + 0xF50000
+		return wram, nil
+	}
+
+	if busAddr >= 0x700000 && busAddr < 0x700000+ramSize {
+		// game pak RAM access:    $70:0000-$71:FFFF
+		ram := (busAddr - 0x700000) + 0xE00000
+		return ram, nil
+	} else if busAddr >= 0x400000 && busAddr < 0x600000 {
+		// ROM access:             $40:0000-$5F:FFFF
+		rom := busAddr & 0x1FFFFF
+		return rom, nil
+	} else if busAddr < 0x400000 {
+		if busAddr&0x8000 != 0 {
+			// ROM access:         $00:8000-$3F:FFFF
+			rom := util.BankToLinear(busAddr & 0x3F7FFF)
+			return rom, nil
+		} else if busAddr&0xFFFF >= 0x6000 {
+			// game pak RAM access: $00:6000-$3F:7FFF
+			ram := (busAddr & 0x1FFF) + 0xE00000
+			return ram, nil
+		} else if busAddr&0xFFFF < 0x2000 {
+			// Lower 8KiB of WRAM: $00:0000-$3F:1FFF
+			wram := (busAddr & 0x1FFF) + 0xF50000
+			return wram, nil
+		}
+	}
+	return 0, util.ErrUnmappedAddress
+}
+
+func PakAddressToBus(pakAddr uint32) (busAddr uint32, err error) {
+	if pakAddr >= 0xF50000 {
+		// WRAM is easy:
+		// mirror bank $F7..FF back down into WRAM because these banks in FX Pak Pro space
+		// are not available on the SNES bus; they are copies of otherwise inaccessible memory
+		// like VRAM, CGRAM, OAM, etc.:
+		busAddr = ((pakAddr - 0xF50000) & 0x01FFFF) + 0x7E0000
+		return
+	} else if pakAddr >= 0xE00000 && pakAddr < 0xE00000+ramSize {
+		// game pak RAM is linearly mapped to $70:0000-$71:FFFF:
+		busAddr = (pakAddr - 0xE00000) + 0x700000
+		return
+	} else if pakAddr < 0xE00000 {
+		// ROM is linearly mapped to $40:0000-$5F:FFFF:
+		busAddr = (pakAddr & 0x1FFFFF) + 0x400000
+		return
+	}
+	return 0, util.ErrUnmappedAddress
+}
@@ -0,0 +1,238 @@
+package superfx
+
+import (
+	"sni/snes/mapping/util"
+	"testing"
+)
+
+func TestPakAddressToBus(t *testing.T) {
+	type args struct {
+		pakAddr uint32
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint32
+	}{
+		{
+			name: "ROM header",
+			args: args{
+				pakAddr: 0x007FC0,
+			},
+			want: 0x407FC0,
+		},
+		{
+			name: "ROM first byte",
+			args: args{
+				pakAddr: 0x000000,
+			},
+			want: 0x400000,
+		},
+		{
+			name: "ROM last byte",
+			args: args{
+				pakAddr: 0x1FFFFF,
+			},
+			want: 0x5FFFFF,
+		},
+		{
+			name: "ROM mirror",
+			args: args{
+				pakAddr: 0x200000,
+			},
+			want: 0x400000,
+		},
+		{
+			name: "game pak RAM first byte",
+			args: args{
+				pakAddr: 0xE00000,
+			},
+			want: 0x700000,
+		},
+		{
+			name: "game pak RAM last byte",
+			args: args{
+				pakAddr: 0xE1FFFF,
+			},
+			want: 0x71FFFF,
+		},
+		{
+			name: "WRAM first byte",
+			args: args{
+				pakAddr: 0xF50000,
+			},
+			want: 0x7E0000,
+		},
+		{
+			name: "WRAM last byte",
+			args: args{
+				pakAddr: 0xF6FFFF,
+			},
+			want: 0x7FFFFF,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := PakAddressToBus(tt.args.pakAddr); got != tt.want {
+				t.Errorf("PakAddressToBus() = 0x%06x, want 0x%06x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusAddressToPak(t *testing.T) {
+	type args struct {
+		busAddr uint32
+	}
+	tests := []struct {
+		name string
+		args args
+		want uint32
+	}{
+		{
+			name: "ROM bank $00:8000",
+			args: args{
+				busAddr: 0x008000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "ROM bank $00:FFC0",
+			args: args{
+				busAddr: 0x00FFC0,
+			},
+			want: 0x007FC0,
+		},
+		{
+			name: "ROM bank $3F:FFFF",
+			args: args{
+				busAddr: 0x3FFFFF,
+			},
+			want: 0x1FFFFF,
+		},
+		{
+			name: "ROM bank $80:8000",
+			args: args{
+				busAddr: 0x808000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "ROM bank $40:0000",
+			args: args{
+				busAddr: 0x400000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "ROM bank $40:FFC0",
+			args: args{
+				busAddr: 0x40FFC0,
+			},
+			want: 0x00FFC0,
+		},
+		{
+			name: "ROM bank $5F:FFFF",
+			args: args{
+				busAddr: 0x5FFFFF,
+			},
+			want: 0x1FFFFF,
+		},
+		{
+			name: "ROM bank $C0:0000",
+			args: args{
+				busAddr: 0xC00000,
+			},
+			want: 0x000000,
+		},
+		{
+			name: "game pak RAM bank $70:0000",
+			args: args{
+				busAddr: 0x700000,
+			},
+			want: 0xE00000,
+		},
+		{
+			name: "game pak RAM bank $71:FFFF",
+			args: args{
+				busAddr: 0x71FFFF,
+			},
+			want: 0xE1FFFF,
+		},
+		{
+			name: "game pak RAM bank $F0:1234",
+			args: args{
+				busAddr: 0xF01234,
+			},
+			want: 0xE01234,
+		},
+		{
+			name: "game pak RAM bank $00:6000",
+			args: args{
+				busAddr: 0x006000,
+			},
+			want: 0xE00000,
+		},
+		{
+			name: "game pak RAM bank $3F:7FFF",
+			args: args{
+				busAddr: 0x3F7FFF,
+			},
+			want: 0xE01FFF,
+		},
+		{
+			name: "WRAM bank $7E:0000",
+			args: args{
+				busAddr: 0x7E0000,
+			},
+			want: 0xF50000,
+		},
+		{
+			name: "WRAM bank $7F:FFFF",
+			args: args{
+				busAddr: 0x7FFFFF,
+			},
+			want: 0xF6FFFF,
+		},
+		{
+			name: "WRAM bank $00:1FFF",
+			args: args{
+				busAddr: 0x001FFF,
+			},
+			want: 0xF51FFF,
+		},
+		{
+			name: "WRAM bank $80:0000",
+			args: args{
+				busAddr: 0x800000,
+			},
+			want: 0xF50000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := BusAddressToPak(tt.args.busAddr); got != tt.want {
+				t.Errorf("BusAddressToPak() = 0x%06x, want 0x%06x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusAddressToPak_unmapped(t *testing.T) {
+	tests := []struct {
+		name    string
+		busAddr uint32
+	}{
+		{"I/O bank $00:3000", 0x003000},
+		{"bank $60:0000", 0x600000},
+		{"backup RAM bank $78:0000", 0x780000},
+		{"bank $FE:0000", 0xFE0000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := BusAddressToPak(tt.busAddr); err != util.ErrUnmappedAddress {
+				t.Errorf("BusAddressToPak() error = %v, want %v", err, util.ErrUnmappedAddress)
+			}
+		})
+	}
+}
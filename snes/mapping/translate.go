@@ -4,9 +4,15 @@ import (
 	"fmt"
 	"sni/protos/sni"
 	"sni/snes"
+	"sni/snes/mapping/bsx"
 	"sni/snes/mapping/exhirom"
+	"sni/snes/mapping/exlorom"
 	"sni/snes/mapping/hirom"
 	"sni/snes/mapping/lorom"
+	"sni/snes/mapping/sa1"
+	"sni/snes/mapping/sdd1"
+	"sni/snes/mapping/spc7110"
+	"sni/snes/mapping/superfx"
 )
 
 var ErrUnknownMapping = fmt.Errorf("cannot remap an address using an Unknown memory mapping; call MappingDetect to detect it from the ROM")
@@ -33,6 +39,18 @@ func TranslateAddress(
 				return hirom.PakAddressToBus(address)
 			case sni.MemoryMapping_ExHiROM:
 				return exhirom.PakAddressToBus(address)
+			case sni.MemoryMapping_ExLoROM:
+				return exlorom.PakAddressToBus(address)
+			case sni.MemoryMapping_SA1:
+				return sa1.PakAddressToBus(address)
+			case sni.MemoryMapping_BSX:
+				return bsx.PakAddressToBus(address)
+			case sni.MemoryMapping_SuperFX:
+				return superfx.PakAddressToBus(address)
+			case sni.MemoryMapping_SDD1:
+				return sdd1.PakAddressToBus(address)
+			case sni.MemoryMapping_SPC7110:
+				return spc7110.PakAddressToBus(address)
 			default:
 				return 0, ErrUnknownMapping
 			}
@@ -51,6 +69,18 @@ func TranslateAddress(
 				return hirom.BusAddressToPak(address)
 			case sni.MemoryMapping_ExHiROM:
 				return exhirom.BusAddressToPak(address)
+			case sni.MemoryMapping_ExLoROM:
+				return exlorom.BusAddressToPak(address)
+			case sni.MemoryMapping_SA1:
+				return sa1.BusAddressToPak(address)
+			case sni.MemoryMapping_BSX:
+				return bsx.BusAddressToPak(address)
+			case sni.MemoryMapping_SuperFX:
+				return superfx.BusAddressToPak(address)
+			case sni.MemoryMapping_SDD1:
+				return sdd1.BusAddressToPak(address)
+			case sni.MemoryMapping_SPC7110:
+				return spc7110.BusAddressToPak(address)
 			default:
 				return 0, ErrUnknownMapping
 			}
@@ -0,0 +1,283 @@
+package snes
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"log"
+	"sni/snes/timing"
+	"sync"
+	"time"
+)
+
+// MemoryDiff is a contiguous span of changed bytes within a watched memory segment.
+type MemoryDiff struct {
+	// Offset is relative to the start of the watched segment
+	Offset int
+	Data   []byte
+}
+
+// MemoryWatchChange describes the changes to a single watched memory segment since the last update
+// delivered to the subscriber.
+type MemoryWatchChange struct {
+	// Index into the subscription's reads of the segment that changed
+	Index    int
+	Response MemoryReadResponse
+	Diffs    []MemoryDiff
+}
+
+// MemoryWatchSubscription receives changes to a set of memory segments polled from a device.
+type MemoryWatchSubscription struct {
+	watcher *memoryWatcher
+
+	reads          []MemoryReadRequest
+	intervalFrames int
+
+	// last data delivered to the subscriber per read; only accessed by the watcher goroutine
+	last [][]byte
+
+	updates chan []MemoryWatchChange
+	err     error
+}
+
+// memoryWatcher polls a single device on behalf of all its subscribers.
+type memoryWatcher struct {
+	key    string
+	device AutoCloseableDevice
+
+	mu   sync.Mutex
+	subs map[*MemoryWatchSubscription]struct{}
+}
+
+// memoryDiffMergeGap is the largest run of unchanged bytes allowed to be folded into a surrounding diff
+// rather than splitting it in two:
+const memoryDiffMergeGap = 4
+
+var (
+	memoryWatchersMu sync.Mutex
+	memoryWatchers   = make(map[string]*memoryWatcher)
+)
+
+// WatchMemory subscribes to changes of the given memory segments on the device, polled every intervalFrames
+// SNES frames. All subscriptions to the same device share a single poll loop which issues one MultiReadMemory
+// call per frame for all subscribers due for an update. If that call fails with a non-fatal error then each
+// subscriber is polled separately so that only the subscriptions whose reads fail are ended. The first update for
+// each segment contains its full contents. Call Close when the subscription is no longer needed.
+func WatchMemory(device AutoCloseableDevice, intervalFrames int, reads ...MemoryReadRequest) *MemoryWatchSubscription {
+	if intervalFrames <= 0 {
+		intervalFrames = 1
+	}
+
+	key := uniqueDeviceKey(device)
+
+	memoryWatchersMu.Lock()
+	defer memoryWatchersMu.Unlock()
+
+	w, ok := memoryWatchers[key]
+	if !ok {
+		w = &memoryWatcher{
+			key:    key,
+			device: device,
+			subs:   make(map[*MemoryWatchSubscription]struct{}),
+		}
+		memoryWatchers[key] = w
+		go w.run()
+	}
+
+	return w.subscribe(intervalFrames, reads)
+}
+
+func (w *memoryWatcher) subscribe(intervalFrames int, reads []MemoryReadRequest) *MemoryWatchSubscription {
+	s := &MemoryWatchSubscription{
+		watcher:        w,
+		reads:          reads,
+		intervalFrames: intervalFrames,
+		last:           make([][]byte, len(reads)),
+		updates:        make(chan []MemoryWatchChange, 1),
+	}
+
+	w.mu.Lock()
+	w.subs[s] = struct{}{}
+	w.mu.Unlock()
+
+	return s
+}
+
+// Updates delivers the changed segments for each poll that observed a change. An update is skipped if the
+// subscriber has not yet received the previous one; the next update then contains all changes since the last
+// delivered one. The channel is closed when polling the device fails; see Err.
+func (s *MemoryWatchSubscription) Updates() <-chan []MemoryWatchChange {
+	return s.updates
+}
+
+// Err returns the error that ended the subscription once Updates is closed.
+func (s *MemoryWatchSubscription) Err() error {
+	return s.err
+}
+
+// Close unsubscribes from the watcher. The shared poll loop stops once it has no more subscribers.
+func (s *MemoryWatchSubscription) Close() {
+	s.watcher.mu.Lock()
+	delete(s.watcher.subs, s)
+	s.watcher.mu.Unlock()
+}
+
+func (w *memoryWatcher) run() {
+	ticker := time.NewTicker(timing.Frame)
+	defer ticker.Stop()
+
+	for frame := 0; ; frame++ {
+		<-ticker.C
+
+		if w.stopIfIdle() {
+			return
+		}
+
+		w.tick(frame)
+	}
+}
+
+// tick polls all subscribers due in the given frame.
+func (w *memoryWatcher) tick(frame int) {
+	w.mu.Lock()
+	due := make([]*MemoryWatchSubscription, 0, len(w.subs))
+	for s := range w.subs {
+		if frame%s.intervalFrames == 0 {
+			due = append(due, s)
+		}
+	}
+	w.mu.Unlock()
+
+	if len(due) == 0 {
+		return
+	}
+
+	w.poll(due)
+}
+
+// stopIfIdle removes the watcher from the registry if it has no subscribers left.
+func (w *memoryWatcher) stopIfIdle() bool {
+	memoryWatchersMu.Lock()
+	defer memoryWatchersMu.Unlock()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.subs) != 0 {
+		return false
+	}
+
+	delete(memoryWatchers, w.key)
+	return true
+}
+
+func (w *memoryWatcher) poll(due []*MemoryWatchSubscription) {
+	// issue all reads for all subscribers in a single request:
+	count := 0
+	for _, s := range due {
+		count += len(s.reads)
+	}
+	reads := make([]MemoryReadRequest, 0, count)
+	for _, s := range due {
+		reads = append(reads, s.reads...)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	rsps, err := w.device.MultiReadMemory(ctx, reads...)
+	cancel()
+	if err == nil && len(rsps) != len(reads) {
+		err = WithCode(codes.Internal, fmt.Errorf(
+			"watch: multi read must have equal number of responses and requests; actual %d expected %d",
+			len(rsps),
+			len(reads),
+		))
+	}
+	if err != nil && len(due) > 1 && !IsFatal(err) {
+		// one subscriber's bad read fails the whole batch so poll each subscriber on its own to only end the
+		// subscriptions that fail by themselves:
+		for _, s := range due {
+			w.poll([]*MemoryWatchSubscription{s})
+		}
+		return
+	}
+	if err != nil {
+		log.Printf("watch[%s]: %v\n", w.key, err)
+		w.fail(due, err)
+		return
+	}
+
+	for _, s := range due {
+		var changes []MemoryWatchChange
+		for i := range s.reads {
+			rsp := rsps[i]
+			if diffs := diffMemory(s.last[i], rsp.Data); len(diffs) > 0 {
+				changes = append(changes, MemoryWatchChange{
+					Index:    i,
+					Response: rsp,
+					Diffs:    diffs,
+				})
+			}
+		}
+		rsps = rsps[len(s.reads):]
+
+		if len(changes) == 0 {
+			continue
+		}
+
+		// skip this update if the subscriber has not consumed the last one yet:
+		select {
+		case s.updates <- changes:
+		default:
+			continue
+		}
+
+		for _, c := range changes {
+			s.last[c.Index] = append(s.last[c.Index][:0], c.Response.Data...)
+		}
+	}
+}
+
+// fail ends the given subscriptions with err.
+func (w *memoryWatcher) fail(due []*MemoryWatchSubscription, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, s := range due {
+		if _, ok := w.subs[s]; !ok {
+			continue
+		}
+		delete(w.subs, s)
+		s.err = err
+		close(s.updates)
+	}
+}
+
+// diffMemory returns the spans of curr that differ from prev. If prev is nil or of a different length then
+// the entire contents of curr are returned as a single span.
+func diffMemory(prev, curr []byte) (diffs []MemoryDiff) {
+	if prev == nil || len(prev) != len(curr) {
+		if len(curr) == 0 {
+			return nil
+		}
+		return []MemoryDiff{{Offset: 0, Data: curr}}
+	}
+
+	start, end := -1, -1
+	for i := range curr {
+		if prev[i] == curr[i] {
+			continue
+		}
+		if start >= 0 && i-end > memoryDiffMergeGap {
+			diffs = append(diffs, MemoryDiff{Offset: start, Data: curr[start:end]})
+			start = -1
+		}
+		if start < 0 {
+			start = i
+		}
+		end = i + 1
+	}
+	if start >= 0 {
+		diffs = append(diffs, MemoryDiff{Offset: start, Data: curr[start:end]})
+	}
+
+	return
+}
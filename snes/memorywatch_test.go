@@ -0,0 +1,213 @@
+package snes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func Test_diffMemory(t *testing.T) {
+	type args struct {
+		prev []byte
+		curr []byte
+	}
+	tests := []struct {
+		name string
+		args args
+		want []MemoryDiff
+	}{
+		{
+			name: "initial",
+			args: args{nil, []byte{1, 2, 3}},
+			want: []MemoryDiff{{Offset: 0, Data: []byte{1, 2, 3}}},
+		},
+		{
+			name: "unchanged",
+			args: args{[]byte{1, 2, 3}, []byte{1, 2, 3}},
+			want: nil,
+		},
+		{
+			name: "single byte",
+			args: args{[]byte{1, 2, 3}, []byte{1, 9, 3}},
+			want: []MemoryDiff{{Offset: 1, Data: []byte{9}}},
+		},
+		{
+			name: "merge small gap",
+			args: args{[]byte{0, 0, 0, 0, 0, 0}, []byte{1, 0, 0, 0, 1, 0}},
+			want: []MemoryDiff{{Offset: 0, Data: []byte{1, 0, 0, 0, 1}}},
+		},
+		{
+			name: "split large gap",
+			args: args{make([]byte, 12), []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}},
+			want: []MemoryDiff{{Offset: 0, Data: []byte{1}}, {Offset: 11, Data: []byte{1}}},
+		},
+		{
+			name: "size changed",
+			args: args{[]byte{1, 2}, []byte{1, 2, 3}},
+			want: []MemoryDiff{{Offset: 0, Data: []byte{1, 2, 3}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diffMemory(tt.args.prev, tt.args.curr); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffMemory() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// watchDevice serves reads from address-derived data, records each MultiReadMemory call and fails any call that
+// reads the bad address
+type watchDevice struct {
+	AutoCloseableDevice
+
+	mu    sync.Mutex
+	calls [][]MemoryReadRequest
+	bad   uint32
+	fatal bool
+}
+
+func (d *watchDevice) MultiReadMemory(ctx context.Context, reads ...MemoryReadRequest) (rsp []MemoryReadResponse, err error) {
+	d.mu.Lock()
+	d.calls = append(d.calls, reads)
+	d.mu.Unlock()
+
+	for _, r := range reads {
+		if r.RequestAddress.Address == d.bad {
+			err = fmt.Errorf("unmapped address $%06x", d.bad)
+			if d.fatal {
+				return nil, DeviceFatal(err.Error(), err)
+			}
+			return nil, DeviceNonFatal(err.Error(), err)
+		}
+
+		data := make([]byte, r.Size)
+		for i := range data {
+			data[i] = byte(r.RequestAddress.Address + uint32(i))
+		}
+		rsp = append(rsp, MemoryReadResponse{RequestAddress: r.RequestAddress, DeviceAddress: r.RequestAddress, Data: data})
+	}
+	return
+}
+
+func (d *watchDevice) lastCall() []MemoryReadRequest {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.calls) == 0 {
+		return nil
+	}
+	return d.calls[len(d.calls)-1]
+}
+
+func newTestWatcher(d *watchDevice) *memoryWatcher {
+	return &memoryWatcher{
+		key:    "test",
+		device: d,
+		subs:   make(map[*MemoryWatchSubscription]struct{}),
+	}
+}
+
+// expectUpdate receives an update for the subscription and checks the first byte of each changed segment
+func expectUpdate(t *testing.T, s *MemoryWatchSubscription, first ...byte) {
+	t.Helper()
+	select {
+	case changes, ok := <-s.Updates():
+		if !ok {
+			t.Fatalf("expected update; subscription ended with %v", s.Err())
+		}
+		if len(changes) != len(first) {
+			t.Fatalf("expected %d changes; got %d", len(first), len(changes))
+		}
+		for i, c := range changes {
+			if c.Index != i || c.Response.Data[0] != first[i] {
+				t.Fatalf("unexpected change[%d] %+v", i, c)
+			}
+		}
+	default:
+		t.Fatal("expected update")
+	}
+}
+
+func TestMemoryWatcher_coalesce(t *testing.T) {
+	d := &watchDevice{}
+	w := newTestWatcher(d)
+	a := w.subscribe(1, []MemoryReadRequest{wram(0xF50010, 4), wram(0xF50020, 4)})
+	b := w.subscribe(1, []MemoryReadRequest{wram(0xF50030, 2)})
+
+	w.tick(0)
+	if len(d.calls) != 1 || len(d.calls[0]) != 3 {
+		t.Fatalf("expected a single call with 3 reads; got %v", d.calls)
+	}
+	expectUpdate(t, a, 0x10, 0x20)
+	expectUpdate(t, b, 0x30)
+
+	// unchanged memory delivers no updates:
+	w.tick(1)
+	select {
+	case changes := <-a.Updates():
+		t.Fatalf("unexpected update %v", changes)
+	case changes := <-b.Updates():
+		t.Fatalf("unexpected update %v", changes)
+	default:
+	}
+}
+
+func TestMemoryWatcher_interval(t *testing.T) {
+	d := &watchDevice{}
+	w := newTestWatcher(d)
+	w.subscribe(1, []MemoryReadRequest{wram(0xF50010, 1)})
+	w.subscribe(3, []MemoryReadRequest{wram(0xF50020, 1)})
+
+	expected := map[int]int{0: 2, 1: 1, 2: 1, 3: 2, 4: 1}
+	for frame := 0; frame <= 4; frame++ {
+		w.tick(frame)
+		if actual := len(d.lastCall()); actual != expected[frame] {
+			t.Fatalf("frame %d: expected %d reads; got %d", frame, expected[frame], actual)
+		}
+	}
+	if len(d.calls) != 5 {
+		t.Fatalf("expected 5 calls; got %d", len(d.calls))
+	}
+}
+
+func TestMemoryWatcher_failureIsolation(t *testing.T) {
+	d := &watchDevice{bad: 0xF60000}
+	w := newTestWatcher(d)
+	good := w.subscribe(1, []MemoryReadRequest{wram(0xF50010, 4)})
+	bad := w.subscribe(1, []MemoryReadRequest{wram(0xF50020, 4), wram(0xF60000, 4)})
+
+	w.tick(0)
+	expectUpdate(t, good, 0x10)
+	if _, ok := <-bad.Updates(); ok || bad.Err() == nil {
+		t.Fatal("expected bad subscription to end with an error")
+	}
+	if _, ok := w.subs[good]; !ok {
+		t.Fatal("expected good subscription to remain")
+	}
+
+	// the next poll only reads the remaining subscription:
+	w.tick(1)
+	if actual := d.lastCall(); len(actual) != 1 || actual[0].RequestAddress.Address != 0xF50010 {
+		t.Fatalf("unexpected reads %v", actual)
+	}
+}
+
+func TestMemoryWatcher_fatal(t *testing.T) {
+	d := &watchDevice{bad: 0xF60000, fatal: true}
+	w := newTestWatcher(d)
+	a := w.subscribe(1, []MemoryReadRequest{wram(0xF50010, 4)})
+	b := w.subscribe(1, []MemoryReadRequest{wram(0xF60000, 4)})
+
+	// a fatal error means the device is gone so every subscription ends:
+	w.tick(0)
+	for _, s := range []*MemoryWatchSubscription{a, b} {
+		if _, ok := <-s.Updates(); ok || !IsFatal(s.Err()) {
+			t.Fatalf("expected subscription to end with a fatal error; got %v", s.Err())
+		}
+	}
+	if len(d.calls) != 1 {
+		t.Fatalf("expected 1 call; got %d", len(d.calls))
+	}
+}
@@ -0,0 +1,64 @@
+package snes
+
+import (
+	"sni/util/metrics"
+	"strconv"
+)
+
+var (
+	deviceReadBytes = metrics.NewCounterVec(
+		"sni_device_read_bytes_total",
+		"Bytes read from device memory.",
+		"driver",
+	)
+	deviceWriteBytes = metrics.NewCounterVec(
+		"sni_device_write_bytes_total",
+		"Bytes written to device memory.",
+		"driver",
+	)
+	deviceErrors = metrics.NewCounterVec(
+		"sni_device_errors_total",
+		"Device errors returned by drivers; fatal errors close the device.",
+		"driver", "fatal",
+	)
+	deviceOpens = metrics.NewCounterVec(
+		"sni_device_opens_total",
+		"Devices opened.",
+		"driver",
+	)
+	deviceCloses = metrics.NewCounterVec(
+		"sni_device_closes_total",
+		"Devices closed.",
+		"driver",
+	)
+	devicesOpen = metrics.NewGaugeVec(
+		"sni_devices_open",
+		"Devices currently open.",
+		"driver",
+	)
+)
+
+// countDeviceError counts err by driver and severity if it is a DeviceError
+func countDeviceError(driverName string, err error) {
+	derr, ok := err.(DeviceError)
+	if !ok {
+		return
+	}
+	deviceErrors.Inc(driverName, strconv.FormatBool(derr.IsFatal()))
+}
+
+func countReadBytes(driverName string, rsp []MemoryReadResponse) {
+	n := 0
+	for i := range rsp {
+		n += len(rsp[i].Data)
+	}
+	deviceReadBytes.Add(float64(n), driverName)
+}
+
+func countWriteBytes(driverName string, writes []MemoryWriteRequest) {
+	n := 0
+	for i := range writes {
+		n += len(writes[i].Data)
+	}
+	deviceWriteBytes.Add(float64(n), driverName)
+}
@@ -0,0 +1,148 @@
+package patch
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+)
+
+const bpsMagic = "BPS1"
+
+const (
+	bpsSourceRead = iota
+	bpsTargetRead
+	bpsSourceCopy
+	bpsTargetCopy
+)
+
+// BPS is a beat patch. Its source, target and patch CRC32 checksums are validated.
+type BPS struct {
+	SourceSize uint64
+	TargetSize uint64
+	Metadata   []byte
+
+	SourceCRC32 uint32
+	TargetCRC32 uint32
+
+	// actions is the encoded action stream between the metadata and the footer
+	actions []byte
+}
+
+func (p *BPS) Format() Format { return FormatBPS }
+
+// ParseBPS parses a BPS patch and validates its patch checksum.
+func ParseBPS(data []byte) (p *BPS, err error) {
+	if len(data) < len(bpsMagic)+12 || string(data[:len(bpsMagic)]) != bpsMagic {
+		return nil, fmt.Errorf("patch: missing BPS header")
+	}
+
+	footer := data[len(data)-12:]
+	if crc32.ChecksumIEEE(data[:len(data)-4]) != binary.LittleEndian.Uint32(footer[8:]) {
+		return nil, ErrPatchChecksum
+	}
+	body := data[:len(data)-12]
+
+	p = &BPS{
+		SourceCRC32: binary.LittleEndian.Uint32(footer[0:]),
+		TargetCRC32: binary.LittleEndian.Uint32(footer[4:]),
+	}
+
+	i := len(bpsMagic)
+	if p.SourceSize, err = readVarint(body, &i); err != nil {
+		return nil, err
+	}
+	if p.TargetSize, err = readVarint(body, &i); err != nil {
+		return nil, err
+	}
+	if p.SourceSize > MaxSize || p.TargetSize > MaxSize {
+		return nil, ErrTooLarge
+	}
+	var metadataSize uint64
+	if metadataSize, err = readVarint(body, &i); err != nil {
+		return nil, err
+	}
+	if metadataSize > uint64(len(body)-i) {
+		return nil, ErrTruncated
+	}
+	p.Metadata = body[i : i+int(metadataSize)]
+	i += int(metadataSize)
+
+	p.actions = body[i:]
+	return
+}
+
+// Apply validates the source checksum, runs the actions and validates the target checksum.
+func (p *BPS) Apply(source []byte) (target []byte, err error) {
+	if uint64(len(source)) != p.SourceSize || crc32.ChecksumIEEE(source) != p.SourceCRC32 {
+		return nil, ErrSourceChecksum
+	}
+
+	target = make([]byte, p.TargetSize)
+	outputOffset := uint64(0)
+	sourceRelativeOffset := int64(0)
+	targetRelativeOffset := int64(0)
+
+	a := p.actions
+	i := 0
+	for i < len(a) {
+		var v uint64
+		if v, err = readVarint(a, &i); err != nil {
+			return nil, err
+		}
+		length := (v >> 2) + 1
+		if outputOffset+length > p.TargetSize {
+			return nil, fmt.Errorf("patch: BPS action writes past end of target")
+		}
+
+		switch v & 3 {
+		case bpsSourceRead:
+			if outputOffset+length > uint64(len(source)) {
+				return nil, fmt.Errorf("patch: BPS source read past end of source")
+			}
+			copy(target[outputOffset:outputOffset+length], source[outputOffset:])
+			outputOffset += length
+		case bpsTargetRead:
+			if uint64(len(a)-i) < length {
+				return nil, ErrTruncated
+			}
+			copy(target[outputOffset:outputOffset+length], a[i:])
+			i += int(length)
+			outputOffset += length
+		case bpsSourceCopy, bpsTargetCopy:
+			var d uint64
+			if d, err = readVarint(a, &i); err != nil {
+				return nil, err
+			}
+			delta := int64(d >> 1)
+			if d&1 != 0 {
+				delta = -delta
+			}
+
+			if v&3 == bpsSourceCopy {
+				sourceRelativeOffset += delta
+				if sourceRelativeOffset < 0 || uint64(sourceRelativeOffset)+length > uint64(len(source)) {
+					return nil, fmt.Errorf("patch: BPS source copy out of range")
+				}
+				copy(target[outputOffset:outputOffset+length], source[sourceRelativeOffset:])
+				sourceRelativeOffset += int64(length)
+				outputOffset += length
+			} else {
+				targetRelativeOffset += delta
+				if targetRelativeOffset < 0 || uint64(targetRelativeOffset) >= outputOffset {
+					return nil, fmt.Errorf("patch: BPS target copy out of range")
+				}
+				// copy byte by byte since the ranges may overlap to repeat a pattern:
+				for ; length > 0; length-- {
+					target[outputOffset] = target[targetRelativeOffset]
+					outputOffset++
+					targetRelativeOffset++
+				}
+			}
+		}
+	}
+
+	if outputOffset != p.TargetSize || crc32.ChecksumIEEE(target) != p.TargetCRC32 {
+		return nil, ErrTargetChecksum
+	}
+	return
+}
@@ -0,0 +1,126 @@
+package patch
+
+import (
+	"fmt"
+	"sni/protos/sni"
+	"sni/snes"
+)
+
+const (
+	ipsMagic = "PATCH"
+	ipsEOF   = 0x454F46 // "EOF"
+)
+
+// IPSRecord replaces the contents at Offset with Data; RLE records are expanded
+type IPSRecord struct {
+	Offset uint32
+	Data   []byte
+}
+
+// IPS is a list of records to write in order. IPS has no checksums.
+type IPS struct {
+	Records []IPSRecord
+
+	// Truncate is the size to truncate the target to if HasTruncate is set
+	Truncate    uint32
+	HasTruncate bool
+}
+
+func (p *IPS) Format() Format { return FormatIPS }
+
+// ParseIPS parses an IPS patch.
+func ParseIPS(data []byte) (p *IPS, err error) {
+	if len(data) < len(ipsMagic) || string(data[:len(ipsMagic)]) != ipsMagic {
+		return nil, fmt.Errorf("patch: missing IPS header")
+	}
+
+	p = &IPS{}
+	i := len(ipsMagic)
+	for {
+		if i+3 > len(data) {
+			return nil, ErrTruncated
+		}
+		offset := uint32(data[i])<<16 | uint32(data[i+1])<<8 | uint32(data[i+2])
+		i += 3
+		if offset == ipsEOF {
+			break
+		}
+
+		if i+2 > len(data) {
+			return nil, ErrTruncated
+		}
+		size := int(data[i])<<8 | int(data[i+1])
+		i += 2
+
+		if size == 0 {
+			// RLE record repeats a single byte:
+			if i+3 > len(data) {
+				return nil, ErrTruncated
+			}
+			count := int(data[i])<<8 | int(data[i+1])
+			value := data[i+2]
+			i += 3
+
+			rle := make([]byte, count)
+			for j := range rle {
+				rle[j] = value
+			}
+			p.Records = append(p.Records, IPSRecord{Offset: offset, Data: rle})
+			continue
+		}
+
+		if i+size > len(data) {
+			return nil, ErrTruncated
+		}
+		p.Records = append(p.Records, IPSRecord{Offset: offset, Data: data[i : i+size]})
+		i += size
+	}
+
+	// optional truncation extension:
+	if len(data)-i >= 3 {
+		p.Truncate = uint32(data[i])<<16 | uint32(data[i+1])<<8 | uint32(data[i+2])
+		p.HasTruncate = true
+	}
+	return
+}
+
+// Apply writes the records over a copy of source, growing it with zeros if records write past its end.
+func (p *IPS) Apply(source []byte) (target []byte, err error) {
+	size := uint32(len(source))
+	for _, r := range p.Records {
+		if end := r.Offset + uint32(len(r.Data)); end > size {
+			size = end
+		}
+	}
+
+	target = make([]byte, size)
+	copy(target, source)
+	for _, r := range p.Records {
+		copy(target[r.Offset:], r.Data)
+	}
+
+	if p.HasTruncate && p.Truncate < uint32(len(target)) {
+		target = target[:p.Truncate]
+	}
+	return
+}
+
+// MemoryWriteRequests converts the records to memory writes with offsets interpreted as addresses in the given
+// address space; this is how usb2snes applies IPS patches to memory. Truncation is ignored.
+func (p *IPS) MemoryWriteRequests(addressSpace sni.AddressSpace, memoryMapping sni.MemoryMapping) []snes.MemoryWriteRequest {
+	writes := make([]snes.MemoryWriteRequest, 0, len(p.Records))
+	for _, r := range p.Records {
+		if len(r.Data) == 0 {
+			continue
+		}
+		writes = append(writes, snes.MemoryWriteRequest{
+			RequestAddress: snes.AddressTuple{
+				Address:       r.Offset,
+				AddressSpace:  addressSpace,
+				MemoryMapping: memoryMapping,
+			},
+			Data: r.Data,
+		})
+	}
+	return writes
+}
@@ -0,0 +1,113 @@
+// Package patch parses and applies IPS, BPS and UPS patches to ROM contents.
+package patch
+
+import (
+	"bytes"
+	"fmt"
+	"sni/snes"
+)
+
+// Format identifies a patch file format
+type Format int
+
+const (
+	FormatIPS Format = iota
+	FormatBPS
+	FormatUPS
+)
+
+func (f Format) String() string {
+	switch f {
+	case FormatIPS:
+		return "IPS"
+	case FormatBPS:
+		return "BPS"
+	case FormatUPS:
+		return "UPS"
+	default:
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+}
+
+// MaxSize is the largest source or target size a BPS or UPS patch may declare; it is the size of the FX Pak Pro's
+// ROM space. Sizes are read from the patch data so they are limited before any memory is allocated for them.
+const MaxSize = 16 * 1024 * 1024
+
+var (
+	ErrUnknownFormat  = fmt.Errorf("patch: unrecognized patch format")
+	ErrTruncated      = fmt.Errorf("patch: unexpected end of patch data")
+	ErrPatchChecksum  = fmt.Errorf("patch: patch checksum mismatch")
+	ErrSourceChecksum = fmt.Errorf("patch: source checksum mismatch; the patch does not apply to this ROM")
+	ErrTargetChecksum = fmt.Errorf("patch: target checksum mismatch")
+	ErrTooLarge       = fmt.Errorf("patch: declared size exceeds %d bytes", MaxSize)
+)
+
+// Patch transforms source contents into target contents
+type Patch interface {
+	Format() Format
+	// Apply returns the patched copy of source; source is not modified
+	Apply(source []byte) (target []byte, err error)
+}
+
+// Parse detects the format of the patch data from its magic bytes and parses it.
+func Parse(data []byte) (Patch, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte(ipsMagic)):
+		return ParseIPS(data)
+	case bytes.HasPrefix(data, []byte(bpsMagic)):
+		return ParseBPS(data)
+	case bytes.HasPrefix(data, []byte(upsMagic)):
+		return ParseUPS(data)
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+// Apply applies the patches in order to contents and returns the patched copy.
+func Apply(contents []byte, patches ...Patch) (patched []byte, err error) {
+	patched = contents
+	for i, p := range patches {
+		patched, err = p.Apply(patched)
+		if err != nil {
+			return nil, fmt.Errorf("patch[%d] (%s): %w", i, p.Format(), err)
+		}
+	}
+	return
+}
+
+// ApplyROM applies the patches in order to the ROM contents and re-reads its header.
+func ApplyROM(rom *snes.ROM, patches ...Patch) (err error) {
+	var patched []byte
+	patched, err = Apply(rom.Contents, patches...)
+	if err != nil {
+		return
+	}
+	if uint32(len(patched)) < rom.HeaderOffset+0x50 {
+		return fmt.Errorf("patch: patched ROM not big enough to contain SNES header")
+	}
+
+	rom.Contents = patched
+	return rom.ReadHeader()
+}
+
+// readVarint decodes the variable-length integers used by BPS and UPS where each byte holds 7 bits and every
+// continuation adds an implicit offset so that each value has exactly one encoding.
+func readVarint(data []byte, p *int) (v uint64, err error) {
+	shift := uint64(1)
+	for {
+		if *p >= len(data) {
+			return 0, ErrTruncated
+		}
+		x := data[*p]
+		*p++
+		v += uint64(x&0x7F) * shift
+		if x&0x80 != 0 {
+			return
+		}
+		if shift > 1<<56 {
+			return 0, fmt.Errorf("patch: variable-length integer overflow")
+		}
+		shift <<= 7
+		v += shift
+	}
+}
@@ -0,0 +1,307 @@
+package patch
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"sni/protos/sni"
+	"sni/snes"
+	"testing"
+)
+
+func testSource() []byte {
+	source := make([]byte, 0x10000)
+	for i := range source {
+		source[i] = byte(i * 7)
+	}
+	copy(source[0x7FC0:], "THE LEGEND OF ZELDA  ")
+	return source
+}
+
+func writeVarint(b *bytes.Buffer, v uint64) {
+	for {
+		x := byte(v & 0x7F)
+		v >>= 7
+		if v == 0 {
+			b.WriteByte(0x80 | x)
+			return
+		}
+		b.WriteByte(x)
+		v--
+	}
+}
+
+// appendFooter appends the source, target and patch checksums used by BPS and UPS
+func appendFooter(b *bytes.Buffer, source, target []byte) []byte {
+	_ = binary.Write(b, binary.LittleEndian, crc32.ChecksumIEEE(source))
+	_ = binary.Write(b, binary.LittleEndian, crc32.ChecksumIEEE(target))
+	_ = binary.Write(b, binary.LittleEndian, crc32.ChecksumIEEE(b.Bytes()))
+	return b.Bytes()
+}
+
+// makeUPS diffs source and target into a UPS patch
+func makeUPS(source, target []byte) []byte {
+	b := &bytes.Buffer{}
+	b.WriteString(upsMagic)
+	writeVarint(b, uint64(len(source)))
+	writeVarint(b, uint64(len(target)))
+
+	at := func(d []byte, i int) byte {
+		if i < len(d) {
+			return d[i]
+		}
+		return 0
+	}
+	size := len(source)
+	if len(target) > size {
+		size = len(target)
+	}
+	last := 0
+	for i := 0; i < size; i++ {
+		if at(source, i) == at(target, i) {
+			continue
+		}
+		writeVarint(b, uint64(i-last))
+		for ; i < size && at(source, i) != at(target, i); i++ {
+			b.WriteByte(at(source, i) ^ at(target, i))
+		}
+		b.WriteByte(0)
+		last = i + 1
+	}
+	return appendFooter(b, source, target)
+}
+
+func TestVarint(t *testing.T) {
+	for _, v := range []uint64{0, 1, 0x7F, 0x80, 0x407F, 0x4080, 0xFFFFFF, 1 << 40} {
+		b := &bytes.Buffer{}
+		writeVarint(b, v)
+		i := 0
+		actual, err := readVarint(b.Bytes(), &i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != v || i != b.Len() {
+			t.Fatalf("expected %#x; got %#x", v, actual)
+		}
+	}
+
+	i := 0
+	if _, err := readVarint([]byte{0x00, 0x01}, &i); err != ErrTruncated {
+		t.Fatalf("expected ErrTruncated; got %v", err)
+	}
+}
+
+func TestIPS(t *testing.T) {
+	source := testSource()
+	ips := []byte(ipsMagic)
+	// record: $000100 <- 01 02 03
+	ips = append(ips, 0x00, 0x01, 0x00, 0x00, 0x03, 0x01, 0x02, 0x03)
+	// RLE record: $000200 <- 4 x $AA
+	ips = append(ips, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x04, 0xAA)
+	// record past the end of the source grows the target:
+	ips = append(ips, 0x01, 0x00, 0x00, 0x00, 0x02, 0x55, 0x66)
+	ips = append(ips, "EOF"...)
+
+	p, err := Parse(ips)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Format() != FormatIPS {
+		t.Fatalf("expected IPS; got %v", p.Format())
+	}
+
+	target, err := p.Apply(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(target) != 0x10002 {
+		t.Fatalf("expected size $10002; got $%x", len(target))
+	}
+	if !bytes.Equal(target[0x100:0x103], []byte{1, 2, 3}) ||
+		!bytes.Equal(target[0x200:0x204], []byte{0xAA, 0xAA, 0xAA, 0xAA}) ||
+		!bytes.Equal(target[0x10000:], []byte{0x55, 0x66}) {
+		t.Fatal("records not applied")
+	}
+	if target[0x103] != source[0x103] || source[0x100] != 0x00 {
+		t.Fatal("unexpected modification")
+	}
+
+	// truncation extension:
+	p, err = ParseIPS(append(ips, 0x00, 0x80, 0x00))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target, err = p.Apply(source); err != nil {
+		t.Fatal(err)
+	}
+	if len(target) != 0x8000 {
+		t.Fatalf("expected truncation to $8000; got $%x", len(target))
+	}
+
+	writes := p.(*IPS).MemoryWriteRequests(sni.AddressSpace_FxPakPro, sni.MemoryMapping_LoROM)
+	if len(writes) != 3 || writes[1].RequestAddress.Address != 0x200 || len(writes[1].Data) != 4 {
+		t.Fatalf("unexpected writes: %v", writes)
+	}
+
+	if _, err = ParseIPS(ips[:len(ips)-4]); err != ErrTruncated {
+		t.Fatalf("expected ErrTruncated; got %v", err)
+	}
+}
+
+func TestBPS(t *testing.T) {
+	source := testSource()
+	target := make([]byte, 0x10010)
+	copy(target, source[:0x8000])
+	copy(target[0x8000:], []byte("NEW!"))
+	copy(target[0x8004:], source[0x9000:0x9100])
+	// repeated pattern made by copying from the target itself:
+	for i := 0x8104; i < len(target); i++ {
+		target[i] = target[i-4]
+	}
+
+	b := &bytes.Buffer{}
+	b.WriteString(bpsMagic)
+	writeVarint(b, uint64(len(source)))
+	writeVarint(b, uint64(len(target)))
+	writeVarint(b, 4)
+	b.WriteString("meta")
+	// SourceRead $8000 bytes:
+	writeVarint(b, (0x8000-1)<<2|bpsSourceRead)
+	// TargetRead "NEW!":
+	writeVarint(b, (4-1)<<2|bpsTargetRead)
+	b.WriteString("NEW!")
+	// SourceCopy $100 bytes from $9000:
+	writeVarint(b, (0x100-1)<<2|bpsSourceCopy)
+	writeVarint(b, 0x9000<<1)
+	// TargetCopy the rest from $8100 overlapping:
+	writeVarint(b, uint64(len(target)-0x8104-1)<<2|bpsTargetCopy)
+	writeVarint(b, 0x8100<<1)
+	bps := appendFooter(b, source, target)
+
+	p, err := Parse(bps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Format() != FormatBPS || string(p.(*BPS).Metadata) != "meta" {
+		t.Fatalf("unexpected patch %v", p.Format())
+	}
+
+	actual, err := p.Apply(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(actual, target) {
+		t.Fatal("target mismatch")
+	}
+
+	// checksums are validated:
+	other := testSource()
+	other[0] ^= 0xFF
+	if _, err = p.Apply(other); err != ErrSourceChecksum {
+		t.Fatalf("expected ErrSourceChecksum; got %v", err)
+	}
+	corrupt := append([]byte(nil), bps...)
+	corrupt[len(bpsMagic)+8] ^= 0xFF
+	if _, err = ParseBPS(corrupt); err != ErrPatchChecksum {
+		t.Fatalf("expected ErrPatchChecksum; got %v", err)
+	}
+}
+
+func TestUPS(t *testing.T) {
+	source := testSource()
+	target := append(append([]byte(nil), source...), 0x11, 0x22)
+	copy(target[0x1234:], "changed")
+	target[0xFFFF] ^= 0x01
+
+	ups := makeUPS(source, target)
+	p, err := Parse(ups)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Format() != FormatUPS {
+		t.Fatalf("expected UPS; got %v", p.Format())
+	}
+
+	actual, err := p.Apply(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(actual, target) {
+		t.Fatal("target mismatch")
+	}
+
+	// the patch also reverts the target:
+	actual, err = p.Apply(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(actual, source) {
+		t.Fatal("source mismatch")
+	}
+
+	if _, err = p.Apply(source[:0x8000]); err != ErrSourceChecksum {
+		t.Fatalf("expected ErrSourceChecksum; got %v", err)
+	}
+}
+
+func TestParse_tooLarge(t *testing.T) {
+	source := testSource()
+	for _, magic := range []string{bpsMagic, upsMagic} {
+		b := &bytes.Buffer{}
+		b.WriteString(magic)
+		writeVarint(b, uint64(len(source)))
+		writeVarint(b, 1<<60)
+		writeVarint(b, 0)
+		data := appendFooter(b, source, source)
+
+		if _, err := Parse(data); err != ErrTooLarge {
+			t.Fatalf("%s: expected ErrTooLarge; got %v", magic, err)
+		}
+	}
+}
+
+func TestApplyROM(t *testing.T) {
+	source := testSource()
+	rom, err := snes.NewROM("test.sfc", source)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// patch the title with IPS then the first byte with UPS:
+	ips := append([]byte(ipsMagic), 0x00, 0x7F, 0xC0, 0x00, 0x03)
+	ips = append(ips, "NEW"...)
+	ips = append(ips, "EOF"...)
+	ipsPatch, err := Parse(ips)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intermediate, _ := ipsPatch.Apply(source)
+	final := append([]byte(nil), intermediate...)
+	final[0] = 0x42
+	upsPatch, err := Parse(makeUPS(intermediate, final))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = ApplyROM(rom, ipsPatch, upsPatch); err != nil {
+		t.Fatal(err)
+	}
+	if string(rom.Header.Title[:10]) != "NEW LEGEND" || rom.Contents[0] != 0x42 {
+		t.Fatalf("unexpected ROM: %q", rom.Header.Title[:])
+	}
+
+	// the patches apply in order so reversing them fails:
+	rom, _ = snes.NewROM("test.sfc", testSource())
+	err = ApplyROM(rom, upsPatch, ipsPatch)
+	if !errors.Is(err, ErrSourceChecksum) {
+		t.Fatalf("expected ErrSourceChecksum; got %v", err)
+	}
+	if rom.Contents[0] != source[0] {
+		t.Fatal("ROM modified by failed patch")
+	}
+
+	if _, err = Parse([]byte("garbage")); err != ErrUnknownFormat {
+		t.Fatalf("expected ErrUnknownFormat; got %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package patch
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+)
+
+const upsMagic = "UPS1"
+
+// UPS is a list of XOR hunks. Its source, target and patch CRC32 checksums are validated. Since XOR is reversible,
+// a UPS patch also applies to its target to restore the source.
+type UPS struct {
+	SourceSize uint64
+	TargetSize uint64
+
+	SourceCRC32 uint32
+	TargetCRC32 uint32
+
+	// hunks is the encoded hunk stream between the sizes and the footer
+	hunks []byte
+}
+
+func (p *UPS) Format() Format { return FormatUPS }
+
+// ParseUPS parses a UPS patch and validates its patch checksum.
+func ParseUPS(data []byte) (p *UPS, err error) {
+	if len(data) < len(upsMagic)+12 || string(data[:len(upsMagic)]) != upsMagic {
+		return nil, fmt.Errorf("patch: missing UPS header")
+	}
+
+	footer := data[len(data)-12:]
+	if crc32.ChecksumIEEE(data[:len(data)-4]) != binary.LittleEndian.Uint32(footer[8:]) {
+		return nil, ErrPatchChecksum
+	}
+	body := data[:len(data)-12]
+
+	p = &UPS{
+		SourceCRC32: binary.LittleEndian.Uint32(footer[0:]),
+		TargetCRC32: binary.LittleEndian.Uint32(footer[4:]),
+	}
+
+	i := len(upsMagic)
+	if p.SourceSize, err = readVarint(body, &i); err != nil {
+		return nil, err
+	}
+	if p.TargetSize, err = readVarint(body, &i); err != nil {
+		return nil, err
+	}
+	if p.SourceSize > MaxSize || p.TargetSize > MaxSize {
+		return nil, ErrTooLarge
+	}
+
+	p.hunks = body[i:]
+	return
+}
+
+// Apply validates the checksum of source against either end of the patch and XORs the hunks into a copy of it.
+func (p *UPS) Apply(source []byte) (target []byte, err error) {
+	inputCRC32 := crc32.ChecksumIEEE(source)
+
+	// determine the direction to apply the patch in:
+	var outputSize uint64
+	var outputCRC32 uint32
+	if uint64(len(source)) == p.SourceSize && inputCRC32 == p.SourceCRC32 {
+		outputSize, outputCRC32 = p.TargetSize, p.TargetCRC32
+	} else if uint64(len(source)) == p.TargetSize && inputCRC32 == p.TargetCRC32 {
+		outputSize, outputCRC32 = p.SourceSize, p.SourceCRC32
+	} else {
+		return nil, ErrSourceChecksum
+	}
+
+	target = make([]byte, outputSize)
+	copy(target, source)
+
+	h := p.hunks
+	offset := uint64(0)
+	i := 0
+	for i < len(h) {
+		var skip uint64
+		if skip, err = readVarint(h, &i); err != nil {
+			return nil, err
+		}
+		offset += skip
+
+		// XOR bytes until a zero byte which ends the hunk and also counts as a position:
+		for {
+			if i >= len(h) {
+				return nil, ErrTruncated
+			}
+			x := h[i]
+			i++
+			if offset < outputSize {
+				target[offset] ^= x
+			}
+			offset++
+			if x == 0 {
+				break
+			}
+		}
+	}
+
+	if crc32.ChecksumIEEE(target) != outputCRC32 {
+		return nil, ErrTargetChecksum
+	}
+	return
+}
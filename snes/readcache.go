@@ -0,0 +1,428 @@
+package snes
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"log"
+	"sni/snes/timing"
+	"sni/util/env"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var (
+	readCacheFramesMu sync.RWMutex
+	readCacheFrames   = make(map[string]int)
+
+	readCachesMu sync.Mutex
+	readCaches   = make(map[string]*readCache)
+)
+
+// ConfigureReadCache configures read coalescing and caching for all devices of the named driver. The number of frames
+// that read data may be served from the cache is read from the envName env var and defaults to defaultFrames. Zero
+// disables the cache for the driver.
+func ConfigureReadCache(driverName string, envName string, defaultFrames int) {
+	frames, err := strconv.Atoi(env.GetOrDefault(envName, strconv.Itoa(defaultFrames)))
+	if err != nil || frames < 0 {
+		log.Printf("%s: invalid %s; using default %d\n", driverName, envName, defaultFrames)
+		frames = defaultFrames
+	}
+
+	readCacheFramesMu.Lock()
+	defer readCacheFramesMu.Unlock()
+	readCacheFrames[driverName] = frames
+}
+
+// ReadCacheFrames returns the number of frames that reads from devices of the named driver are served from the cache.
+func ReadCacheFrames(driverName string) int {
+	readCacheFramesMu.RLock()
+	defer readCacheFramesMu.RUnlock()
+	return readCacheFrames[driverName]
+}
+
+// readCacheFor returns the read cache for the device or nil if caching is disabled for its driver.
+func readCacheFor(device AutoCloseableDevice) *readCache {
+	frames := ReadCacheFrames(device.URI().Scheme)
+	if frames <= 0 {
+		return nil
+	}
+
+	key := uniqueDeviceKey(device)
+
+	readCachesMu.Lock()
+	defer readCachesMu.Unlock()
+
+	c, ok := readCaches[key]
+	if !ok {
+		c = &readCache{window: timing.Frame * time.Duration(frames)}
+		readCaches[key] = c
+	}
+	return c
+}
+
+type multiReadFunc func(ctx context.Context, reads ...MemoryReadRequest) ([]MemoryReadResponse, error)
+
+// readCache sits in front of a device's MultiReadMemory. Reads from a client that arrive while another read of that
+// client is in flight are batched together and overlapping or adjacent reads are merged into a single driver call.
+// Batches are kept per client so that the scheduler still sees which client each device read is made for. Read data
+// is served to all clients from the cache for one window after it was read; writes invalidate overlapping data.
+type readCache struct {
+	window time.Duration
+
+	mu      sync.Mutex
+	entries []readCacheEntry
+	// generation is incremented on every invalidation so in-flight reads do not cache stale data:
+	generation uint64
+	clients    map[string]*clientReads
+}
+
+type readCacheEntry struct {
+	rsp     MemoryReadResponse
+	expires time.Time
+}
+
+// clientReads tracks the batches of one client ID
+type clientReads struct {
+	inflight bool
+	pending  *readBatch
+}
+
+type readBatch struct {
+	fetch    multiReadFunc
+	requests []*batchedRead
+
+	// ctx carries the client ID of the batch and is cancelled once all callers waiting on the batch are gone:
+	ctx     context.Context
+	cancel  context.CancelFunc
+	waiting int
+
+	done chan struct{}
+}
+
+// batchedRead is one caller's reads within a batch
+type batchedRead struct {
+	reads []MemoryReadRequest
+
+	rsps []MemoryReadResponse
+	err  error
+}
+
+func (c *readCache) MultiReadMemory(ctx context.Context, fetch multiReadFunc, reads ...MemoryReadRequest) (rsp []MemoryReadResponse, err error) {
+	c.mu.Lock()
+	c.expire(time.Now())
+
+	// serve entirely from cache if possible:
+	if rsp = sliceReadResponses(c.cached(), reads); rsp != nil {
+		c.mu.Unlock()
+		return
+	}
+
+	// join the client's next batch:
+	id := ClientIDFromContext(ctx)
+	if c.clients == nil {
+		c.clients = make(map[string]*clientReads)
+	}
+	cl := c.clients[id]
+	if cl == nil {
+		cl = &clientReads{}
+		c.clients[id] = cl
+	}
+	b := cl.pending
+	if b == nil || b.waiting == 0 {
+		// a pending batch without waiting callers was cancelled when they left:
+		b = &readBatch{fetch: fetch, done: make(chan struct{})}
+		b.ctx, b.cancel = context.WithCancel(WithClientID(context.Background(), id))
+		cl.pending = b
+	}
+	r := &batchedRead{reads: reads}
+	b.requests = append(b.requests, r)
+	b.waiting++
+
+	if !cl.inflight {
+		c.start(id, cl)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+		c.leave(b)
+		return nil, ctx.Err()
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	rsp = sliceReadResponses(r.rsps, reads)
+	if rsp == nil {
+		err = WithCode(codes.Internal, fmt.Errorf("read cache: merged read did not cover requested read"))
+	}
+	return
+}
+
+// leave cancels the batch once its last waiting caller has gone.
+func (c *readCache) leave(b *readBatch) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b.waiting--
+	if b.waiting == 0 {
+		b.cancel()
+	}
+}
+
+// start runs the client's pending batch; c.mu must be held.
+func (c *readCache) start(id string, cl *clientReads) {
+	b := cl.pending
+	cl.pending = nil
+	cl.inflight = true
+	go c.run(id, cl, b, c.generation)
+}
+
+func (c *readCache) run(id string, cl *clientReads, b *readBatch, generation uint64) {
+	defer b.cancel()
+
+	var reads []MemoryReadRequest
+	for _, r := range b.requests {
+		reads = append(reads, r.reads...)
+	}
+
+	rsps, err := c.fetch(b.ctx, b.fetch, generation, reads)
+	if err != nil && len(b.requests) > 1 && !IsFatal(err) && b.ctx.Err() == nil {
+		// one caller's bad read fails the whole merged read so read each caller's reads separately to only fail the
+		// callers whose reads fail by themselves:
+		for _, r := range b.requests {
+			r.rsps, r.err = c.fetch(b.ctx, b.fetch, generation, r.reads)
+		}
+	} else {
+		for _, r := range b.requests {
+			r.rsps, r.err = rsps, err
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	close(b.done)
+
+	if cl.pending != nil {
+		c.start(id, cl)
+	} else {
+		cl.inflight = false
+		delete(c.clients, id)
+	}
+}
+
+// fetch reads the merged reads from the device and caches the responses unless the cache was invalidated since
+// generation. The read is shared by the callers of a batch so it runs on the batch's context rather than any one
+// caller's.
+func (c *readCache) fetch(ctx context.Context, fetch multiReadFunc, generation uint64, reads []MemoryReadRequest) (rsps []MemoryReadResponse, err error) {
+	if err = ctx.Err(); err != nil {
+		// all callers have gone:
+		return nil, err
+	}
+
+	merged := mergeReads(reads)
+
+	tStart := time.Now()
+	rsps, err = fetch(ctx, merged...)
+	if err == nil && len(rsps) != len(merged) {
+		err = WithCode(codes.Internal, fmt.Errorf(
+			"multi read must have equal number of responses and requests; actual %d expected %d",
+			len(rsps),
+			len(merged),
+		))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation == c.generation {
+		expires := tStart.Add(c.window)
+		for i := range rsps {
+			c.entries = append(c.entries, readCacheEntry{rsp: rsps[i], expires: expires})
+		}
+	}
+	return
+}
+
+// expire removes expired entries; c.mu must be held.
+func (c *readCache) expire(now time.Time) {
+	kept := c.entries[:0]
+	for _, e := range c.entries {
+		if now.Before(e.expires) {
+			kept = append(kept, e)
+		}
+	}
+	c.entries = kept
+}
+
+// cached returns the responses of all entries, most recent first; c.mu must be held.
+func (c *readCache) cached() []MemoryReadResponse {
+	rsps := make([]MemoryReadResponse, len(c.entries))
+	for i := range c.entries {
+		rsps[len(c.entries)-1-i] = c.entries[i].rsp
+	}
+	return rsps
+}
+
+// InvalidateWrites removes cached data overlapping the writes. Since addresses in different address spaces or memory
+// mappings may alias, all data cached in other address spaces or mappings is removed as well.
+func (c *readCache) InvalidateWrites(writes []MemoryWriteRequest) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	kept := c.entries[:0]
+nextEntry:
+	for _, e := range c.entries {
+		for _, w := range writes {
+			if !sameAddressDomain(e.rsp.RequestAddress, w.RequestAddress) {
+				continue nextEntry
+			}
+			start, end := e.rsp.RequestAddress.Address, e.rsp.RequestAddress.Address+uint32(len(e.rsp.Data))
+			wStart, wEnd := w.RequestAddress.Address, w.RequestAddress.Address+uint32(len(w.Data))
+			if wStart < end && start < wEnd {
+				continue nextEntry
+			}
+		}
+		kept = append(kept, e)
+	}
+	c.entries = kept
+}
+
+// InvalidateAll removes all cached data.
+func (c *readCache) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.entries = nil
+}
+
+func sameAddressDomain(a, b AddressTuple) bool {
+	return a.AddressSpace == b.AddressSpace && a.MemoryMapping == b.MemoryMapping
+}
+
+// MemoryRegionFunc translates an address to the FX Pak Pro space and names the type of memory found there, e.g. WRAM.
+// ok is false if the address is not mapped to a known type of memory.
+type MemoryRegionFunc func(a AddressTuple) (memoryType string, pakAddress uint32, ok bool)
+
+var (
+	memoryRegionMu sync.RWMutex
+	memoryRegion   MemoryRegionFunc
+)
+
+// RegisterMemoryRegionFunc sets the function that the read cache checks reads with before merging them. It is
+// registered by the mapping package which cannot be imported here; until then no reads are merged.
+func RegisterMemoryRegionFunc(f MemoryRegionFunc) {
+	memoryRegionMu.Lock()
+	defer memoryRegionMu.Unlock()
+	memoryRegion = f
+}
+
+// canMergeRange reports whether the address range [a.Address, end) is read from the device as one contiguous range,
+// i.e. both of its ends translate to the same type of memory at pak addresses as far apart as the range. Without this
+// check a range in the same bank could run from WRAM into I/O registers, or in FX Pak Pro space from WRAM into VRAM.
+func canMergeRange(a AddressTuple, end uint32) bool {
+	if end <= a.Address+1 {
+		return true
+	}
+
+	memoryRegionMu.RLock()
+	region := memoryRegion
+	memoryRegionMu.RUnlock()
+	if region == nil {
+		return false
+	}
+
+	last := a
+	last.Address = end - 1
+	startType, startPak, startOk := region(a)
+	lastType, lastPak, lastOk := region(last)
+	return startOk && lastOk && startType == lastType && lastPak-startPak == last.Address-a.Address
+}
+
+// mergeReads merges overlapping and adjacent reads in the same address space and memory mapping.
+func mergeReads(reads []MemoryReadRequest) (merged []MemoryReadRequest) {
+	sorted := make([]MemoryReadRequest, len(reads))
+	copy(sorted, reads)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].RequestAddress, sorted[j].RequestAddress
+		if a.AddressSpace != b.AddressSpace {
+			return a.AddressSpace < b.AddressSpace
+		}
+		if a.MemoryMapping != b.MemoryMapping {
+			return a.MemoryMapping < b.MemoryMapping
+		}
+		return a.Address < b.Address
+	})
+
+	for _, r := range sorted {
+		if n := len(merged); n > 0 {
+			m := &merged[n-1]
+			start, end := m.RequestAddress.Address, m.RequestAddress.Address+uint32(m.Size)
+			rEnd := r.RequestAddress.Address + uint32(r.Size)
+			if rEnd < end {
+				rEnd = end
+			}
+			if sameAddressDomain(m.RequestAddress, r.RequestAddress) &&
+				r.RequestAddress.Address <= end &&
+				canMergeRange(m.RequestAddress, rEnd) {
+				m.Size = int(rEnd - start)
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+	return
+}
+
+// sliceReadResponses fans out the data of the first response covering each read. Returns nil if any read is not
+// covered.
+func sliceReadResponses(rsps []MemoryReadResponse, reads []MemoryReadRequest) []MemoryReadResponse {
+	out := make([]MemoryReadResponse, 0, len(reads))
+
+nextRead:
+	for _, read := range reads {
+		start := read.RequestAddress.Address
+		end := start + uint32(read.Size)
+		for i := range rsps {
+			rsp := &rsps[i]
+			if !sameAddressDomain(rsp.RequestAddress, read.RequestAddress) {
+				continue
+			}
+			rStart := rsp.RequestAddress.Address
+			if start < rStart || end > rStart+uint32(len(rsp.Data)) {
+				continue
+			}
+			// a read starting where the response starts reads the same memory; one starting later must be linearly mapped:
+			if start != rStart && !canMergeRange(rsp.RequestAddress, end) {
+				continue
+			}
+
+			offs := start - rStart
+			data := make([]byte, read.Size)
+			copy(data, rsp.Data[offs:])
+
+			deviceAddress := rsp.DeviceAddress
+			deviceAddress.Address += offs
+			out = append(out, MemoryReadResponse{
+				RequestAddress: read.RequestAddress,
+				DeviceAddress:  deviceAddress,
+				Data:           data,
+			})
+			continue nextRead
+		}
+		return nil
+	}
+
+	return out
+}
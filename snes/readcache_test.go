@@ -0,0 +1,422 @@
+package snes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sni/protos/sni"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func init() {
+	RegisterMemoryRegionFunc(testMemoryRegion)
+}
+
+// testMemoryRegion stands in for the mapping package, which imports this package, and models FX Pak Pro space and the
+// low banks of the LoROM A-bus.
+func testMemoryRegion(a AddressTuple) (memoryType string, pakAddress uint32, ok bool) {
+	pakAddress = a.Address
+	if a.AddressSpace == sni.AddressSpace_SnesABus {
+		switch {
+		case a.Address&0xFFFF < 0x2000:
+			pakAddress = 0xF50000 + a.Address&0x1FFF
+		case a.Address&0x8000 != 0:
+			pakAddress = a.Address>>16<<15 | a.Address&0x7FFF
+		default:
+			return "", 0, false
+		}
+	}
+
+	switch {
+	case pakAddress < 0xE00000:
+		return "ROM", pakAddress, true
+	case pakAddress >= 0xF50000 && pakAddress < 0xF70000:
+		return "WRAM", pakAddress, true
+	case pakAddress >= 0xF70000 && pakAddress < 0xF80000:
+		return "VRAM", pakAddress, true
+	}
+	return "", 0, false
+}
+
+func wram(addr uint32, size int) MemoryReadRequest {
+	return MemoryReadRequest{
+		RequestAddress: AddressTuple{Address: addr, AddressSpace: sni.AddressSpace_FxPakPro},
+		Size:           size,
+	}
+}
+
+func Test_mergeReads(t *testing.T) {
+	abus := func(addr uint32, size int) MemoryReadRequest {
+		return MemoryReadRequest{
+			RequestAddress: AddressTuple{Address: addr, AddressSpace: sni.AddressSpace_SnesABus, MemoryMapping: sni.MemoryMapping_LoROM},
+			Size:           size,
+		}
+	}
+
+	tests := []struct {
+		name  string
+		reads []MemoryReadRequest
+		want  []MemoryReadRequest
+	}{
+		{
+			name:  "overlapping",
+			reads: []MemoryReadRequest{wram(0xF50010, 0x10), wram(0xF50000, 0x18)},
+			want:  []MemoryReadRequest{wram(0xF50000, 0x20)},
+		},
+		{
+			name:  "adjacent",
+			reads: []MemoryReadRequest{wram(0xF50000, 0x10), wram(0xF50010, 0x10)},
+			want:  []MemoryReadRequest{wram(0xF50000, 0x20)},
+		},
+		{
+			name:  "contained",
+			reads: []MemoryReadRequest{wram(0xF50000, 0x20), wram(0xF50004, 0x4)},
+			want:  []MemoryReadRequest{wram(0xF50000, 0x20)},
+		},
+		{
+			name:  "disjoint",
+			reads: []MemoryReadRequest{wram(0xF50020, 0x10), wram(0xF50000, 0x10)},
+			want:  []MemoryReadRequest{wram(0xF50000, 0x10), wram(0xF50020, 0x10)},
+		},
+		{
+			name:  "different address spaces",
+			reads: []MemoryReadRequest{wram(0xF50000, 0x10), abus(0xF50010, 0x10)},
+			want:  []MemoryReadRequest{wram(0xF50000, 0x10), abus(0xF50010, 0x10)},
+		},
+		{
+			name:  "a-bus within bank half",
+			reads: []MemoryReadRequest{abus(0x008000, 0x10), abus(0x008010, 0x10)},
+			want:  []MemoryReadRequest{abus(0x008000, 0x20)},
+		},
+		{
+			name:  "a-bus across bank boundary",
+			reads: []MemoryReadRequest{abus(0x00FFF0, 0x10), abus(0x018000, 0x10)},
+			want:  []MemoryReadRequest{abus(0x00FFF0, 0x10), abus(0x018000, 0x10)},
+		},
+		{
+			name:  "wram into vram",
+			reads: []MemoryReadRequest{wram(0xF6FFF0, 0x10), wram(0xF70000, 0x10)},
+			want:  []MemoryReadRequest{wram(0xF6FFF0, 0x10), wram(0xF70000, 0x10)},
+		},
+		{
+			name:  "a-bus wram into i/o",
+			reads: []MemoryReadRequest{abus(0x001FF0, 0x10), abus(0x002000, 0x10)},
+			want:  []MemoryReadRequest{abus(0x001FF0, 0x10), abus(0x002000, 0x10)},
+		},
+		{
+			name:  "a-bus within wram",
+			reads: []MemoryReadRequest{abus(0x001F00, 0x10), abus(0x001F10, 0x10)},
+			want:  []MemoryReadRequest{abus(0x001F00, 0x20)},
+		},
+		{
+			name:  "a-bus across bank halves",
+			reads: []MemoryReadRequest{abus(0x007FF0, 0x10), abus(0x008000, 0x10)},
+			want:  []MemoryReadRequest{abus(0x007FF0, 0x10), abus(0x008000, 0x10)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeReads(tt.reads); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeReads() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeMemory serves reads from a byte slice indexed by address and counts calls. Calls that read the bad address
+// fail with a non-fatal error.
+type fakeMemory struct {
+	mu      sync.Mutex
+	calls   [][]MemoryReadRequest
+	clients []string
+	gate    chan struct{}
+
+	hasBad bool
+	bad    uint32
+}
+
+func (f *fakeMemory) read(ctx context.Context, reads ...MemoryReadRequest) (rsp []MemoryReadResponse, err error) {
+	if f.gate != nil {
+		<-f.gate
+	}
+	f.mu.Lock()
+	f.calls = append(f.calls, reads)
+	f.clients = append(f.clients, ClientIDFromContext(ctx))
+	f.mu.Unlock()
+
+	for _, r := range reads {
+		if f.hasBad && r.RequestAddress.Address <= f.bad && f.bad < r.RequestAddress.Address+uint32(r.Size) {
+			err = fmt.Errorf("unmapped address $%06x", f.bad)
+			return nil, DeviceNonFatal(err.Error(), err)
+		}
+	}
+
+	for _, r := range reads {
+		data := make([]byte, r.Size)
+		for i := range data {
+			data[i] = byte(r.RequestAddress.Address + uint32(i))
+		}
+		rsp = append(rsp, MemoryReadResponse{RequestAddress: r.RequestAddress, DeviceAddress: r.RequestAddress, Data: data})
+	}
+	return
+}
+
+func (f *fakeMemory) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.calls)
+}
+
+func TestReadCache_cacheAndInvalidate(t *testing.T) {
+	c := &readCache{window: time.Hour}
+	f := &fakeMemory{}
+	ctx := context.Background()
+
+	rsp, err := c.MultiReadMemory(ctx, f.read, wram(0xF50000, 0x20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp[0].Data[0x1F] != 0x1F {
+		t.Fatalf("unexpected data %v", rsp[0].Data)
+	}
+
+	// contained reads are served from cache:
+	rsp, err = c.MultiReadMemory(ctx, f.read, wram(0xF50010, 0x4), wram(0xF50000, 0x2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f.callCount(); got != 1 {
+		t.Fatalf("expected 1 driver call; got %d", got)
+	}
+	if !reflect.DeepEqual(rsp[0].Data, []byte{0x10, 0x11, 0x12, 0x13}) || rsp[0].DeviceAddress.Address != 0xF50010 {
+		t.Fatalf("unexpected response %+v", rsp[0])
+	}
+
+	// non-overlapping writes keep the cache:
+	c.InvalidateWrites([]MemoryWriteRequest{{RequestAddress: wram(0xF50020, 0).RequestAddress, Data: []byte{1}}})
+	if _, err = c.MultiReadMemory(ctx, f.read, wram(0xF50000, 0x2)); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.callCount(); got != 1 {
+		t.Fatalf("expected 1 driver call; got %d", got)
+	}
+
+	// overlapping writes invalidate:
+	c.InvalidateWrites([]MemoryWriteRequest{{RequestAddress: wram(0xF5001F, 0).RequestAddress, Data: []byte{1}}})
+	if _, err = c.MultiReadMemory(ctx, f.read, wram(0xF50000, 0x2)); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.callCount(); got != 2 {
+		t.Fatalf("expected 2 driver calls; got %d", got)
+	}
+}
+
+// waitForBatches waits until the client has a read in flight and n callers joined its next batch.
+func waitForBatches(c *readCache, id string, n int) {
+	for {
+		c.mu.Lock()
+		inflight, pending := false, 0
+		if cl := c.clients[id]; cl != nil {
+			inflight = cl.inflight
+			if cl.pending != nil {
+				pending = len(cl.pending.requests)
+			}
+		}
+		c.mu.Unlock()
+		if inflight && pending == n {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestReadCache_coalesce(t *testing.T) {
+	c := &readCache{window: time.Hour}
+	f := &fakeMemory{gate: make(chan struct{})}
+	ctx := context.Background()
+
+	wg := sync.WaitGroup{}
+	read := func(reads ...MemoryReadRequest) {
+		defer wg.Done()
+		rsp, err := c.MultiReadMemory(ctx, f.read, reads...)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		for i, r := range reads {
+			if len(rsp[i].Data) != r.Size || rsp[i].Data[0] != byte(r.RequestAddress.Address) {
+				t.Errorf("unexpected response %+v for %+v", rsp[i], r)
+			}
+		}
+	}
+
+	// the first read goes in flight and blocks on the gate:
+	wg.Add(1)
+	go read(wram(0xF50100, 0x10))
+	waitForBatches(c, "", 0)
+
+	// these all join the next batch:
+	wg.Add(3)
+	go read(wram(0xF50000, 0x10))
+	go read(wram(0xF50008, 0x10))
+	go read(wram(0xF50018, 0x8))
+	waitForBatches(c, "", 3)
+
+	close(f.gate)
+	wg.Wait()
+
+	if got := f.callCount(); got != 2 {
+		t.Fatalf("expected 2 driver calls; got %d", got)
+	}
+	if want := []MemoryReadRequest{wram(0xF50000, 0x20)}; !reflect.DeepEqual(f.calls[1], want) {
+		t.Errorf("coalesced read = %v, want %v", f.calls[1], want)
+	}
+}
+
+func TestReadCache_clients(t *testing.T) {
+	c := &readCache{window: time.Hour}
+	f := &fakeMemory{gate: make(chan struct{})}
+
+	// each client's read goes in flight in its own batch and blocks on the gate:
+	errs := make(chan error, 2)
+	for _, id := range []string{"a", "b"} {
+		ctx := WithClientID(context.Background(), id)
+		go func() {
+			_, err := c.MultiReadMemory(ctx, f.read, wram(0xF50000, 0x10))
+			errs <- err
+		}()
+		waitForBatches(c, id, 0)
+	}
+
+	close(f.gate)
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the scheduler sees each client's own read:
+	sort.Strings(f.clients)
+	if got, want := f.clients, []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("driver calls made with client IDs %q, want %q", got, want)
+	}
+}
+
+func TestReadCache_cancel(t *testing.T) {
+	c := &readCache{window: time.Hour}
+	f := &fakeMemory{gate: make(chan struct{})}
+	app := WithClientID(context.Background(), "app")
+
+	// the first read goes in flight and blocks on the gate:
+	first := make(chan error, 1)
+	go func() {
+		_, err := c.MultiReadMemory(app, f.read, wram(0xF50100, 0x10))
+		first <- err
+	}()
+	waitForBatches(c, "app", 0)
+
+	// two callers join the next batch and then go away:
+	var cancels []context.CancelFunc
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		ctx, cancel := context.WithCancel(app)
+		cancels = append(cancels, cancel)
+		go func() {
+			_, err := c.MultiReadMemory(ctx, f.read, wram(0xF50000, 0x10))
+			errs <- err
+		}()
+		waitForBatches(c, "app", i+1)
+	}
+	c.mu.Lock()
+	b := c.clients["app"].pending
+	c.mu.Unlock()
+
+	cancels[0]()
+	if err := <-errs; err != context.Canceled {
+		t.Fatalf("expected cancelled read; got %v", err)
+	}
+	if err := b.ctx.Err(); err != nil {
+		t.Fatalf("expected batch to keep going while a caller waits; got %v", err)
+	}
+
+	cancels[1]()
+	if err := <-errs; err != context.Canceled {
+		t.Fatalf("expected cancelled read; got %v", err)
+	}
+	if err := b.ctx.Err(); err != context.Canceled {
+		t.Fatalf("expected batch to be cancelled once all callers left; got %v", err)
+	}
+
+	close(f.gate)
+	if err := <-first; err != nil {
+		t.Fatal(err)
+	}
+	for {
+		c.mu.Lock()
+		n := len(c.clients)
+		c.mu.Unlock()
+		if n == 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// the cancelled batch never reached the device:
+	if got := f.callCount(); got != 1 {
+		t.Fatalf("expected 1 driver call; got %d", got)
+	}
+}
+
+func TestReadCache_failureIsolation(t *testing.T) {
+	c := &readCache{window: time.Hour}
+	f := &fakeMemory{gate: make(chan struct{}), hasBad: true, bad: 0xF60000}
+	app := WithClientID(context.Background(), "app")
+
+	// the first read goes in flight and blocks on the gate:
+	first := make(chan error, 1)
+	go func() {
+		_, err := c.MultiReadMemory(app, f.read, wram(0xF50100, 0x10))
+		first <- err
+	}()
+	waitForBatches(c, "app", 0)
+
+	// a good and a bad read join the next batch:
+	good, bad := make(chan error, 1), make(chan error, 1)
+	go func() {
+		rsp, err := c.MultiReadMemory(app, f.read, wram(0xF50000, 0x10))
+		if err == nil && rsp[0].Data[0] != 0x00 {
+			err = fmt.Errorf("unexpected response %+v", rsp[0])
+		}
+		good <- err
+	}()
+	go func() {
+		_, err := c.MultiReadMemory(app, f.read, wram(0xF5FFF8, 0x10))
+		bad <- err
+	}()
+	waitForBatches(c, "app", 2)
+
+	close(f.gate)
+	if err := <-first; err != nil {
+		t.Fatal(err)
+	}
+	if err := <-good; err != nil {
+		t.Fatalf("expected good read to succeed; got %v", err)
+	}
+	if err := <-bad; err == nil {
+		t.Fatal("expected bad read to fail")
+	}
+
+	// the merged read failed and then each caller's reads were retried separately:
+	if got := f.callCount(); got != 4 {
+		t.Fatalf("expected 4 driver calls; got %d", got)
+	}
+	// every read is made on behalf of the client:
+	for i, id := range f.clients {
+		if id != "app" {
+			t.Errorf("call[%d] made with client ID %q, want %q", i, id, "app")
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package snes
+
+import (
+	"compress/gzip"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"hash/crc32"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sni/protos/sni"
+	"sni/util/env"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RecordingFileExt is the file extension of recordings
+const RecordingFileExt = ".snirec"
+
+// RecordingHeader is the first value in a recording.
+type RecordingHeader struct {
+	// URI of the recorded device
+	URI     string
+	Started time.Time
+}
+
+// RecordedCall is a device call with its arguments, results and error. Only the fields relevant to Method are set.
+type RecordedCall struct {
+	Time     time.Time
+	Duration time.Duration
+	Method   string
+
+	// arguments:
+	Reads       []MemoryReadRequest
+	Writes      []MemoryWriteRequest
+	Conditions  []MemoryCondition
+	Fields      []Field
+	PausedState bool
+	Path        string
+	NewPath     string
+	Code        []byte
+	// Data is the ExecuteASM data argument or the file data received by GetFile
+	Data []byte
+	// Size and CRC32 describe the file data sent by PutFile rather than recording it whole
+	Size  uint32
+	CRC32 uint32
+
+	// results:
+	AddressSpace     sni.AddressSpace
+	ReadResponses    []MemoryReadResponse
+	WriteResponses   []MemoryWriteResponse
+	ConditionalWrite ConditionalWriteResponse
+	FieldValues      []FieldValue
+	DirEntries       []DirEntry
+	Ok               bool
+	Err              *RecordedError
+}
+
+// RecordedError preserves the gRPC code and fatality of an error so it can be reproduced.
+type RecordedError struct {
+	Message string
+	Code    codes.Code
+	Device  bool
+	Fatal   bool
+}
+
+func recordError(err error) *RecordedError {
+	if err == nil {
+		return nil
+	}
+	rerr := &RecordedError{Message: err.Error(), Code: codes.Unknown}
+	var coded *CodedError
+	if errors.As(err, &coded) {
+		rerr.Code = coded.Code
+	}
+	if derr, ok := err.(DeviceError); ok {
+		rerr.Device = true
+		rerr.Fatal = derr.IsFatal()
+	}
+	return rerr
+}
+
+// AsError reconstructs the recorded error.
+func (e *RecordedError) AsError() error {
+	if e == nil {
+		return nil
+	}
+	err := errors.New(e.Message)
+	if e.Device {
+		if e.Fatal {
+			return DeviceFatal(e.Message, err)
+		}
+		return DeviceNonFatal(e.Message, err)
+	}
+	if e.Code != codes.Unknown {
+		return WithCode(e.Code, err)
+	}
+	return err
+}
+
+// RecordingReader reads the calls of a recording in order.
+type RecordingReader struct {
+	zr     *gzip.Reader
+	dec    *gob.Decoder
+	Header RecordingHeader
+}
+
+// NewRecordingReader reads the recording header from r.
+func NewRecordingReader(r io.Reader) (rr *RecordingReader, err error) {
+	rr = &RecordingReader{}
+	rr.zr, err = gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("recording: %w", err)
+	}
+	rr.dec = gob.NewDecoder(rr.zr)
+	if err = rr.dec.Decode(&rr.Header); err != nil {
+		return nil, fmt.Errorf("recording: header: %w", err)
+	}
+	return
+}
+
+// Next returns the next call or io.EOF at the end of the recording. A recording cut short, e.g. because SNI exited
+// while recording, ends at its last complete call.
+func (rr *RecordingReader) Next() (call RecordedCall, err error) {
+	err = rr.dec.Decode(&call)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+	return
+}
+
+// RecordingWriter writes a compressed recording of device calls.
+type RecordingWriter struct {
+	mu  sync.Mutex
+	w   io.Writer
+	zw  *gzip.Writer
+	enc *gob.Encoder
+}
+
+// NewRecordingWriter writes the recording header to w.
+func NewRecordingWriter(w io.Writer, header RecordingHeader) (rw *RecordingWriter, err error) {
+	rw = &RecordingWriter{w: w, zw: gzip.NewWriter(w)}
+	rw.enc = gob.NewEncoder(rw.zw)
+	if err = rw.enc.Encode(&header); err != nil {
+		return nil, err
+	}
+	if err = rw.zw.Flush(); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Write appends the call to the recording. Each call is flushed so that the recording is usable even if it is never
+// closed.
+func (rw *RecordingWriter) Write(call *RecordedCall) (err error) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	if err = rw.enc.Encode(call); err != nil {
+		return
+	}
+	return rw.zw.Flush()
+}
+
+// Close finishes the recording and closes the underlying writer if it is an io.Closer.
+func (rw *RecordingWriter) Close() (err error) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	err = rw.zw.Close()
+	if c, ok := rw.w.(io.Closer); ok {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return
+}
+
+var (
+	recordConfigOnce sync.Once
+	recordDir        string
+
+	recordersMu sync.Mutex
+	recorders   = make(map[string]*RecordingWriter)
+	// recordingFailed stops recording after a recording file could not be created:
+	recordingFailed bool
+	// recordingDisabledSchemes lists drivers whose devices are never recorded:
+	recordingDisabledSchemes = map[string]bool{"replay": true}
+)
+
+func loadRecordConfig() {
+	recordDir = env.GetOrDefault("SNI_RECORD_DIR", "")
+	if recordDir != "" {
+		log.Printf("recording: recording device calls to %s\n", recordDir)
+	}
+}
+
+var recordingFileNameReplacer = strings.NewReplacer("/", "_", "\\", "_", ":", "_", ".", "_", " ", "_")
+
+// recorderFor returns the recording writer for the device or nil if recording is disabled. The recording file is
+// created on first use.
+func recorderFor(device AutoCloseableDevice) *RecordingWriter {
+	recordConfigOnce.Do(loadRecordConfig)
+	if recordDir == "" || recordingDisabledSchemes[device.URI().Scheme] {
+		return nil
+	}
+
+	key := uniqueDeviceKey(device)
+
+	recordersMu.Lock()
+	defer recordersMu.Unlock()
+
+	if rw, ok := recorders[key]; ok {
+		return rw
+	}
+	if recordingFailed {
+		return nil
+	}
+
+	started := time.Now()
+	name := fmt.Sprintf(
+		"%s_%s%s",
+		recordingFileNameReplacer.Replace(key),
+		started.Format("20060102-150405"),
+		RecordingFileExt,
+	)
+	path := filepath.Join(recordDir, name)
+
+	var rw *RecordingWriter
+	f, err := os.Create(path)
+	if err == nil {
+		rw, err = NewRecordingWriter(f, RecordingHeader{URI: device.URI().String(), Started: started})
+		if err != nil {
+			_ = f.Close()
+		}
+	}
+	if err != nil {
+		log.Printf("recording: %v\n", err)
+		// do not retry on every call:
+		recordingFailed = true
+		return nil
+	}
+
+	log.Printf("recording: recording %s to %s\n", device.URI(), path)
+	recorders[key] = rw
+	return rw
+}
+
+// recordCall records a completed call made at tStart with the error it returned.
+func recordCall(device AutoCloseableDevice, tStart time.Time, err error, call RecordedCall) {
+	rw := recorderFor(device)
+	if rw == nil {
+		return
+	}
+
+	call.Time = tStart
+	call.Duration = time.Since(tStart)
+	call.Err = recordError(err)
+	if werr := rw.Write(&call); werr != nil {
+		log.Printf("recording: %v\n", werr)
+	}
+}
+
+// recordingReader records the size and CRC32 of the data read through it
+type recordingReader struct {
+	r    io.Reader
+	size uint32
+	crc  uint32
+}
+
+func (r *recordingReader) Read(p []byte) (n int, err error) {
+	n, err = r.r.Read(p)
+	r.size += uint32(n)
+	r.crc = crc32.Update(r.crc, crc32.IEEETable, p[:n])
+	return
+}
+
+// recordingWriter keeps a copy of the data written through it
+type recordingWriter struct {
+	w    io.Writer
+	data []byte
+}
+
+func (w *recordingWriter) Write(p []byte) (n int, err error) {
+	n, err = w.w.Write(p)
+	w.data = append(w.data, p[:n]...)
+	return
+}
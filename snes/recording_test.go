@@ -0,0 +1,91 @@
+package snes
+
+import (
+	"bytes"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"io"
+	"sni/protos/sni"
+	"testing"
+	"time"
+)
+
+func TestRecordingRoundTrip(t *testing.T) {
+	buf := &bytes.Buffer{}
+	header := RecordingHeader{URI: "fxpakpro://./COM4", Started: time.Unix(1600000000, 0)}
+	rw, err := NewRecordingWriter(buf, header)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read := MemoryReadRequest{
+		RequestAddress: AddressTuple{Address: 0xF50010, AddressSpace: sni.AddressSpace_FxPakPro},
+		Size:           2,
+	}
+	calls := []RecordedCall{
+		{
+			Method:        "MultiReadMemory",
+			Reads:         []MemoryReadRequest{read},
+			ReadResponses: []MemoryReadResponse{{RequestAddress: read.RequestAddress, Data: []byte{1, 2}}},
+		},
+		{
+			Method: "ResetSystem",
+			Err:    recordError(DeviceFatal("reset failed", errors.New("io"))),
+		},
+		{
+			Method: "BootFile",
+			Path:   "/missing.sfc",
+			Err:    recordError(WithCode(codes.NotFound, errors.New("not found"))),
+		},
+	}
+	for i := range calls {
+		if err = rw.Write(&calls[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the recording must be readable without being closed:
+	rr, err := NewRecordingReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rr.Header.URI != header.URI || !rr.Header.Started.Equal(header.Started) {
+		t.Fatalf("header mismatch: %+v", rr.Header)
+	}
+
+	var got []RecordedCall
+	for {
+		var call RecordedCall
+		call, err = rr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, call)
+	}
+	if len(got) != len(calls) {
+		t.Fatalf("expected %d calls; got %d", len(calls), len(got))
+	}
+
+	if !bytes.Equal(got[0].ReadResponses[0].Data, []byte{1, 2}) || got[0].Reads[0] != read {
+		t.Fatalf("read call mismatch: %+v", got[0])
+	}
+	if err = got[0].Err.AsError(); err != nil {
+		t.Fatalf("expected no error; got %v", err)
+	}
+
+	if err = got[1].Err.AsError(); !IsFatal(err) || err.Error() != "reset failed" {
+		t.Fatalf("expected fatal device error; got %v", err)
+	}
+
+	var coded *CodedError
+	if err = got[2].Err.AsError(); !errors.As(err, &coded) || coded.Code != codes.NotFound {
+		t.Fatalf("expected NotFound error; got %v", err)
+	}
+
+	if err = rw.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
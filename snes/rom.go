@@ -4,33 +4,105 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"sni/protos/sni"
 )
 
+// CopierHeaderSize is the size of the header that old copier devices prepend to ROM files, e.g. .smc files
+const CopierHeaderSize = 0x200
+
 type ROM struct {
 	Name     string
 	Contents []byte
 
+	// CopierHeader is the copier header found before the ROM contents or nil if there was none
+	CopierHeader []byte
+
 	HeaderOffset uint32
 	Header       Header
+	// MemoryMapping is the mapping whose header location scored best or Unknown if no header looked valid
+	MemoryMapping sni.MemoryMapping
 }
 
+// headerCandidates are the offsets into the ROM contents of the header for each memory mapping
+var headerCandidates = []struct {
+	offset  uint32
+	mapping sni.MemoryMapping
+}{
+	{0x007FB0, sni.MemoryMapping_LoROM},
+	{0x00FFB0, sni.MemoryMapping_HiROM},
+	{0x40FFB0, sni.MemoryMapping_ExHiROM},
+	{0x407FB0, sni.MemoryMapping_ExLoROM},
+}
+
+// NewROM strips any copier header from the file contents and reads the header at the LoROM, HiROM, ExHiROM or
+// ExLoROM location that scores best. The LoROM header is read if none scores above zero.
 func NewROM(name string, contents []byte) (r *ROM, err error) {
+	var copierHeader []byte
+	if len(contents)&0x7FFF == CopierHeaderSize {
+		copierHeader = contents[:CopierHeaderSize]
+		contents = contents[CopierHeaderSize:]
+	}
 	if len(contents) < 0x8000 {
 		return nil, fmt.Errorf("ROM file not big enough to contain SNES header")
 	}
 
-	headerOffset := uint32(0x007FB0)
-
 	r = &ROM{
-		Name:         name,
-		Contents:     contents,
-		HeaderOffset: headerOffset,
+		Name:          name,
+		Contents:      contents,
+		CopierHeader:  copierHeader,
+		HeaderOffset:  headerCandidates[0].offset,
+		MemoryMapping: sni.MemoryMapping_Unknown,
+	}
+
+	bestScore := 0
+	bestOffset := r.HeaderOffset
+	for _, candidate := range headerCandidates {
+		if int(candidate.offset)+0x50 > len(contents) {
+			continue
+		}
+		r.HeaderOffset = candidate.offset
+		if err = r.ReadHeader(); err != nil {
+			return nil, err
+		}
+		if score := r.Header.Score(candidate.offset); score > bestScore {
+			bestScore = score
+			bestOffset = candidate.offset
+			r.MemoryMapping = candidate.mapping
+		}
 	}
 
+	r.HeaderOffset = bestOffset
 	err = r.ReadHeader()
 	return
 }
 
+// FileContents returns the ROM contents prefixed with the copier header if the ROM has one.
+func (r *ROM) FileContents() []byte {
+	if r.CopierHeader == nil {
+		return r.Contents
+	}
+	file := make([]byte, 0, len(r.CopierHeader)+len(r.Contents))
+	file = append(file, r.CopierHeader...)
+	return append(file, r.Contents...)
+}
+
+// StripCopierHeader removes the copier header so that FileContents returns only the ROM contents.
+func (r *ROM) StripCopierHeader() {
+	r.CopierHeader = nil
+}
+
+// AddCopierHeader adds a blank copier header if the ROM does not have one. Its first two bytes hold the ROM size in
+// 8KiB units as the Super Wild Card format expects.
+func (r *ROM) AddCopierHeader() {
+	if r.CopierHeader != nil {
+		return
+	}
+	r.CopierHeader = make([]byte, CopierHeaderSize)
+	blocks := len(r.Contents) / 0x2000
+	r.CopierHeader[0] = byte(blocks)
+	r.CopierHeader[1] = byte(blocks >> 8)
+}
+
 func (r *ROM) ReadHeader() (err error) {
 	// Read SNES header:
 	b := bytes.NewReader(r.Contents[r.HeaderOffset : r.HeaderOffset+0x50])
@@ -54,6 +126,72 @@ func (r *ROM) WriteHeader() (err error) {
 	return
 }
 
+// ComputeChecksum computes the standard SNES checksum: the 16-bit sum of all ROM bytes. ROMs whose size is not a power
+// of two are summed as the console sees them, with the remainder past the largest power of two mirrored to fill out
+// the next power of two. The header's checksum and complement are summed as if they were valid, i.e. as
+// $FFFF and $0000.
+func (r *ROM) ComputeChecksum() uint16 {
+	contents := r.Contents
+	if o := int(r.HeaderOffset) + 0x2C; o+4 <= len(contents) {
+		// sum a copy with a valid checksum and complement pair in the header:
+		contents = make([]byte, len(r.Contents))
+		copy(contents, r.Contents)
+		copy(contents[o:o+4], []byte{0xFF, 0xFF, 0x00, 0x00})
+	}
+
+	sum := mirroredSum(contents, nextPowerOfTwo(len(contents)))
+	return uint16(sum)
+}
+
+// ChecksumValid returns true if the header's checksum matches the computed checksum and its complement matches.
+func (r *ROM) ChecksumValid() bool {
+	checksum := r.ComputeChecksum()
+	return r.Header.CheckSum == checksum && r.Header.ComplementCheckSum == ^checksum
+}
+
+// FixChecksum computes the checksum and rewrites the header's checksum and complement through WriteHeader.
+func (r *ROM) FixChecksum() (err error) {
+	checksum := r.ComputeChecksum()
+	r.Header.CheckSum = checksum
+	r.Header.ComplementCheckSum = ^checksum
+	return r.WriteHeader()
+}
+
+func nextPowerOfTwo(n int) int {
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
+
+func largestPowerOfTwo(n int) int {
+	p := 1
+	for p<<1 <= n {
+		p <<= 1
+	}
+	return p
+}
+
+// mirroredSum sums data mirrored to fill size bytes, which must be a power of two at least as large as data
+func mirroredSum(data []byte, size int) (sum uint32) {
+	if len(data) == 0 {
+		return 0
+	}
+
+	p := largestPowerOfTwo(len(data))
+	for _, b := range data[:p] {
+		sum += uint32(b)
+	}
+	if p < len(data) {
+		// the remainder is mirrored to fill out the next power of two:
+		sum += mirroredSum(data[p:], p)
+		p <<= 1
+	}
+
+	return sum * uint32(size/p)
+}
+
 type alwaysError struct{}
 
 func (alwaysError) Read(p []byte) (int, error) {
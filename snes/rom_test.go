@@ -5,7 +5,9 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"io"
+	"sni/protos/sni"
 	"testing"
 )
 
@@ -134,3 +136,157 @@ func TestROM_BusWriter_Bank01_Success(t *testing.T) {
 		t.Fatal("expected NMI vector at $FFEA")
 	}
 }
+
+func TestNewROM_detect(t *testing.T) {
+	header := sampleROM()[0x7FB0:0x8000]
+	withHeader := func(size int, offset uint32, mapMode byte) []byte {
+		contents := make([]byte, size)
+		copy(contents[offset:], header)
+		contents[offset+0x25] = mapMode
+		return contents
+	}
+
+	tests := []struct {
+		name          string
+		contents      []byte
+		headerOffset  uint32
+		mapping       sni.MemoryMapping
+		copierHeader  bool
+		expectedTitle string
+	}{
+		{"LoROM", sampleROM(), 0x7FB0, sni.MemoryMapping_LoROM, false, "THE LEGEND OF ZELDA"},
+		{"HiROM", withHeader(0x10000, 0xFFB0, 0x21), 0xFFB0, sni.MemoryMapping_HiROM, false, "THE LEGEND OF ZELDA"},
+		{"ExHiROM", withHeader(0x410000, 0x40FFB0, 0x25), 0x40FFB0, sni.MemoryMapping_ExHiROM, false, "THE LEGEND OF ZELDA"},
+		{"ExLoROM", withHeader(0x408000, 0x407FB0, 0x32), 0x407FB0, sni.MemoryMapping_ExLoROM, false, "THE LEGEND OF ZELDA"},
+		{"copier LoROM", append(make([]byte, CopierHeaderSize), sampleROM()...), 0x7FB0, sni.MemoryMapping_LoROM, true, "THE LEGEND OF ZELDA"},
+		{"copier HiROM", append(make([]byte, CopierHeaderSize), withHeader(0x10000, 0xFFB0, 0x21)...), 0xFFB0, sni.MemoryMapping_HiROM, true, "THE LEGEND OF ZELDA"},
+		{"no header", make([]byte, 0x10000), 0x7FB0, sni.MemoryMapping_Unknown, false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rom, err := NewROM("", tt.contents)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rom.HeaderOffset != tt.headerOffset || rom.MemoryMapping != tt.mapping {
+				t.Fatalf("expected header at $%06x for %v; got $%06x for %v", tt.headerOffset, tt.mapping, rom.HeaderOffset, rom.MemoryMapping)
+			}
+			if (rom.CopierHeader != nil) != tt.copierHeader {
+				t.Fatalf("expected copier header %v; got %v", tt.copierHeader, rom.CopierHeader != nil)
+			}
+			if len(rom.Contents)&0x7FFF != 0 {
+				t.Fatalf("expected contents without copier header; got $%x bytes", len(rom.Contents))
+			}
+			if title := string(bytes.TrimRight(rom.Header.Title[:], " \x00")); title != tt.expectedTitle {
+				t.Fatalf("expected title %q; got %q", tt.expectedTitle, title)
+			}
+		})
+	}
+
+	if _, err := NewROM("", make([]byte, CopierHeaderSize+0x7000)); err == nil {
+		t.Fatal("expected error for ROM too small")
+	}
+}
+
+func TestROM_copierHeader(t *testing.T) {
+	rom, err := NewROM("", sampleROM())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rom.FileContents()) != 0x10000 {
+		t.Fatalf("expected no copier header; got $%x bytes", len(rom.FileContents()))
+	}
+
+	rom.AddCopierHeader()
+	file := rom.FileContents()
+	if len(file) != 0x10000+CopierHeaderSize || file[0] != 0x08 || file[1] != 0x00 {
+		t.Fatalf("unexpected copier header: %x", file[:2])
+	}
+
+	// the copier header round-trips:
+	file[2] = 0x55
+	rom, err = NewROM("", file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rom.CopierHeader == nil || rom.CopierHeader[2] != 0x55 || rom.MemoryMapping != sni.MemoryMapping_LoROM {
+		t.Fatal("expected copier header to be detected")
+	}
+
+	rom.StripCopierHeader()
+	if !bytes.Equal(rom.FileContents(), sampleROM()) {
+		t.Fatal("expected contents without copier header")
+	}
+}
+
+// mirror expands data the way the console mirrors a ROM whose size is not a power of two
+func mirror(data []byte) []byte {
+	p := 1
+	for p<<1 <= len(data) {
+		p <<= 1
+	}
+	if p == len(data) {
+		return data
+	}
+	rest := mirror(data[p:])
+	expanded := append([]byte(nil), data[:p]...)
+	for len(expanded) < p<<1 {
+		expanded = append(expanded, rest...)
+	}
+	return expanded
+}
+
+func TestROM_Checksum(t *testing.T) {
+	for _, size := range []int{0x8000, 0x10000, 0x18000, 0x1C000, 0x28000, 0x30000} {
+		t.Run(fmt.Sprintf("$%x", size), func(t *testing.T) {
+			contents := make([]byte, size)
+			for i := range contents {
+				contents[i] = byte(i*13 + i>>12)
+			}
+			copy(contents[0x7FB0:], sampleROM()[0x7FB0:0x8000])
+
+			rom, err := NewROM("", contents)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// reference sum over the mirrored contents with a valid checksum pair:
+			expanded := append([]byte(nil), mirror(contents)...)
+			copy(expanded[0x7FDC:], []byte{0xFF, 0xFF, 0x00, 0x00})
+			expected := uint16(0)
+			for _, b := range expanded {
+				expected += uint16(b)
+			}
+			if len(expanded)&(len(expanded)-1) != 0 {
+				t.Fatalf("mirrored size $%x not a power of two", len(expanded))
+			}
+
+			if actual := rom.ComputeChecksum(); actual != expected {
+				t.Fatalf("expected checksum $%04x; got $%04x", expected, actual)
+			}
+			if rom.ChecksumValid() {
+				t.Fatal("expected invalid checksum")
+			}
+
+			if err = rom.FixChecksum(); err != nil {
+				t.Fatal(err)
+			}
+			if !rom.ChecksumValid() {
+				t.Fatal("expected valid checksum after fix")
+			}
+			// the checksum does not change when its own bytes are rewritten:
+			if actual := rom.ComputeChecksum(); actual != expected {
+				t.Fatalf("expected checksum $%04x after fix; got $%04x", expected, actual)
+			}
+
+			// the fix is written to the contents:
+			reread, err := NewROM("", rom.Contents)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if reread.Header.CheckSum != expected || reread.Header.ComplementCheckSum != ^expected || !reread.ChecksumValid() {
+				t.Fatalf("expected checksum $%04x in contents; got $%04x", expected, reread.Header.CheckSum)
+			}
+		})
+	}
+}
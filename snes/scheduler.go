@@ -0,0 +1,252 @@
+package snes
+
+import (
+	"context"
+	"log"
+	"sni/util"
+	"sni/util/env"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// schedulerSlowWait is the wait time above which a client's access to a device is logged
+const schedulerSlowWait = time.Millisecond * 100
+
+type clientIDKeyType int
+
+var clientIDKey clientIDKeyType
+
+// WithClientID returns a context that identifies the client making device calls to the scheduler.
+func WithClientID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, clientIDKey, id)
+}
+
+// ClientIDFromContext returns the client ID set by WithClientID, if any.
+func ClientIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(clientIDKey).(string)
+	return id
+}
+
+var (
+	schedulersMu sync.Mutex
+	schedulers   = make(map[string]*deviceScheduler)
+
+	schedulerConfigOnce sync.Once
+	schedulerDisabled   bool
+	schedulerDebug      bool
+	schedulerWeights    map[string]int
+)
+
+func loadSchedulerConfig() {
+	schedulerDisabled = util.IsTruthy(env.GetOrDefault("SNI_SCHEDULER_DISABLE", "0"))
+	schedulerDebug = util.IsTruthy(env.GetOrDefault("SNI_DEBUG", "0"))
+	schedulerWeights = ParseSchedulerWeights(env.GetOrDefault("SNI_SCHEDULER_WEIGHTS", ""))
+}
+
+// ParseSchedulerWeights parses a comma-delimited list of client=weight pairs. Invalid pairs are ignored.
+func ParseSchedulerWeights(s string) map[string]int {
+	weights := make(map[string]int)
+	for _, pair := range strings.Split(s, ",") {
+		i := strings.LastIndex(pair, "=")
+		if i < 0 {
+			continue
+		}
+		client := strings.TrimSpace(pair[:i])
+		weight, err := strconv.Atoi(strings.TrimSpace(pair[i+1:]))
+		if client == "" || err != nil || weight <= 0 {
+			log.Printf("scheduler: ignoring invalid weight '%s'\n", pair)
+			continue
+		}
+		weights[client] = weight
+	}
+	return weights
+}
+
+func schedulerFor(key string) *deviceScheduler {
+	schedulersMu.Lock()
+	defer schedulersMu.Unlock()
+
+	s, ok := schedulers[key]
+	if !ok {
+		s = newDeviceScheduler(key, schedulerWeights)
+		schedulers[key] = s
+	}
+	return s
+}
+
+// scheduleDeviceAccess waits for the client identified in ctx to be granted exclusive access to the device and returns
+// a function that must be called to release access.
+func scheduleDeviceAccess(ctx context.Context, key string) (release func(), err error) {
+	schedulerConfigOnce.Do(loadSchedulerConfig)
+	if schedulerDisabled {
+		return func() {}, nil
+	}
+
+	return schedulerFor(key).acquire(ctx, ClientIDFromContext(ctx))
+}
+
+// deviceScheduler grants exclusive access to a device to one client at a time. Waiting clients each have their own
+// queue and the queues are served in weighted round-robin order: a client with weight N is granted up to N accesses in
+// a row before the next waiting client is served. Clients default to a weight of 1.
+type deviceScheduler struct {
+	key     string
+	weights map[string]int
+
+	mu     sync.Mutex
+	busy   bool
+	queues map[string]*clientQueue
+	// ring holds the clients with waiters in the order they are served:
+	ring    []*clientQueue
+	current *clientQueue
+	depth   int
+}
+
+type clientQueue struct {
+	id      string
+	weight  int
+	credits int
+	waiters []*schedulerWaiter
+}
+
+type schedulerWaiter struct {
+	ready    chan struct{}
+	enqueued time.Time
+}
+
+func newDeviceScheduler(key string, weights map[string]int) *deviceScheduler {
+	return &deviceScheduler{
+		key:     key,
+		weights: weights,
+		queues:  make(map[string]*clientQueue),
+	}
+}
+
+func (s *deviceScheduler) acquire(ctx context.Context, client string) (release func(), err error) {
+	release = func() { s.release() }
+
+	s.mu.Lock()
+	if !s.busy {
+		s.busy = true
+		s.mu.Unlock()
+		return
+	}
+
+	// enqueue and wait our turn:
+	q, ok := s.queues[client]
+	if !ok {
+		weight := s.weights[client]
+		if weight <= 0 {
+			weight = 1
+		}
+		q = &clientQueue{id: client, weight: weight, credits: weight}
+		s.queues[client] = q
+	}
+	if len(q.waiters) == 0 {
+		s.ring = append(s.ring, q)
+	}
+	w := &schedulerWaiter{ready: make(chan struct{}), enqueued: time.Now()}
+	q.waiters = append(q.waiters, w)
+	s.depth++
+	s.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		s.logWait(client, w)
+		return
+	case <-ctx.Done():
+	}
+
+	s.mu.Lock()
+	select {
+	case <-w.ready:
+		// we were granted access just as ctx was cancelled so pass it on:
+		s.mu.Unlock()
+		s.release()
+	default:
+		s.remove(q, w)
+		s.mu.Unlock()
+	}
+
+	return nil, ctx.Err()
+}
+
+func (s *deviceScheduler) logWait(client string, w *schedulerWaiter) {
+	wait := time.Since(w.enqueued)
+	if wait < schedulerSlowWait && !schedulerDebug {
+		return
+	}
+
+	s.mu.Lock()
+	depth := s.depth
+	s.mu.Unlock()
+	log.Printf("scheduler[%s]: client '%s' waited %v for access; %d waiting\n", s.key, client, wait.Round(time.Microsecond), depth)
+}
+
+// remove removes a cancelled waiter from its queue; s.mu must be held.
+func (s *deviceScheduler) remove(q *clientQueue, w *schedulerWaiter) {
+	for i := range q.waiters {
+		if q.waiters[i] == w {
+			q.waiters = append(q.waiters[:i], q.waiters[i+1:]...)
+			s.depth--
+			break
+		}
+	}
+	if len(q.waiters) == 0 {
+		s.removeFromRing(q)
+	}
+}
+
+// removeFromRing removes a client with no waiters from the ring; s.mu must be held.
+func (s *deviceScheduler) removeFromRing(q *clientQueue) {
+	for i := range s.ring {
+		if s.ring[i] == q {
+			s.ring = append(s.ring[:i], s.ring[i+1:]...)
+			break
+		}
+	}
+	if s.current == q {
+		s.current = nil
+	}
+	q.credits = q.weight
+	delete(s.queues, q.id)
+}
+
+func (s *deviceScheduler) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.ring) == 0 {
+		s.busy = false
+		return
+	}
+
+	// continue serving the current client while it has credits left, otherwise move on to the next client:
+	q := s.current
+	if q == nil || q.credits <= 0 {
+		if q != nil {
+			q.credits = q.weight
+			// rotate the current client to the back of the ring:
+			for i := range s.ring {
+				if s.ring[i] == q {
+					s.ring = append(append(s.ring[:i:i], s.ring[i+1:]...), q)
+					break
+				}
+			}
+		}
+		q = s.ring[0]
+		s.current = q
+	}
+
+	w := q.waiters[0]
+	q.waiters = q.waiters[1:]
+	q.credits--
+	s.depth--
+	if len(q.waiters) == 0 {
+		s.removeFromRing(q)
+	}
+
+	// access is handed over directly to the next waiter so s.busy remains set:
+	close(w.ready)
+}
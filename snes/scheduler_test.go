@@ -0,0 +1,125 @@
+package snes
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// enqueue starts a goroutine waiting for access as client and waits until it is queued
+func enqueue(t *testing.T, s *deviceScheduler, ctx context.Context, client string, granted chan<- string, wg *sync.WaitGroup) {
+	s.mu.Lock()
+	depth := s.depth
+	s.mu.Unlock()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		release, err := s.acquire(ctx, client)
+		if err != nil {
+			return
+		}
+		granted <- client
+		release()
+	}()
+
+	for deadline := time.Now().Add(time.Second); ; {
+		s.mu.Lock()
+		queued := s.depth > depth
+		s.mu.Unlock()
+		if queued {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("client %s was not queued", client)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestDeviceScheduler_weightedRoundRobin(t *testing.T) {
+	s := newDeviceScheduler("test", map[string]int{"bot": 2})
+
+	release, err := s.acquire(context.Background(), "holder")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// serialize the grants so the order is observable:
+	granted := make(chan string)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		enqueue(t, s, context.Background(), "bot", granted, &wg)
+	}
+	for i := 0; i < 2; i++ {
+		enqueue(t, s, context.Background(), "tracker", granted, &wg)
+	}
+
+	release()
+
+	order := make([]string, 0, 6)
+	for i := 0; i < 6; i++ {
+		order = append(order, <-granted)
+	}
+	wg.Wait()
+
+	want := []string{"bot", "bot", "tracker", "bot", "bot", "tracker"}
+	if !reflect.DeepEqual(order, want) {
+		t.Errorf("grant order = %v, want %v", order, want)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.busy || s.depth != 0 || len(s.ring) != 0 {
+		t.Errorf("scheduler not idle; busy=%v depth=%d ring=%d", s.busy, s.depth, len(s.ring))
+	}
+}
+
+func TestDeviceScheduler_cancel(t *testing.T) {
+	s := newDeviceScheduler("test", nil)
+
+	release, err := s.acquire(context.Background(), "holder")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	granted := make(chan string, 2)
+	wg := sync.WaitGroup{}
+	enqueue(t, s, ctx, "cancelled", granted, &wg)
+	enqueue(t, s, context.Background(), "waiting", granted, &wg)
+
+	cancel()
+	// wait for the cancelled client to leave the queue:
+	for deadline := time.Now().Add(time.Second); ; {
+		s.mu.Lock()
+		depth := s.depth
+		s.mu.Unlock()
+		if depth == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("cancelled client was not removed from the queue")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	release()
+	wg.Wait()
+
+	if got := <-granted; got != "waiting" {
+		t.Errorf("granted %s, want waiting", got)
+	}
+	if len(granted) != 0 {
+		t.Errorf("cancelled client was granted access")
+	}
+}
+
+func TestParseSchedulerWeights(t *testing.T) {
+	got := ParseSchedulerWeights("tracker=3, bot = 1,bad,neg=-1,=2")
+	want := map[string]int{"tracker": 3, "bot": 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseSchedulerWeights() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"github.com/alttpo/observable"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"log"
+	"sni/cmd/sni/config"
+	"sni/snes"
+	"strings"
+	"sync"
+)
+
+// tokens maps each accepted bearer token to the name of the app it was issued to
+var (
+	tokensMu sync.RWMutex
+	tokens   map[string]string
+)
+
+// Init configures authentication from config.yaml and reconfigures it whenever the file changes:
+//
+//	auth:
+//	  token: <shared secret accepted from any app>
+//	  apps:
+//	    <app name>: <token accepted from this app>
+//
+// Authentication is disabled when no tokens are configured.
+func Init() {
+	config.ConfigObservable.Subscribe(observable.NewObserver("auth", func(event observable.Event) {
+		v, ok := event.Value.(*viper.Viper)
+		if !ok || v == nil {
+			return
+		}
+
+		Configure(v)
+	}))
+}
+
+// Configure replaces the accepted tokens with those found in the configuration.
+func Configure(v *viper.Viper) {
+	newTokens := make(map[string]string)
+	if token := strings.TrimSpace(v.GetString("auth.token")); token != "" {
+		newTokens[token] = ""
+	}
+	for app, token := range v.GetStringMapString("auth.apps") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+		newTokens[token] = app
+	}
+
+	tokensMu.Lock()
+	wasEnabled := len(tokens) > 0
+	tokens = newTokens
+	tokensMu.Unlock()
+
+	if enabled := len(newTokens) > 0; enabled != wasEnabled {
+		if enabled {
+			log.Printf("auth: token authentication enabled\n")
+		} else {
+			log.Printf("auth: token authentication disabled\n")
+		}
+	}
+}
+
+// Enabled reports whether clients must authenticate with a token.
+func Enabled() bool {
+	tokensMu.RLock()
+	defer tokensMu.RUnlock()
+	return len(tokens) > 0
+}
+
+// Authenticate checks the token and returns the name of the app it was issued to; the shared secret has no app name.
+// Fails with codes.Unauthenticated if authentication is enabled and the token is not accepted.
+func Authenticate(token string) (app string, err error) {
+	tokensMu.RLock()
+	defer tokensMu.RUnlock()
+
+	if len(tokens) == 0 {
+		return
+	}
+	if token == "" {
+		err = snes.WithCode(codes.Unauthenticated, fmt.Errorf("missing authentication token"))
+		return
+	}
+
+	// compare against every token in constant time so as not to leak which tokens are close:
+	found := false
+	for t, a := range tokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			app, found = a, true
+		}
+	}
+	if !found {
+		err = snes.WithCode(codes.Unauthenticated, fmt.Errorf("invalid authentication token"))
+		return
+	}
+
+	return
+}
+
+// BearerToken extracts the token from an `Authorization: Bearer <token>` header value.
+func BearerToken(authorization string) string {
+	const prefix = "bearer "
+	if len(authorization) < len(prefix) || !strings.EqualFold(authorization[:len(prefix)], prefix) {
+		return ""
+	}
+	return strings.TrimSpace(authorization[len(prefix):])
+}
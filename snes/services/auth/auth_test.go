@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"errors"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"sni/snes"
+	"testing"
+)
+
+func TestAuthenticate(t *testing.T) {
+	v := viper.New()
+	Configure(v)
+	if Enabled() {
+		t.Fatal("expected authentication disabled without tokens")
+	}
+	if _, err := Authenticate(""); err != nil {
+		t.Fatalf("expected no error when disabled; got %v", err)
+	}
+
+	v.Set("auth.token", "shared")
+	v.Set("auth.apps", map[string]string{"tracker": "tracker-token"})
+	Configure(v)
+	defer Configure(viper.New())
+
+	tests := []struct {
+		name    string
+		token   string
+		wantApp string
+		wantErr bool
+	}{
+		{name: "shared", token: "shared", wantApp: ""},
+		{name: "app", token: "tracker-token", wantApp: "tracker"},
+		{name: "missing", token: "", wantErr: true},
+		{name: "invalid", token: "nope", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, err := Authenticate(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				var coded *snes.CodedError
+				if !errors.As(err, &coded) || coded.Code != codes.Unauthenticated {
+					t.Fatalf("Authenticate() error = %v, want Unauthenticated", err)
+				}
+			}
+			if app != tt.wantApp {
+				t.Errorf("Authenticate() app = %q, want %q", app, tt.wantApp)
+			}
+		})
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	tests := map[string]string{
+		"Bearer abc":  "abc",
+		"bearer  abc": "abc",
+		"Basic abc":   "",
+		"":            "",
+	}
+	for authorization, want := range tests {
+		if got := BearerToken(authorization); got != want {
+			t.Errorf("BearerToken(%q) = %q, want %q", authorization, got, want)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sni/cmd/sni/config"
+)
+
+// TLSConfig loads the certificate and key configured in config.yaml:
+//
+//	tls:
+//	  certFile: <path to PEM certificate chain>
+//	  keyFile: <path to PEM private key>
+//
+// Returns nil if TLS is not configured.
+func TLSConfig() (cfg *tls.Config, err error) {
+	certFile := config.Config.GetString("tls.certFile")
+	keyFile := config.Config.GetString("tls.keyFile")
+	if certFile == "" && keyFile == "" {
+		return nil, nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, fmt.Errorf("tls: both tls.certFile and tls.keyFile must be configured")
+	}
+
+	var cert tls.Certificate
+	cert, err = tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("tls: %w", err)
+	}
+
+	cfg = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	return
+}
+
+// WrapListener wraps lis with TLS if configured.
+func WrapListener(lis net.Listener, cfg *tls.Config) net.Listener {
+	if cfg == nil {
+		return lis
+	}
+	return tls.NewListener(lis, cfg)
+}
@@ -0,0 +1,61 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"sni/snes/services/auth"
+	"strings"
+)
+
+// authorizationMetadataKey is the request metadata header clients send their `Bearer <token>` in:
+const authorizationMetadataKey = "authorization"
+
+// healthMethodPrefix prefixes the methods of the standard health service that probes call without a token:
+const healthMethodPrefix = "/grpc.health.v1.Health/"
+
+// serverInfoMethod is called without a token by clients discovering whether they must authenticate:
+const serverInfoMethod = "/Server/ServerInfo"
+
+func authenticate(ctx context.Context, fullMethod string) error {
+	if !auth.Enabled() {
+		return nil
+	}
+	if strings.HasPrefix(fullMethod, healthMethodPrefix) || fullMethod == serverInfoMethod {
+		return nil
+	}
+
+	token := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationMetadataKey); len(values) > 0 {
+			token = auth.BearerToken(values[0])
+		}
+	}
+
+	_, err := auth.Authenticate(token)
+	return grpcError(err)
+}
+
+func authUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (rsp interface{}, err error) {
+	if err = authenticate(ctx, info.FullMethod); err != nil {
+		return
+	}
+	return handler(ctx, req)
+}
+
+func authStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	if err = authenticate(ss.Context(), info.FullMethod); err != nil {
+		return
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,65 @@
+package grpcimpl
+
+import (
+	"context"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"sni/snes/services/auth"
+	"testing"
+)
+
+// authServerStream carries the context of a stream
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context { return s.ctx }
+
+func Test_authInterceptors(t *testing.T) {
+	v := viper.New()
+	v.Set("auth.token", "shared")
+	auth.Configure(v)
+	defer auth.Configure(viper.New())
+
+	withToken := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationMetadataKey, "Bearer shared"))
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		fullMethod string
+		wantCode   codes.Code
+	}{
+		{"health check", context.Background(), "/grpc.health.v1.Health/Check", codes.OK},
+		{"health watch", context.Background(), "/grpc.health.v1.Health/Watch", codes.OK},
+		{"server info", context.Background(), "/Server/ServerInfo", codes.OK},
+		{"without token", context.Background(), "/Devices/ListDevices", codes.Unauthenticated},
+		{"with token", withToken, "/Devices/ListDevices", codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := authUnaryInterceptor(
+				tt.ctx,
+				nil,
+				&grpc.UnaryServerInfo{FullMethod: tt.fullMethod},
+				func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil },
+			)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("authUnaryInterceptor() code = %v, want %v", got, tt.wantCode)
+			}
+
+			err = authStreamInterceptor(
+				nil,
+				&authServerStream{ctx: tt.ctx},
+				&grpc.StreamServerInfo{FullMethod: tt.fullMethod},
+				func(srv interface{}, stream grpc.ServerStream) error { return nil },
+			)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("authStreamInterceptor() code = %v, want %v", got, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"sni/snes"
+)
+
+// clientNameMetadataKey is the optional request metadata header clients use to name themselves to the device
+// scheduler; otherwise clients are identified by their peer address.
+const clientNameMetadataKey = "sni-client-name"
+
+// contextServerStream replaces the context of a grpc.ServerStream
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context { return s.ctx }
+
+// clientContext identifies the client to the device scheduler.
+func clientContext(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if names := md.Get(clientNameMetadataKey); len(names) > 0 && names[0] != "" {
+			return snes.WithClientID(ctx, names[0])
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		return snes.WithClientID(ctx, p.Addr.String())
+	}
+
+	return ctx
+}
+
+func clientUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (rsp interface{}, err error) {
+	return handler(clientContext(ctx), req)
+}
+
+func clientStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	return handler(srv, &contextServerStream{ServerStream: ss, ctx: clientContext(ss.Context())})
+}
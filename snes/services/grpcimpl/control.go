@@ -130,3 +130,35 @@ func (d *DeviceControlService) PauseToggleEmulation(gctx context.Context, reques
 
 	return
 }
+
+func (d *DeviceControlService) ExecuteASM(gctx context.Context, request *sni.ExecuteASMRequest) (grsp *sni.ExecuteASMResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(request.GetCode()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "code must not be empty")
+	}
+
+	var driver snes.Driver
+	var device snes.AutoCloseableDevice
+	driver, device, gerr = snes.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ExecuteASM); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	gerr = device.ExecuteASM(gctx, request.GetCode(), request.GetData())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.ExecuteASMResponse{
+		Uri: request.Uri,
+	}
+
+	return
+}
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"google.golang.org/grpc/status"
-	"sni/cmd/sni/tray"
 	"sni/protos/sni"
 	"sni/snes"
 )
@@ -22,50 +21,92 @@ type DevicesService struct {
 	sni.UnimplementedDevicesServer
 }
 
-func (s *DevicesService) ListDevices(ctx context.Context, request *sni.DevicesRequest) (*sni.DevicesResponse, error) {
-	descriptors := make([]snes.DeviceDescriptor, 0, 10)
-	for _, driver := range snes.Drivers() {
-		d, err := driver.Driver.Detect()
-		if err != nil {
-			return nil, err
-		}
-		descriptors = append(descriptors, d...)
+func kindPredicate(kinds []string) func(kind string) bool {
+	if kinds == nil {
+		return func(kind string) bool { return true }
 	}
 
-	go tray.UpdateDeviceList(descriptors)
-
-	var kindPredicate func(kind string) bool
-	if request.GetKinds() == nil {
-		kindPredicate = func(kind string) bool { return true }
-	} else {
-		kindPredicate = func(kind string) bool {
-			for _, k := range request.GetKinds() {
-				if kind == k {
-					return true
-				}
+	return func(kind string) bool {
+		for _, k := range kinds {
+			if kind == k {
+				return true
 			}
-			return false
 		}
+		return false
+	}
+}
+
+func deviceFromDescriptor(descriptor *snes.DeviceDescriptor) *sni.DevicesResponse_Device {
+	return &sni.DevicesResponse_Device{
+		Uri:                 descriptor.Uri.String(),
+		DisplayName:         descriptor.DisplayName,
+		Kind:                descriptor.Kind,
+		Capabilities:        descriptor.Capabilities,
+		DefaultAddressSpace: descriptor.DefaultAddressSpace,
 	}
+}
+
+func (s *DevicesService) ListDevices(ctx context.Context, request *sni.DevicesRequest) (*sni.DevicesResponse, error) {
+	descriptors, err := snes.DetectDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	predicate := kindPredicate(request.GetKinds())
 
 	devices := make([]*sni.DevicesResponse_Device, 0, 10)
-	for _, descriptor := range descriptors {
-		if !kindPredicate(descriptor.Kind) {
+	for i := range descriptors {
+		if !predicate(descriptors[i].Kind) {
 			continue
 		}
 
-		devices = append(devices, &sni.DevicesResponse_Device{
-			Uri:                 descriptor.Uri.String(),
-			DisplayName:         descriptor.DisplayName,
-			Kind:                descriptor.Kind,
-			Capabilities:        descriptor.Capabilities,
-			DefaultAddressSpace: descriptor.DefaultAddressSpace,
-		})
+		devices = append(devices, deviceFromDescriptor(&descriptors[i]))
 	}
 
 	return &sni.DevicesResponse{Devices: devices}, nil
 }
 
+func (s *DevicesService) WatchDevices(request *sni.WatchDevicesRequest, stream sni.Devices_WatchDevicesServer) error {
+	predicate := kindPredicate(request.GetKinds())
+
+	descriptors, sub := snes.SubscribeDeviceEvents()
+	defer sub.Close()
+
+	// send the currently known devices first:
+	events := make([]snes.DeviceEvent, 0, len(descriptors))
+	for _, descriptor := range descriptors {
+		events = append(events, snes.DeviceEvent{Type: snes.DeviceAdded, Device: descriptor})
+	}
+
+	for {
+		gevents := make([]*sni.WatchDevicesResponse_Event, 0, len(events))
+		for i := range events {
+			if !predicate(events[i].Device.Kind) {
+				continue
+			}
+
+			gevents = append(gevents, &sni.WatchDevicesResponse_Event{
+				Type:   sni.DeviceEventType(events[i].Type),
+				Device: deviceFromDescriptor(&events[i].Device),
+			})
+		}
+
+		if len(gevents) > 0 {
+			err := stream.Send(&sni.WatchDevicesResponse{Events: gevents})
+			if err != nil {
+				return err
+			}
+		}
+
+		var err error
+		events, err = sub.Next(stream.Context())
+		if err != nil {
+			// client went away:
+			return nil
+		}
+	}
+}
+
 func (s *DevicesService) MethodRequestString(method string, req interface{}) string {
 	if req == nil {
 		return "nil"
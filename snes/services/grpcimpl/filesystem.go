@@ -5,9 +5,12 @@ import (
 	"context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"hash/crc32"
+	"io"
 	"net/url"
 	"sni/protos/sni"
 	"sni/snes"
+	"sni/snes/patch"
 )
 
 type DeviceFilesystem struct {
@@ -181,6 +184,62 @@ func (d *DeviceFilesystem) PutFile(ctx context.Context, request *sni.PutFileRequ
 	return
 }
 
+func (d *DeviceFilesystem) PatchAndPutFile(ctx context.Context, request *sni.PatchAndPutFileRequest) (grsp *sni.PatchAndPutFileResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// parse and apply the patches before touching the device:
+	patches := make([]patch.Patch, 0, len(request.GetPatches()))
+	for i, data := range request.GetPatches() {
+		var p patch.Patch
+		p, err = patch.Parse(data)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "patches[%d]: %v", i, err)
+		}
+		patches = append(patches, p)
+	}
+
+	var patched []byte
+	patched, err = patch.Apply(request.GetData(), patches...)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver snes.Driver
+	var device snes.AutoCloseableDevice
+	driver, device, gerr = snes.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_PutFile); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var n uint32
+	n, gerr = device.PutFile(
+		ctx,
+		request.GetPath(),
+		uint32(len(patched)),
+		bytes.NewReader(patched),
+		nil,
+	)
+	if gerr != nil {
+		return
+	}
+
+	// translate response:
+	grsp = &sni.PatchAndPutFileResponse{
+		Uri:   request.Uri,
+		Path:  request.Path,
+		Size:  n,
+		Crc32: crc32.ChecksumIEEE(patched),
+	}
+	return
+}
+
 func (d *DeviceFilesystem) GetFile(ctx context.Context, request *sni.GetFileRequest) (grsp *sni.GetFileResponse, gerr error) {
 	uri, err := url.Parse(request.GetUri())
 	if err != nil {
@@ -220,6 +279,190 @@ func (d *DeviceFilesystem) GetFile(ctx context.Context, request *sni.GetFileRequ
 	return
 }
 
+func (d *DeviceFilesystem) PutFileStream(stream sni.DeviceFilesystem_PutFileStreamServer) (gerr error) {
+	ctx := stream.Context()
+
+	// the first request identifies the device, path, and size:
+	request, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver snes.Driver
+	var device snes.AutoCloseableDevice
+	driver, device, gerr = snes.DeviceByUri(uri)
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_PutFile); err != nil {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	size := request.GetSize()
+	if uint32(len(request.GetData())) > size {
+		return status.Errorf(codes.InvalidArgument, "data exceeds size; %d > %d", len(request.GetData()), size)
+	}
+
+	r := &putFileStreamReader{
+		stream:    stream,
+		chunk:     request.GetData(),
+		remaining: size,
+	}
+
+	var sendErr error
+	send := func(size, sent uint32) {
+		if sendErr != nil {
+			return
+		}
+		sendErr = stream.Send(&sni.PutFileStreamResponse{
+			Uri:  request.Uri,
+			Path: request.Path,
+			Size: size,
+			Sent: sent,
+		})
+	}
+
+	// progress is reported at most every putFileStreamProgressSize bytes; completion is reported below:
+	var reported uint32
+	var n uint32
+	n, gerr = device.PutFile(ctx, request.GetPath(), size, r, func(current uint32, total uint32) {
+		if current < total && current-reported >= putFileStreamProgressSize {
+			reported = current
+			send(total, current)
+		}
+	})
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+
+	send(n, n)
+	return sendErr
+}
+
+// putFileStreamProgressSize is the least data sent to the device between PutFileStream progress responses
+const putFileStreamProgressSize = 64 * 1024
+
+// putFileStreamReader reads file data from the chunks of a PutFileStream. It fails if the client ends the stream
+// before size bytes are sent or sends more than size bytes. Reads fill p across chunk boundaries because device
+// drivers treat a short read as the end of the file.
+type putFileStreamReader struct {
+	stream    sni.DeviceFilesystem_PutFileStreamServer
+	chunk     []byte
+	remaining uint32
+}
+
+func (r *putFileStreamReader) Read(p []byte) (n int, err error) {
+	for n < len(p) {
+		if len(r.chunk) == 0 {
+			if r.remaining == 0 {
+				break
+			}
+
+			var request *sni.PutFileStreamRequest
+			request, err = r.stream.Recv()
+			if err == io.EOF {
+				return n, status.Errorf(codes.InvalidArgument, "stream ended with %d bytes remaining", r.remaining)
+			}
+			if err != nil {
+				return n, err
+			}
+			if uint32(len(request.GetData())) > r.remaining {
+				return n, status.Errorf(codes.InvalidArgument, "data exceeds size by %d bytes", uint32(len(request.GetData()))-r.remaining)
+			}
+			r.chunk = request.GetData()
+			continue
+		}
+
+		c := copy(p[n:], r.chunk)
+		r.chunk = r.chunk[c:]
+		r.remaining -= uint32(c)
+		n += c
+	}
+
+	if n == 0 && len(p) > 0 {
+		err = io.EOF
+	}
+	return
+}
+
+// getFileStreamChunkSize is the most data sent in each GetFileStreamResponse
+const getFileStreamChunkSize = 64 * 1024
+
+func (d *DeviceFilesystem) GetFileStream(request *sni.GetFileStreamRequest, stream sni.DeviceFilesystem_GetFileStreamServer) (gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver snes.Driver
+	var device snes.AutoCloseableDevice
+	driver, device, gerr = snes.DeviceByUri(uri)
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_GetFile); err != nil {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	// data is buffered up to getFileStreamChunkSize and sent along with the progress:
+	data := make([]byte, 0, getFileStreamChunkSize)
+	var sendErr error
+	send := func(size, received uint32) {
+		if sendErr != nil {
+			return
+		}
+		sendErr = stream.Send(&sni.GetFileStreamResponse{
+			Uri:      request.Uri,
+			Path:     request.Path,
+			Size:     size,
+			Received: received,
+			Data:     data,
+		})
+		data = make([]byte, 0, getFileStreamChunkSize)
+	}
+
+	w := writerFunc(func(p []byte) (int, error) {
+		// fail the write to abort the transfer once the client is gone:
+		if sendErr != nil {
+			return 0, sendErr
+		}
+		data = append(data, p...)
+		return len(p), nil
+	})
+
+	_, gerr = device.GetFile(
+		stream.Context(),
+		request.GetPath(),
+		w,
+		func(size uint32) {
+			send(size, 0)
+		},
+		func(current uint32, total uint32) {
+			if len(data) >= getFileStreamChunkSize || (current == total && len(data) > 0) {
+				send(total, current)
+			}
+		},
+	)
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+
+	return sendErr
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
+
 func (d *DeviceFilesystem) BootFile(ctx context.Context, request *sni.BootFileRequest) (grsp *sni.BootFileResponse, gerr error) {
 	uri, err := url.Parse(request.GetUri())
 	if err != nil {
@@ -0,0 +1,135 @@
+package grpcimpl
+
+import (
+	"bytes"
+	"context"
+	"google.golang.org/grpc"
+	"io"
+	"net/url"
+	"os"
+	"reflect"
+	"sni/protos/sni"
+	"sni/snes"
+	"sni/snes/drivers/fxpakpro"
+	"sync"
+	"testing"
+)
+
+var registerSimulator sync.Once
+
+// initSimulator registers the fxpakpro driver with the simulator enabled
+func initSimulator() {
+	_ = os.Setenv("SNI_FXPAKPRO_SIM", "1")
+	defer os.Unsetenv("SNI_FXPAKPRO_SIM")
+	fxpakpro.DriverInit()
+}
+
+// putFileStreamServer replays requests to PutFileStream
+type putFileStreamServer struct {
+	grpc.ServerStream
+	requests  []*sni.PutFileStreamRequest
+	responses []*sni.PutFileStreamResponse
+}
+
+func (s *putFileStreamServer) Context() context.Context { return context.Background() }
+
+func (s *putFileStreamServer) Recv() (*sni.PutFileStreamRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	request := s.requests[0]
+	s.requests = s.requests[1:]
+	return request, nil
+}
+
+func (s *putFileStreamServer) Send(response *sni.PutFileStreamResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func TestDeviceFilesystem_PutFileStream_oddChunks(t *testing.T) {
+	registerSimulator.Do(initSimulator)
+
+	const uri = "fxpakpro://sim"
+	data := make([]byte, 4000)
+	for i := range data {
+		data[i] = byte(i*7 + 1)
+	}
+
+	// chunk sizes that are not multiples of the fxpakpro's 512 byte serial blocks:
+	requests := []*sni.PutFileStreamRequest{
+		{Uri: uri, Path: "odd.sfc", Size: uint32(len(data))},
+	}
+	for offset, size := 0, 1; offset < len(data); offset, size = offset+size, size*3+2 {
+		end := offset + size
+		if end > len(data) {
+			end = len(data)
+		}
+		requests = append(requests, &sni.PutFileStreamRequest{Data: data[offset:end]})
+	}
+
+	stream := &putFileStreamServer{requests: requests}
+	if err := (&DeviceFilesystem{}).PutFileStream(stream); err != nil {
+		t.Fatal(err)
+	}
+	if len(stream.responses) != 1 || stream.responses[0].Size != uint32(len(data)) || stream.responses[0].Sent != uint32(len(data)) {
+		t.Fatalf("expected one response with size %d; got %v", len(data), stream.responses)
+	}
+
+	u, _ := url.Parse(uri)
+	_, device, err := snes.DeviceByUri(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := &bytes.Buffer{}
+	if _, err = device.GetFile(context.Background(), "odd.sfc", w, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(w.Bytes(), data) {
+		t.Fatal("file contents do not match the streamed data")
+	}
+}
+
+func TestDeviceFilesystem_PutFileStream_progress(t *testing.T) {
+	registerSimulator.Do(initSimulator)
+
+	const size = 200 * 1024
+	requests := []*sni.PutFileStreamRequest{
+		{Uri: "fxpakpro://sim", Path: "progress.sfc", Size: size},
+	}
+	for offset := 0; offset < size; offset += 10000 {
+		end := offset + 10000
+		if end > size {
+			end = size
+		}
+		requests = append(requests, &sni.PutFileStreamRequest{Data: make([]byte, end-offset)})
+	}
+
+	stream := &putFileStreamServer{requests: requests}
+	if err := (&DeviceFilesystem{}).PutFileStream(stream); err != nil {
+		t.Fatal(err)
+	}
+
+	// one progress response per 64KiB sent and one once the upload completes:
+	var sent []uint32
+	for _, response := range stream.responses {
+		if response.Size != size {
+			t.Fatalf("expected size %d; got %d", size, response.Size)
+		}
+		sent = append(sent, response.Sent)
+	}
+	if expected := []uint32{0x10000, 0x20000, 0x30000, size}; !reflect.DeepEqual(sent, expected) {
+		t.Fatalf("expected sent %v; got %v", expected, sent)
+	}
+}
+
+func TestDeviceFilesystem_PutFileStream_shortStream(t *testing.T) {
+	registerSimulator.Do(initSimulator)
+
+	stream := &putFileStreamServer{requests: []*sni.PutFileStreamRequest{
+		{Uri: "fxpakpro://sim", Path: "short.sfc", Size: 1000, Data: make([]byte, 600)},
+	}}
+	if err := (&DeviceFilesystem{}).PutFileStream(stream); err == nil {
+		t.Fatal("expected error when the stream ends early")
+	}
+}
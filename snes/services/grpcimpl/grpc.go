@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 	"log"
 	"net"
 	"sni/cmd/sni/config"
 	"sni/protos/sni"
+	"sni/snes/services/auth"
 	"sni/util"
 	"sni/util/env"
 	"strconv"
@@ -22,9 +25,33 @@ var (
 	ListenHost string
 	ListenPort int
 	ListenAddr string
+	ListenTLS  bool
 	GrpcServer *grpc.Server
 )
 
+var (
+	unaryInterceptors  = []grpc.UnaryServerInterceptor{metricsUnaryInterceptor, logTimingInterceptor, authUnaryInterceptor, clientUnaryInterceptor, leaseUnaryInterceptor}
+	streamInterceptors = []grpc.StreamServerInterceptor{metricsStreamInterceptor, reportErrorStreamInterceptor, authStreamInterceptor, clientStreamInterceptor, leaseStreamInterceptor}
+)
+
+// service pairs a gRPC service description with its implementation
+type service struct {
+	desc *grpc.ServiceDesc
+	impl interface{}
+}
+
+// services are served by both the gRPC server and the gRPC-Web gateway
+var services = []service{
+	{&sni.Devices_ServiceDesc, &DevicesService{}},
+	{&sni.DeviceMemory_ServiceDesc, &DeviceMemoryService{}},
+	{&sni.DeviceControl_ServiceDesc, &DeviceControlService{}},
+	{&sni.DeviceFilesystem_ServiceDesc, &DeviceFilesystem{}},
+	{&sni.DeviceInfo_ServiceDesc, &DeviceInfoService{}},
+	{&sni.DeviceLease_ServiceDesc, &DeviceLeaseService{}},
+	{&sni.Server_ServiceDesc, &ServerService{}},
+	{&grpc_health_v1.Health_ServiceDesc, healthServer},
+}
+
 func StartGrpcServer() {
 	var err error
 
@@ -39,24 +66,39 @@ func StartGrpcServer() {
 		ListenPort = 8191
 	}
 
+	tlsConfig, err := auth.TLSConfig()
+	if err != nil {
+		log.Fatalf("grpc: %v", err)
+	}
+
 	ListenAddr = net.JoinHostPort(ListenHost, strconv.Itoa(ListenPort))
 	lc := &net.ListenConfig{Control: util.ReusePortControl}
 	lis, err := lc.Listen(context.Background(), "tcp", ListenAddr)
 	if err != nil {
 		log.Fatalf("grpc: failed to listen: %v", err)
 	}
-	log.Printf("grpc: listening on %s\n", ListenAddr)
+	ListenTLS = tlsConfig != nil
+	if tlsConfig != nil {
+		log.Printf("grpc: listening on %s with TLS\n", ListenAddr)
+	} else {
+		log.Printf("grpc: listening on %s\n", ListenAddr)
+	}
 
 	// start gRPC server:
-	GrpcServer = grpc.NewServer(
-		grpc.ChainUnaryInterceptor(logTimingInterceptor),
-		grpc.ChainStreamInterceptor(reportErrorStreamInterceptor),
-	)
-	sni.RegisterDevicesServer(GrpcServer, &DevicesService{})
-	sni.RegisterDeviceMemoryServer(GrpcServer, &DeviceMemoryService{})
-	sni.RegisterDeviceControlServer(GrpcServer, &DeviceControlService{})
-	sni.RegisterDeviceFilesystemServer(GrpcServer, &DeviceFilesystem{})
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+		grpc.StatsHandler(connStatsHandler{}),
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	GrpcServer = grpc.NewServer(opts...)
+	for _, svc := range services {
+		GrpcServer.RegisterService(svc.desc, svc.impl)
+	}
 	reflection.Register(GrpcServer)
+	setServing()
 
 	go func() {
 		if err := GrpcServer.Serve(lis); err != nil {
@@ -0,0 +1,68 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"sni/protos/sni"
+	"sni/snes"
+)
+
+type DeviceInfoService struct {
+	sni.UnimplementedDeviceInfoServer
+}
+
+func (d *DeviceInfoService) FetchFields(gctx context.Context, request *sni.FieldsRequest) (grsp *sni.FieldsResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver snes.Driver
+	var device snes.AutoCloseableDevice
+	driver, device, gerr = snes.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_FetchFields); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	fields := make([]snes.Field, 0, len(request.GetFields()))
+	for _, field := range request.GetFields() {
+		fields = append(fields, snes.Field(field))
+	}
+
+	var values []snes.FieldValue
+	values, gerr = device.FetchFields(gctx, fields...)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+	if actual, expected := len(values), len(fields); actual != expected {
+		gerr = status.Errorf(
+			codes.Internal,
+			"fetch fields must return one value per requested field; actual %d expected %d",
+			actual,
+			expected,
+		)
+		return
+	}
+
+	gvalues := make([]*sni.FieldsResponse_Value, 0, len(values))
+	for i, value := range values {
+		gvalues = append(gvalues, &sni.FieldsResponse_Value{
+			Field:       request.Fields[i],
+			Value:       value.Value,
+			Unsupported: value.Unsupported,
+		})
+	}
+
+	grsp = &sni.FieldsResponse{
+		Uri:    request.Uri,
+		Values: gvalues,
+	}
+
+	return
+}
@@ -0,0 +1,131 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"sni/protos/sni"
+	"sni/snes"
+	"time"
+)
+
+// leaseIDMetadataKey is the request metadata header clients use to identify themselves as a lease holder:
+const leaseIDMetadataKey = "sni-lease-id"
+
+type DeviceLeaseService struct {
+	sni.UnimplementedDeviceLeaseServer
+}
+
+func (s *DeviceLeaseService) AcquireLease(gctx context.Context, request *sni.AcquireLeaseRequest) (grsp *sni.AcquireLeaseResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var device snes.AutoCloseableDevice
+	_, device, gerr = snes.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	var lease snes.Lease
+	lease, gerr = snes.AcquireLease(device, time.Duration(request.GetDurationMilliseconds())*time.Millisecond)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.AcquireLeaseResponse{
+		Uri:                  request.Uri,
+		LeaseId:              lease.ID,
+		DurationMilliseconds: uint32(lease.Duration.Milliseconds()),
+	}
+
+	return
+}
+
+func (s *DeviceLeaseService) RenewLease(gctx context.Context, request *sni.RenewLeaseRequest) (grsp *sni.RenewLeaseResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var device snes.AutoCloseableDevice
+	_, device, gerr = snes.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	var lease snes.Lease
+	lease, gerr = snes.RenewLease(device, request.GetLeaseId(), time.Duration(request.GetDurationMilliseconds())*time.Millisecond)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.RenewLeaseResponse{
+		Uri:                  request.Uri,
+		LeaseId:              lease.ID,
+		DurationMilliseconds: uint32(lease.Duration.Milliseconds()),
+	}
+
+	return
+}
+
+func (s *DeviceLeaseService) ReleaseLease(gctx context.Context, request *sni.ReleaseLeaseRequest) (grsp *sni.ReleaseLeaseResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var device snes.AutoCloseableDevice
+	_, device, gerr = snes.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	gerr = snes.ReleaseLease(device, request.GetLeaseId())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.ReleaseLeaseResponse{
+		Uri: request.Uri,
+	}
+
+	return
+}
+
+// leaseContext attaches the lease ID from the request metadata, if any, to the context.
+func leaseContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	ids := md.Get(leaseIDMetadataKey)
+	if len(ids) == 0 {
+		return ctx
+	}
+
+	return snes.WithLeaseID(ctx, ids[0])
+}
+
+func leaseUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (rsp interface{}, err error) {
+	return handler(leaseContext(ctx), req)
+}
+
+func leaseStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	return handler(srv, &contextServerStream{ServerStream: ss, ctx: leaseContext(ss.Context())})
+}
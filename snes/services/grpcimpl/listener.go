@@ -0,0 +1,25 @@
+package grpcimpl
+
+import "sync"
+
+// listenerState records where a server is listening. It is guarded since ServerInfo may read it while the server is
+// starting.
+type listenerState struct {
+	mu        sync.Mutex
+	addr      string
+	tls       bool
+	listening bool
+}
+
+func (s *listenerState) set(addr string, tls bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addr, s.tls, s.listening = addr, tls, true
+}
+
+// get returns the address the server is listening on, whether it requires TLS and whether it is listening at all.
+func (s *listenerState) get() (addr string, tls bool, listening bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addr, s.tls, s.listening
+}
@@ -1,6 +1,7 @@
 package grpcimpl
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"google.golang.org/grpc/codes"
@@ -10,6 +11,7 @@ import (
 	"sni/protos/sni"
 	"sni/snes"
 	"sni/snes/mapping"
+	"sni/snes/patch"
 	"strings"
 )
 
@@ -384,6 +386,407 @@ func (s *DeviceMemoryService) StreamWrite(stream sni.DeviceMemory_StreamWriteSer
 	}
 }
 
+func (s *DeviceMemoryService) WatchMemory(
+	request *sni.WatchMemoryRequest,
+	stream sni.DeviceMemory_WatchMemoryServer,
+) (gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(request.GetRequests()) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one memory segment must be requested to watch")
+	}
+
+	var driver snes.Driver
+	var device snes.AutoCloseableDevice
+	driver, device, gerr = snes.DeviceByUri(uri)
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory); err != nil {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	reads := make([]snes.MemoryReadRequest, 0, len(request.Requests))
+	for _, req := range request.Requests {
+		reads = append(reads, snes.MemoryReadRequest{
+			RequestAddress: snes.AddressTuple{
+				Address:       req.GetRequestAddress(),
+				AddressSpace:  req.GetRequestAddressSpace(),
+				MemoryMapping: req.GetRequestMemoryMapping(),
+			},
+			Size: int(req.GetSize()),
+		})
+	}
+
+	sub := snes.WatchMemory(device, int(request.GetIntervalFrames()), reads...)
+	defer sub.Close()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case changes, ok := <-sub.Updates():
+			if !ok {
+				return grpcError(sub.Err())
+			}
+
+			gchanges := make([]*sni.WatchedMemoryChange, 0, len(changes))
+			for _, c := range changes {
+				diffs := make([]*sni.MemoryDiff, 0, len(c.Diffs))
+				for _, d := range c.Diffs {
+					diffs = append(diffs, &sni.MemoryDiff{
+						Offset: uint32(d.Offset),
+						Data:   d.Data,
+					})
+				}
+
+				gchanges = append(gchanges, &sni.WatchedMemoryChange{
+					Index:                uint32(c.Index),
+					RequestAddress:       c.Response.RequestAddress.Address,
+					RequestAddressSpace:  c.Response.RequestAddress.AddressSpace,
+					RequestMemoryMapping: c.Response.RequestAddress.MemoryMapping,
+					DeviceAddress:        c.Response.DeviceAddress.Address,
+					DeviceAddressSpace:   c.Response.DeviceAddress.AddressSpace,
+					Diffs:                diffs,
+				})
+			}
+
+			err = stream.Send(&sni.WatchMemoryResponse{
+				Uri:     request.Uri,
+				Changes: gchanges,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *DeviceMemoryService) ConditionalWrite(
+	gctx context.Context,
+	request *sni.ConditionalWriteMemoryRequest,
+) (grsp *sni.ConditionalWriteMemoryResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver snes.Driver
+	var device snes.AutoCloseableDevice
+	driver, device, gerr = snes.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory, sni.DeviceCapability_WriteMemory); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	conditions := make([]snes.MemoryCondition, 0, len(request.Conditions))
+	for _, c := range request.Conditions {
+		conditions = append(conditions, snes.MemoryCondition{
+			RequestAddress: snes.AddressTuple{
+				Address:       c.GetRequestAddress(),
+				AddressSpace:  c.GetRequestAddressSpace(),
+				MemoryMapping: c.GetRequestMemoryMapping(),
+			},
+			Expected: c.GetExpected(),
+			Mask:     c.GetMask(),
+		})
+	}
+
+	writes := make([]snes.MemoryWriteRequest, 0, len(request.Writes))
+	for _, req := range request.Writes {
+		writes = append(writes, snes.MemoryWriteRequest{
+			RequestAddress: snes.AddressTuple{
+				Address:       req.GetRequestAddress(),
+				AddressSpace:  req.GetRequestAddressSpace(),
+				MemoryMapping: req.GetRequestMemoryMapping(),
+			},
+			Data: req.Data,
+		})
+	}
+
+	var crsp snes.ConditionalWriteResponse
+	crsp, gerr = device.ConditionalWriteMemory(gctx, conditions, writes)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	failed := make([]uint32, 0, len(crsp.FailedConditions))
+	for _, i := range crsp.FailedConditions {
+		failed = append(failed, uint32(i))
+	}
+
+	grsps := make([]*sni.WriteMemoryResponse, 0, len(crsp.Writes))
+	for _, mrsp := range crsp.Writes {
+		grsps = append(grsps, &sni.WriteMemoryResponse{
+			RequestAddress:       mrsp.RequestAddress.Address,
+			RequestAddressSpace:  mrsp.RequestAddress.AddressSpace,
+			RequestMemoryMapping: mrsp.RequestAddress.MemoryMapping,
+			DeviceAddress:        mrsp.DeviceAddress.Address,
+			DeviceAddressSpace:   mrsp.DeviceAddress.AddressSpace,
+			Size:                 uint32(mrsp.Size),
+		})
+	}
+
+	grsp = &sni.ConditionalWriteMemoryResponse{
+		Uri:              request.Uri,
+		Written:          crsp.Written,
+		FailedConditions: failed,
+		Responses:        grsps,
+	}
+
+	return
+}
+
+func (s *DeviceMemoryService) PatchMemory(
+	gctx context.Context,
+	request *sni.PatchMemoryRequest,
+) (grsp *sni.PatchMemoryResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var ips *patch.IPS
+	ips, err = patch.ParseIPS(request.GetIps())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver snes.Driver
+	var device snes.AutoCloseableDevice
+	driver, device, gerr = snes.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_WriteMemory); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var mrsps []snes.MemoryWriteResponse
+	mrsps, gerr = device.MultiWriteMemory(
+		gctx,
+		ips.MemoryWriteRequests(request.GetRequestAddressSpace(), request.GetRequestMemoryMapping())...,
+	)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsps := make([]*sni.WriteMemoryResponse, 0, len(mrsps))
+	for _, mrsp := range mrsps {
+		grsps = append(grsps, &sni.WriteMemoryResponse{
+			RequestAddress:       mrsp.RequestAddress.Address,
+			RequestAddressSpace:  mrsp.RequestAddress.AddressSpace,
+			RequestMemoryMapping: mrsp.RequestAddress.MemoryMapping,
+			DeviceAddress:        mrsp.DeviceAddress.Address,
+			DeviceAddressSpace:   mrsp.DeviceAddress.AddressSpace,
+			Size:                 uint32(mrsp.Size),
+		})
+	}
+
+	grsp = &sni.PatchMemoryResponse{
+		Uri:       request.Uri,
+		Responses: grsps,
+	}
+	return
+}
+
+func (s *DeviceMemoryService) ValidateROM(
+	gctx context.Context,
+	request *sni.ValidateROMRequest,
+) (grsp *sni.ValidateROMResponse, gerr error) {
+	var rom *snes.ROM
+	var err error
+
+	var device snes.AutoCloseableDevice
+	var memoryMapping sni.MemoryMapping
+	if len(request.GetData()) > 0 {
+		// validate the uploaded ROM file:
+		if rom, err = snes.NewROM("", request.GetData()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	} else {
+		// read the ROM back from the device:
+		if request.GetFix() && request.GetSize() == 0 {
+			// a ROM smaller than its declared size is only detected heuristically so do not write a checksum based on
+			// a guessed size into the running ROM:
+			return nil, status.Error(codes.InvalidArgument, "size is required to fix the checksum of a ROM on a device")
+		}
+
+		var uri *url.URL
+		uri, err = url.Parse(request.GetUri())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		var driver snes.Driver
+		driver, device, gerr = snes.DeviceByUri(uri)
+		if gerr != nil {
+			return nil, grpcError(gerr)
+		}
+
+		capabilities := []sni.DeviceCapability{sni.DeviceCapability_ReadMemory}
+		if request.GetFix() {
+			capabilities = append(capabilities, sni.DeviceCapability_WriteMemory)
+		}
+		if _, err := driver.HasCapabilities(capabilities...); err != nil {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+
+		if request.MemoryMapping != nil {
+			memoryMapping = request.GetMemoryMapping()
+		} else {
+			memoryMapping, _, _, gerr = mapping.Detect(gctx, device, nil, nil)
+			if gerr != nil {
+				return nil, grpcError(gerr)
+			}
+		}
+
+		rom, gerr = readROM(gctx, device, memoryMapping, request.GetSize())
+		if gerr != nil {
+			return nil, grpcError(gerr)
+		}
+	}
+
+	computed := rom.ComputeChecksum()
+	grsp = &sni.ValidateROMResponse{
+		Uri:              request.GetUri(),
+		MemoryMapping:    rom.MemoryMapping,
+		HeaderOffset:     rom.HeaderOffset,
+		CopierHeader:     rom.CopierHeader != nil,
+		Size:             uint32(len(rom.Contents)),
+		HeaderChecksum:   uint32(rom.Header.CheckSum),
+		HeaderComplement: uint32(rom.Header.ComplementCheckSum),
+		ComputedChecksum: uint32(computed),
+		Valid:            rom.ChecksumValid(),
+	}
+
+	if !request.GetFix() {
+		return
+	}
+	if err = rom.FixChecksum(); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if device == nil {
+		grsp.Data = rom.FileContents()
+		return
+	}
+
+	// write the checksum and complement back to the device:
+	o := rom.HeaderOffset + 0x2C
+	_, gerr = device.MultiWriteMemory(gctx, snes.MemoryWriteRequest{
+		RequestAddress: snes.AddressTuple{
+			Address:       o,
+			AddressSpace:  sni.AddressSpace_FxPakPro,
+			MemoryMapping: memoryMapping,
+		},
+		Data: rom.Contents[o : o+4],
+	})
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+	return
+}
+
+// readROM reads the ROM contents from the device's memory. If size is zero, the size declared in the ROM header at
+// $00:FFB0 is read instead. The declared size is a power of two so a smaller ROM is followed by whatever the device
+// has mapped after it; unless the ROM is valid at the declared size, a blank or mirrored tail is then trimmed off.
+func readROM(ctx context.Context, device snes.DeviceMemory, memoryMapping sni.MemoryMapping, size uint32) (rom *snes.ROM, err error) {
+	declared := size == 0
+	if declared {
+		var mrsp []snes.MemoryReadResponse
+		mrsp, err = device.MultiReadMemory(ctx, snes.MemoryReadRequest{
+			RequestAddress: snes.AddressTuple{
+				Address:       0x00FFB0,
+				AddressSpace:  sni.AddressSpace_SnesABus,
+				MemoryMapping: memoryMapping,
+			},
+			Size: 0x50,
+		})
+		if err != nil {
+			return
+		}
+
+		var header snes.Header
+		if err = header.ReadHeader(bytes.NewReader(mrsp[0].Data)); err != nil {
+			return
+		}
+		if header.ROMSize > 0x0D {
+			err = fmt.Errorf("ROM header declares invalid ROM size $%02x", header.ROMSize)
+			return
+		}
+		size = 0x400 << header.ROMSize
+	}
+	if size > 0xE00000 {
+		err = fmt.Errorf("ROM size $%x too large", size)
+		return
+	}
+
+	reads := make([]snes.MemoryReadRequest, 0, size/0x10000+1)
+	for addr := uint32(0); addr < size; addr += 0x10000 {
+		chunk := size - addr
+		if chunk > 0x10000 {
+			chunk = 0x10000
+		}
+		reads = append(reads, snes.MemoryReadRequest{
+			RequestAddress: snes.AddressTuple{
+				Address:       addr,
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: memoryMapping,
+			},
+			Size: int(chunk),
+		})
+	}
+
+	var mrsp []snes.MemoryReadResponse
+	if mrsp, err = device.MultiReadMemory(ctx, reads...); err != nil {
+		return
+	}
+
+	contents := make([]byte, 0, size)
+	for _, rsp := range mrsp {
+		contents = append(contents, rsp.Data...)
+	}
+	if rom, err = snes.NewROM("", contents); err != nil || !declared || rom.ChecksumValid() {
+		return
+	}
+	if n := romSizeWithoutTail(contents); n < len(contents) {
+		return snes.NewROM("", contents[:n])
+	}
+	return
+}
+
+// romSizeWithoutTail returns the size of the ROM contents without a tail past the end of the ROM, checked in steps of
+// 1/2 down to 1/8 of the contents. A tail is blank (all $00 or all $FF) or mirrors the step before it.
+func romSizeWithoutTail(contents []byte) int {
+	size := len(contents)
+	for step := len(contents) / 2; step >= len(contents)/8 && step > 0; step /= 2 {
+		if size < 2*step {
+			continue
+		}
+		tail := contents[size-step : size]
+		if isBlank(tail) || bytes.Equal(tail, contents[size-2*step:size-step]) {
+			size -= step
+		}
+	}
+	return size
+}
+
+func isBlank(p []byte) bool {
+	for _, b := range p {
+		if b != p[0] {
+			return false
+		}
+	}
+	return len(p) > 0 && (p[0] == 0x00 || p[0] == 0xFF)
+}
+
 func ReadMemoryRequestString(m *sni.ReadMemoryRequest) string {
 	return fmt.Sprintf(
 		"{address:%s,size:%#x}",
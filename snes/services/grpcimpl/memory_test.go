@@ -0,0 +1,117 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sni/protos/sni"
+	"sni/snes"
+	"sni/snes/drivers/mock"
+	"sni/snes/snestest"
+	"testing"
+)
+
+// testROM3MiB returns a 3MiB LoROM ROM with a valid checksum whose header declares the next power of two, 4MiB
+func testROM3MiB(t *testing.T) []byte {
+	contents := snestest.LoROM(0x300000)
+	x := uint32(1)
+	for i := 0x10000; i < len(contents); i++ {
+		x = x*1103515245 + 12345
+		contents[i] = byte(x >> 16)
+	}
+	contents[0x7FB0+0x27] = 0x0C
+
+	rom, err := snes.NewROM("", contents)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rom.Header.ROMSize != 0x0C {
+		t.Fatalf("expected ROM size $0C; got $%02x", rom.Header.ROMSize)
+	}
+	if err = rom.FixChecksum(); err != nil {
+		t.Fatal(err)
+	}
+	return rom.FileContents()
+}
+
+// startMockDevice loads the ROM into the mock driver's console; the mock leaves pak memory past the ROM zeroed.
+func startMockDevice(t *testing.T, rom []byte) string {
+	path := filepath.Join(t.TempDir(), "rom.sfc")
+	if err := ioutil.WriteFile(path, rom, 0644); err != nil {
+		t.Fatal(err)
+	}
+	for name, value := range map[string]string{"SNI_MOCK_ENABLE": "1", "SNI_MOCK_ROM": path} {
+		if err := os.Setenv(name, value); err != nil {
+			t.Fatal(err)
+		}
+		defer os.Unsetenv(name)
+	}
+	mock.DriverInit()
+	return "mock:mock"
+}
+
+func TestDeviceMemoryService_ValidateROM_readBack(t *testing.T) {
+	uri := startMockDevice(t, testROM3MiB(t))
+	ctx := context.Background()
+	s := &DeviceMemoryService{}
+	lorom := sni.MemoryMapping_LoROM
+
+	rsp, err := s.ValidateROM(ctx, &sni.ValidateROMRequest{Uri: uri, MemoryMapping: &lorom})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp.Size != 0x300000 || !rsp.Valid {
+		t.Errorf("ValidateROM() = (size $%x, valid %v), want (size $%x, valid %v)", rsp.Size, rsp.Valid, 0x300000, true)
+	}
+
+	// the size was guessed so it must be given to fix the checksum on the device:
+	_, err = s.ValidateROM(ctx, &sni.ValidateROMRequest{Uri: uri, MemoryMapping: &lorom, Fix: true})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ValidateROM(fix) error = %v, want code %v", err, codes.InvalidArgument)
+	}
+
+	rsp, err = s.ValidateROM(ctx, &sni.ValidateROMRequest{Uri: uri, MemoryMapping: &lorom, Size: 0x300000, Fix: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp.Size != 0x300000 || !rsp.Valid {
+		t.Errorf("ValidateROM(size, fix) = (size $%x, valid %v), want (size $%x, valid %v)", rsp.Size, rsp.Valid, 0x300000, true)
+	}
+}
+
+func Test_romSizeWithoutTail(t *testing.T) {
+	pattern := func(size int) []byte {
+		b := make([]byte, size)
+		for i := range b {
+			b[i] = byte(i>>4 ^ i*13)
+		}
+		return b
+	}
+	mirrored := pattern(0x400)
+	copy(mirrored[0x300:], mirrored[0x200:0x300])
+	blankFF := pattern(0x400)
+	for i := 0x280; i < 0x400; i++ {
+		blankFF[i] = 0xFF
+	}
+
+	tests := []struct {
+		name     string
+		contents []byte
+		want     int
+	}{
+		{"full", pattern(0x400), 0x400},
+		{"zeroed tail", append(pattern(0x300), make([]byte, 0x100)...), 0x300},
+		{"mirrored tail", mirrored, 0x300},
+		{"$FF tail", blankFF, 0x280},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := romSizeWithoutTail(tt.contents); got != tt.want {
+				t.Errorf("romSizeWithoutTail() = $%x, want $%x", got, tt.want)
+			}
+		})
+	}
+}
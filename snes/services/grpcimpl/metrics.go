@@ -0,0 +1,147 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+	"log"
+	"net"
+	"net/http"
+	"sni/snes/services/auth"
+	"sni/util"
+	"sni/util/env"
+	"sni/util/metrics"
+	"time"
+)
+
+var (
+	rpcDuration = metrics.NewHistogramVec(
+		"sni_grpc_request_duration_seconds",
+		"Time taken to handle gRPC calls; for streams this is the lifetime of the stream.",
+		metrics.DefaultLatencyBuckets,
+		"method", "code",
+	)
+	connectedClients = metrics.NewGaugeVec(
+		"sni_grpc_connected_clients",
+		"Clients currently connected to the gRPC and gRPC-Web servers.",
+		"protocol",
+	)
+
+	metricsListener listenerState
+)
+
+// metricsListenAddr is where StartMetricsServer serves metrics; the gRPC-Web server only serves them when it is empty.
+func metricsListenAddr() string {
+	return env.GetOrDefault("SNI_METRICS_LISTEN_ADDR", "")
+}
+
+// StartMetricsServer serves metrics on their own listener instead of the gRPC-Web server when SNI_METRICS_LISTEN_ADDR
+// is set so that they are available independently of it.
+func StartMetricsServer() {
+	listenAddr := metricsListenAddr()
+	if listenAddr == "" {
+		return
+	}
+
+	tlsConfig, err := auth.TLSConfig()
+	if err != nil {
+		log.Printf("metrics: %v\n", err)
+		return
+	}
+
+	lc := &net.ListenConfig{Control: util.ReusePortControl}
+	lis, err := lc.Listen(context.Background(), "tcp", listenAddr)
+	if err != nil {
+		log.Printf("metrics: failed to listen: %v\n", err)
+		return
+	}
+	if tlsConfig != nil {
+		log.Printf("metrics: listening on %s with TLS\n", listenAddr)
+	} else {
+		log.Printf("metrics: listening on %s\n", listenAddr)
+	}
+	lis = auth.WrapListener(lis, tlsConfig)
+
+	metricsListener.set(listenAddr, tlsConfig != nil)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metricsHandler())
+	server := &http.Server{Handler: mux}
+	go func() {
+		err := server.Serve(lis)
+		log.Printf("metrics: exit: %v\n", err)
+	}()
+}
+
+func observeRPC(fullMethod string, tStart time.Time, err error) {
+	code := status.Code(grpcError(err))
+	rpcDuration.Observe(time.Since(tStart).Seconds(), fullMethod, code.String())
+}
+
+func metricsUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (rsp interface{}, err error) {
+	tStart := time.Now()
+	rsp, err = handler(ctx, req)
+	observeRPC(info.FullMethod, tStart, err)
+	return
+}
+
+func metricsStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	tStart := time.Now()
+	err = handler(srv, ss)
+	observeRPC(info.FullMethod, tStart, err)
+	return
+}
+
+// connStatsHandler counts connected gRPC clients
+type connStatsHandler struct{}
+
+func (connStatsHandler) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context { return ctx }
+func (connStatsHandler) HandleRPC(context.Context, stats.RPCStats)                       {}
+func (connStatsHandler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (connStatsHandler) HandleConn(_ context.Context, s stats.ConnStats) {
+	switch s.(type) {
+	case *stats.ConnBegin:
+		connectedClients.Inc("grpc")
+	case *stats.ConnEnd:
+		connectedClients.Dec("grpc")
+	}
+}
+
+// webConnState counts clients connected to the gRPC-Web server
+func webConnState(_ net.Conn, state http.ConnState) {
+	switch state {
+	case http.StateNew:
+		connectedClients.Inc("grpcweb")
+	case http.StateClosed, http.StateHijacked:
+		connectedClients.Dec("grpcweb")
+	}
+}
+
+// metricsHandler serves metrics and requires the bearer token when authentication is enabled.
+func metricsHandler() http.Handler {
+	h := metrics.Handler()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth.Enabled() {
+			if _, err := auth.Authenticate(auth.BearerToken(r.Header.Get("Authorization"))); err != nil {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+		}
+		h.ServeHTTP(w, r)
+	})
+}
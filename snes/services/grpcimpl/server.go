@@ -0,0 +1,103 @@
+package grpcimpl
+
+import (
+	"context"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"sni/cmd/sni/appversion"
+	"sni/protos/sni"
+	"sni/snes"
+	"sni/snes/services/auth"
+	"sni/snes/services/usb2snes"
+)
+
+// healthServer reports SERVING for the server as a whole ("") and for each service once the gRPC server is started
+var healthServer = health.NewServer()
+
+// baseFeatures are always supported; see ServerInfoResponse.features
+var baseFeatures = []string{
+	"health",
+	"leases",
+	"watch_devices",
+	"watch_memory",
+	"conditional_write",
+	"file_streams",
+	"patch",
+	"validate_rom",
+}
+
+func setServing() {
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	for _, svc := range services {
+		healthServer.SetServingStatus(svc.desc.ServiceName, grpc_health_v1.HealthCheckResponse_SERVING)
+	}
+}
+
+type ServerService struct {
+	sni.UnimplementedServerServer
+}
+
+func (s *ServerService) ServerInfo(ctx context.Context, request *sni.ServerInfoRequest) (*sni.ServerInfoResponse, error) {
+	rsp := &sni.ServerInfoResponse{
+		Version: &sni.ServerInfoResponse_AppVersion{
+			Version: appversion.Version,
+			Commit:  appversion.Commit,
+			Date:    appversion.Date,
+			BuiltBy: appversion.BuiltBy,
+		},
+	}
+
+	for _, named := range snes.Drivers() {
+		d := &sni.ServerInfoResponse_Driver{
+			Name: named.Name,
+			Kind: named.Driver.Kind(),
+		}
+		if descriptor, ok := named.Driver.(snes.DriverDescriptor); ok {
+			d.DisplayName = descriptor.DisplayName()
+			d.DisplayDescription = descriptor.DisplayDescription()
+			d.DisplayOrder = int32(descriptor.DisplayOrder())
+		}
+		rsp.Drivers = append(rsp.Drivers, d)
+	}
+
+	anyTLS := false
+	addListener := func(protocol, address string, tls bool) {
+		rsp.Listeners = append(rsp.Listeners, &sni.ServerInfoResponse_Listener{
+			Protocol: protocol,
+			Address:  address,
+			Tls:      tls,
+		})
+		anyTLS = anyTLS || tls
+	}
+	if GrpcServer != nil {
+		addListener("grpc", ListenAddr, ListenTLS)
+	}
+	webAddr, webTLS, webServing := webListener.get()
+	if webServing {
+		addListener("grpcweb", webAddr, webTLS)
+	}
+	metricsAddr, metricsTLS, metricsServing := metricsListener.get()
+	if metricsServing {
+		addListener("metrics", metricsAddr, metricsTLS)
+	}
+	usb2snesAddrs, usb2snesTLS := usb2snes.ListenAddrs()
+	for _, addr := range usb2snesAddrs {
+		addListener("usb2snes", addr, usb2snesTLS)
+	}
+
+	rsp.Features = append(rsp.Features, baseFeatures...)
+	if webServing {
+		rsp.Features = append(rsp.Features, "grpc_web", "rest_json")
+	}
+	if metricsServing || (webServing && metricsListenAddr() == "") {
+		rsp.Features = append(rsp.Features, "metrics")
+	}
+	if auth.Enabled() {
+		rsp.Features = append(rsp.Features, "auth")
+	}
+	if anyTLS {
+		rsp.Features = append(rsp.Features, "tls")
+	}
+
+	return rsp, nil
+}
@@ -0,0 +1,615 @@
+package grpcimpl
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"sni/snes/services/auth"
+	"sni/util"
+	"sni/util/env"
+	"strconv"
+	"strings"
+)
+
+// restPathPrefix prefixes the REST/JSON mapping of each method, e.g. /v1/Devices/ListDevices
+const restPathPrefix = "/v1/"
+
+// maxWebMessageSize limits the size of each request message received by the gateway
+const maxWebMessageSize = 16 * 1024 * 1024
+
+// localhostOrigin in SNI_GRPCWEB_CORS_ORIGINS allows pages served from this computer on any port. It is the default
+// so that other web pages the user visits cannot control their devices; `*` must be configured to allow any origin.
+const localhostOrigin = "localhost"
+
+var (
+	WebListenHost  string
+	WebListenPort  int
+	WebCORSOrigins []string
+
+	webListener listenerState
+)
+
+// StartWebServer serves the gRPC services over gRPC-Web and a REST/JSON mapping for browser clients.
+func StartWebServer() {
+	var err error
+
+	// Parse env vars:
+	disabled := env.GetOrDefault("SNI_GRPCWEB_DISABLE", "0")
+	if util.IsTruthy(disabled) {
+		log.Printf("grpcweb: server disabled due to env var %s=%s\n", "SNI_GRPCWEB_DISABLE", disabled)
+		return
+	}
+
+	WebListenHost = env.GetOrDefault("SNI_GRPCWEB_LISTEN_HOST", "0.0.0.0")
+
+	WebListenPort, err = strconv.Atoi(env.GetOrDefault("SNI_GRPCWEB_LISTEN_PORT", "8190"))
+	if err != nil {
+		WebListenPort = 8190
+	}
+	if WebListenPort <= 0 {
+		WebListenPort = 8190
+	}
+
+	WebCORSOrigins = nil
+	for _, origin := range strings.Split(env.GetOrDefault("SNI_GRPCWEB_CORS_ORIGINS", localhostOrigin), ",") {
+		origin = strings.TrimSpace(origin)
+		if origin == "" {
+			continue
+		}
+		if origin == "*" {
+			log.Printf("grpcweb: any web page may make requests to SNI due to %s=*\n", "SNI_GRPCWEB_CORS_ORIGINS")
+		}
+		WebCORSOrigins = append(WebCORSOrigins, origin)
+	}
+
+	tlsConfig, err := auth.TLSConfig()
+	if err != nil {
+		log.Printf("grpcweb: %v\n", err)
+		return
+	}
+
+	listenAddr := net.JoinHostPort(WebListenHost, strconv.Itoa(WebListenPort))
+	lc := &net.ListenConfig{Control: util.ReusePortControl}
+	lis, err := lc.Listen(context.Background(), "tcp", listenAddr)
+	if err != nil {
+		log.Printf("grpcweb: failed to listen: %v\n", err)
+		return
+	}
+	if tlsConfig != nil {
+		log.Printf("grpcweb: listening on %s with TLS\n", listenAddr)
+	} else {
+		log.Printf("grpcweb: listening on %s\n", listenAddr)
+	}
+	lis = auth.WrapListener(lis, tlsConfig)
+
+	webListener.set(listenAddr, tlsConfig != nil)
+
+	mux := http.NewServeMux()
+	if metricsListenAddr() == "" {
+		// metrics are only served in one place; SNI_METRICS_LISTEN_ADDR moves them to their own listener:
+		mux.Handle("/metrics", metricsHandler())
+	}
+	mux.Handle("/", newWebGateway(services, WebCORSOrigins))
+	server := &http.Server{Handler: mux, ConnState: webConnState}
+	go func() {
+		err := server.Serve(lis)
+		log.Printf("grpcweb: exit: %v\n", err)
+	}()
+}
+
+type webMethod struct {
+	impl   interface{}
+	unary  *grpc.MethodDesc
+	stream *grpc.StreamDesc
+}
+
+// webGateway dispatches gRPC-Web and REST/JSON requests directly to the service implementations through the same
+// interceptors used by the gRPC server.
+type webGateway struct {
+	methods     map[string]webMethod
+	corsOrigins []string
+}
+
+func newWebGateway(services []service, corsOrigins []string) *webGateway {
+	g := &webGateway{
+		methods:     make(map[string]webMethod),
+		corsOrigins: corsOrigins,
+	}
+	for _, svc := range services {
+		for i := range svc.desc.Methods {
+			md := &svc.desc.Methods[i]
+			g.methods["/"+svc.desc.ServiceName+"/"+md.MethodName] = webMethod{impl: svc.impl, unary: md}
+		}
+		for i := range svc.desc.Streams {
+			sd := &svc.desc.Streams[i]
+			g.methods["/"+svc.desc.ServiceName+"/"+sd.StreamName] = webMethod{impl: svc.impl, stream: sd}
+		}
+	}
+	return g
+}
+
+func (g *webGateway) allowOrigin(origin string) bool {
+	for _, allowed := range g.corsOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+		if allowed == localhostOrigin && isLocalhostOrigin(origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// isLocalhostOrigin reports whether the origin is an http or https page served from the loopback interface.
+func isLocalhostOrigin(origin string) bool {
+	u, err := url.Parse(origin)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return false
+	}
+	switch strings.ToLower(u.Hostname()) {
+	case "localhost", "127.0.0.1", "::1":
+		return true
+	default:
+		return false
+	}
+}
+
+func (g *webGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if origin := r.Header.Get("Origin"); origin != "" {
+		if !g.allowOrigin(origin) {
+			http.Error(w, "origin not allowed", http.StatusForbidden)
+			return
+		}
+
+		h := w.Header()
+		h.Set("Access-Control-Allow-Origin", origin)
+		h.Add("Vary", "Origin")
+		h.Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		h.Set("Access-Control-Allow-Headers", "Authorization, Content-Type, X-Grpc-Web, X-User-Agent, Grpc-Timeout, "+leaseIDMetadataKey+", "+clientNameMetadataKey)
+		h.Set("Access-Control-Expose-Headers", "Grpc-Status, Grpc-Message")
+		h.Set("Access-Control-Max-Age", "600")
+	}
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	var fullMethod string
+	isGrpcWeb := strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc-web")
+	if isGrpcWeb {
+		if r.Method != http.MethodPost {
+			http.Error(w, "gRPC-Web requests must use POST", http.StatusMethodNotAllowed)
+			return
+		}
+		fullMethod = r.URL.Path
+	} else if strings.HasPrefix(r.URL.Path, restPathPrefix) {
+		if r.Method != http.MethodPost && r.Method != http.MethodGet {
+			http.Error(w, "REST requests must use GET or POST", http.StatusMethodNotAllowed)
+			return
+		}
+		fullMethod = "/" + strings.TrimPrefix(r.URL.Path, restPathPrefix)
+	} else {
+		http.NotFound(w, r)
+		return
+	}
+
+	m, ok := g.methods[fullMethod]
+
+	var codec webCodec
+	if isGrpcWeb {
+		codec = newGrpcWebCodec(w, r)
+	} else {
+		codec = newJSONCodec(w, r, ok && m.stream != nil && m.stream.ServerStreams)
+	}
+
+	ss := &webServerStream{
+		ctx:     webContext(r),
+		codec:   codec,
+		header:  metadata.MD{},
+		trailer: metadata.MD{},
+	}
+
+	var err error
+	if !ok {
+		err = status.Errorf(codes.Unimplemented, "unknown method %s", fullMethod)
+	} else if m.unary != nil {
+		var rsp interface{}
+		rsp, err = m.unary.Handler(m.impl, ss.ctx, ss.RecvMsg, chainUnaryInterceptors(unaryInterceptors))
+		if err == nil {
+			err = ss.SendMsg(rsp)
+		}
+	} else {
+		info := &grpc.StreamServerInfo{
+			FullMethod:     fullMethod,
+			IsClientStream: m.stream.ClientStreams,
+			IsServerStream: m.stream.ServerStreams,
+		}
+		err = chainStreamInterceptors(streamInterceptors)(m.impl, ss, info, m.stream.Handler)
+	}
+
+	ss.finish(err)
+}
+
+// webContext builds the gRPC call context from the HTTP request headers and remote address.
+func webContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	for k, v := range r.Header {
+		md.Append(k, v...)
+	}
+
+	ctx := metadata.NewIncomingContext(r.Context(), md)
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: webAddr(r.RemoteAddr)})
+	return ctx
+}
+
+type webAddr string
+
+func (a webAddr) Network() string { return "tcp" }
+func (a webAddr) String() string  { return string(a) }
+
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return chained(ctx, req)
+	}
+}
+
+func chainStreamInterceptors(interceptors []grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, next)
+			}
+		}
+		return chained(srv, ss)
+	}
+}
+
+// webCodec reads request messages from and writes response messages to an HTTP exchange.
+type webCodec interface {
+	// readMessage decodes the next request message into m and returns io.EOF when there are no more
+	readMessage(m proto.Message) error
+	sendHeader(header metadata.MD)
+	writeMessage(m proto.Message) error
+	finish(trailer metadata.MD, err error)
+}
+
+// webServerStream implements grpc.ServerStream over a webCodec.
+type webServerStream struct {
+	ctx        context.Context
+	codec      webCodec
+	header     metadata.MD
+	trailer    metadata.MD
+	headerSent bool
+}
+
+func (s *webServerStream) SetHeader(md metadata.MD) error {
+	if s.headerSent {
+		return status.Error(codes.Internal, "headers already sent")
+	}
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *webServerStream) SendHeader(md metadata.MD) error {
+	if err := s.SetHeader(md); err != nil {
+		return err
+	}
+	s.headerSent = true
+	s.codec.sendHeader(s.header)
+	return nil
+}
+
+func (s *webServerStream) SetTrailer(md metadata.MD) {
+	s.trailer = metadata.Join(s.trailer, md)
+}
+
+func (s *webServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *webServerStream) SendMsg(m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "response type %T is not a proto.Message", m)
+	}
+	if !s.headerSent {
+		s.headerSent = true
+		s.codec.sendHeader(s.header)
+	}
+	return s.codec.writeMessage(msg)
+}
+
+func (s *webServerStream) RecvMsg(m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "request type %T is not a proto.Message", m)
+	}
+	return s.codec.readMessage(msg)
+}
+
+func (s *webServerStream) finish(err error) {
+	if !s.headerSent {
+		s.headerSent = true
+		s.codec.sendHeader(s.header)
+	}
+	s.codec.finish(s.trailer, err)
+}
+
+func writeHeaderMetadata(h http.Header, md metadata.MD) {
+	for k, v := range md {
+		for _, vv := range v {
+			h.Add(k, vv)
+		}
+	}
+}
+
+func flush(w http.ResponseWriter) {
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// grpcWebCodec implements the gRPC-Web protocol in both binary and base64 text modes.
+type grpcWebCodec struct {
+	w    http.ResponseWriter
+	r    io.Reader
+	text bool
+}
+
+func newGrpcWebCodec(w http.ResponseWriter, r *http.Request) *grpcWebCodec {
+	c := &grpcWebCodec{
+		w:    w,
+		r:    r.Body,
+		text: strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc-web-text"),
+	}
+	if c.text {
+		c.r = base64.NewDecoder(base64.StdEncoding, r.Body)
+	}
+	return c
+}
+
+func (c *grpcWebCodec) readMessage(m proto.Message) error {
+	var hdr [5]byte
+	if _, err := io.ReadFull(c.r, hdr[:]); err != nil {
+		if err == io.EOF {
+			return io.EOF
+		}
+		return status.Errorf(codes.InvalidArgument, "could not read message frame: %v", err)
+	}
+	if hdr[0]&0x80 != 0 {
+		// clients do not send trailers but treat one as the end of the stream:
+		return io.EOF
+	}
+	if hdr[0]&0x01 != 0 {
+		return status.Error(codes.Unimplemented, "compressed messages are not supported")
+	}
+
+	size := binary.BigEndian.Uint32(hdr[1:])
+	if size > maxWebMessageSize {
+		return status.Errorf(codes.ResourceExhausted, "message too large; %d > %d", size, maxWebMessageSize)
+	}
+
+	b := make([]byte, size)
+	if _, err := io.ReadFull(c.r, b); err != nil {
+		return status.Errorf(codes.InvalidArgument, "could not read message: %v", err)
+	}
+
+	if err := proto.Unmarshal(b, m); err != nil {
+		return status.Errorf(codes.InvalidArgument, "could not decode message: %v", err)
+	}
+	return nil
+}
+
+func (c *grpcWebCodec) sendHeader(header metadata.MD) {
+	h := c.w.Header()
+	writeHeaderMetadata(h, header)
+	if c.text {
+		h.Set("Content-Type", "application/grpc-web-text+proto")
+	} else {
+		h.Set("Content-Type", "application/grpc-web+proto")
+	}
+	c.w.WriteHeader(http.StatusOK)
+}
+
+func (c *grpcWebCodec) writeFrame(flags byte, payload []byte) error {
+	b := make([]byte, 5+len(payload))
+	b[0] = flags
+	binary.BigEndian.PutUint32(b[1:5], uint32(len(payload)))
+	copy(b[5:], payload)
+
+	if c.text {
+		b = []byte(base64.StdEncoding.EncodeToString(b))
+	}
+	if _, err := c.w.Write(b); err != nil {
+		return err
+	}
+
+	flush(c.w)
+	return nil
+}
+
+func (c *grpcWebCodec) writeMessage(m proto.Message) error {
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not encode message: %v", err)
+	}
+	return c.writeFrame(0x00, b)
+}
+
+func (c *grpcWebCodec) finish(trailer metadata.MD, err error) {
+	st := status.Convert(err)
+
+	sb := strings.Builder{}
+	_, _ = fmt.Fprintf(&sb, "grpc-status: %d\r\n", st.Code())
+	if st.Message() != "" {
+		_, _ = fmt.Fprintf(&sb, "grpc-message: %s\r\n", encodeGrpcMessage(st.Message()))
+	}
+	for k, v := range trailer {
+		for _, vv := range v {
+			_, _ = fmt.Fprintf(&sb, "%s: %s\r\n", k, vv)
+		}
+	}
+
+	_ = c.writeFrame(0x80, []byte(sb.String()))
+}
+
+// encodeGrpcMessage percent-encodes the grpc-message trailer value as required by the gRPC protocol.
+func encodeGrpcMessage(msg string) string {
+	sb := strings.Builder{}
+	for i := 0; i < len(msg); i++ {
+		c := msg[i]
+		if c >= ' ' && c <= '~' && c != '%' {
+			sb.WriteByte(c)
+		} else {
+			_, _ = fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+	return sb.String()
+}
+
+// jsonCodec implements the REST/JSON mapping. Request messages are JSON objects in the body; an empty body is an
+// empty request. Client-streaming methods accept a sequence of JSON objects. Unary responses are a single JSON object
+// and server-streaming responses are newline-delimited JSON objects.
+type jsonCodec struct {
+	w         http.ResponseWriter
+	dec       *json.Decoder
+	streaming bool
+	reads     int
+	writes    int
+}
+
+func newJSONCodec(w http.ResponseWriter, r *http.Request, streaming bool) *jsonCodec {
+	return &jsonCodec{
+		w:         w,
+		dec:       json.NewDecoder(io.LimitReader(r.Body, maxWebMessageSize)),
+		streaming: streaming,
+	}
+}
+
+func (c *jsonCodec) readMessage(m proto.Message) error {
+	var raw json.RawMessage
+	err := c.dec.Decode(&raw)
+	c.reads++
+	if err == io.EOF {
+		if c.reads == 1 {
+			// an empty body is an empty request:
+			return nil
+		}
+		return io.EOF
+	}
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "could not read JSON request: %v", err)
+	}
+
+	if err = (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(raw, m); err != nil {
+		return status.Errorf(codes.InvalidArgument, "could not decode JSON request: %v", err)
+	}
+	return nil
+}
+
+func (c *jsonCodec) sendHeader(header metadata.MD) {
+	writeHeaderMetadata(c.w.Header(), header)
+	if c.streaming {
+		c.w.Header().Set("Content-Type", "application/x-ndjson")
+	} else {
+		c.w.Header().Set("Content-Type", "application/json")
+	}
+}
+
+func (c *jsonCodec) writeMessage(m proto.Message) error {
+	b, err := (protojson.MarshalOptions{EmitUnpopulated: true}).Marshal(m)
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not encode JSON response: %v", err)
+	}
+
+	c.writes++
+	if c.writes == 1 {
+		c.w.WriteHeader(http.StatusOK)
+	}
+	if _, err = c.w.Write(append(b, '\n')); err != nil {
+		return err
+	}
+
+	flush(c.w)
+	return nil
+}
+
+type jsonError struct {
+	Code    codes.Code `json:"code"`
+	Message string     `json:"message"`
+}
+
+func (c *jsonCodec) finish(trailer metadata.MD, err error) {
+	if err == nil {
+		if c.writes == 0 {
+			c.w.WriteHeader(http.StatusOK)
+		}
+		return
+	}
+
+	st := status.Convert(err)
+	e := jsonError{Code: st.Code(), Message: st.Message()}
+	if c.writes == 0 {
+		// nothing written yet so report the error with the HTTP status:
+		c.w.WriteHeader(httpStatusFromCode(st.Code()))
+		_ = json.NewEncoder(c.w).Encode(e)
+		return
+	}
+
+	// already streaming responses so report the error as the final message:
+	_ = json.NewEncoder(c.w).Encode(struct {
+		Error jsonError `json:"error"`
+	}{e})
+}
+
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,30 @@
+package grpcimpl
+
+import "testing"
+
+func Test_webGateway_allowOrigin(t *testing.T) {
+	tests := []struct {
+		name        string
+		corsOrigins []string
+		origin      string
+		want        bool
+	}{
+		{"default localhost", []string{localhostOrigin}, "http://localhost:3000", true},
+		{"default loopback IPv4", []string{localhostOrigin}, "https://127.0.0.1", true},
+		{"default loopback IPv6", []string{localhostOrigin}, "http://[::1]:8080", true},
+		{"default other site", []string{localhostOrigin}, "https://example.com", false},
+		{"default localhost subdomain", []string{localhostOrigin}, "http://localhost.example.com", false},
+		{"default file origin", []string{localhostOrigin}, "null", false},
+		{"listed origin", []string{localhostOrigin, "https://app.example.com"}, "https://APP.example.com", true},
+		{"any origin", []string{"*"}, "https://example.com", true},
+		{"none", nil, "http://localhost:3000", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newWebGateway(nil, tt.corsOrigins)
+			if got := g.allowOrigin(tt.origin); got != tt.want {
+				t.Errorf("allowOrigin(%q) = %v, want %v", tt.origin, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package usb2snes
+
+import "sni/util/metrics"
+
+var (
+	opcodesTotal = metrics.NewCounterVec(
+		"sni_usb2snes_opcodes_total",
+		"usb2snes commands received by opcode; unrecognized opcodes are counted as 'other'.",
+		"opcode",
+	)
+	connectedClients = metrics.NewGaugeVec(
+		"sni_usb2snes_connected_clients",
+		"Clients currently connected to the usb2snes server.",
+	)
+)
+
+// knownOpcodes bounds the opcode label values so clients cannot create arbitrarily many series
+var knownOpcodes = map[string]bool{
+	"Authenticate": true,
+	"DeviceList":   true,
+	"Name":         true,
+	"AppVersion":   true,
+	"Close":        true,
+	"Attach":       true,
+	"Info":         true,
+	"GetAddress":   true,
+	"PutAddress":   true,
+	"PutIPS":       true,
+	"Reset":        true,
+	"Menu":         true,
+	"Boot":         true,
+	"List":         true,
+	"MakeDir":      true,
+	"Remove":       true,
+	"Rename":       true,
+	"GetFile":      true,
+	"PutFile":      true,
+}
+
+func countOpcode(opcode string) {
+	if !knownOpcodes[opcode] {
+		opcode = "other"
+	}
+	opcodesTotal.Inc(opcode)
+}
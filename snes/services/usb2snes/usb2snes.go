@@ -2,6 +2,7 @@ package usb2snes
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"github.com/gobwas/ws"
@@ -13,18 +14,36 @@ import (
 	"net/url"
 	"sni/cmd/sni/appversion"
 	"sni/cmd/sni/config"
-	"sni/cmd/sni/tray"
 	"sni/protos/sni"
 	"sni/snes"
 	"sni/snes/mapping"
+	"sni/snes/patch"
+	"sni/snes/services/auth"
 	"sni/util"
 	"sni/util/env"
 	"sni/util/hex"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
+// putFileLeaseDuration is how long a PutFile upload holds its device lease between progress updates
+const putFileLeaseDuration = time.Second * 5
+
+var (
+	listenersMu sync.Mutex
+	listenAddrs []string
+	listenTLS   bool
+)
+
+// ListenAddrs returns the addresses the usb2snes server is listening on and whether they require TLS.
+func ListenAddrs() (addrs []string, tls bool) {
+	listenersMu.Lock()
+	defer listenersMu.Unlock()
+	return append([]string(nil), listenAddrs...), listenTLS
+}
+
 func StartHttpServer() {
 	// Parse env vars:
 	disabled := env.GetOrDefault("SNI_USB2SNES_DISABLE", "0")
@@ -34,13 +53,20 @@ func StartHttpServer() {
 	}
 
 	addrList := env.GetOrDefault("SNI_USB2SNES_LISTEN_ADDRS", "0.0.0.0:23074,0.0.0.0:8080")
+
+	tlsConfig, err := auth.TLSConfig()
+	if err != nil {
+		log.Printf("usb2snes: %v\n", err)
+		return
+	}
+
 	listenAddrs := strings.Split(addrList, ",")
 	for _, listenAddr := range listenAddrs {
-		go listenHttp(listenAddr)
+		go listenHttp(listenAddr, tlsConfig)
 	}
 }
 
-func listenHttp(listenAddr string) {
+func listenHttp(listenAddr string, tlsConfig *tls.Config) {
 	var err error
 	var lis net.Listener
 
@@ -67,8 +93,17 @@ func listenHttp(listenAddr string) {
 		time.Sleep(time.Second)
 	}
 
-	log.Printf("usb2snes: listening on %s\n", listenAddr)
-	err = http.Serve(lis, mux)
+	if tlsConfig != nil {
+		log.Printf("usb2snes: listening on %s with TLS\n", listenAddr)
+	} else {
+		log.Printf("usb2snes: listening on %s\n", listenAddr)
+	}
+	listenersMu.Lock()
+	listenAddrs = append(listenAddrs, listenAddr)
+	listenTLS = tlsConfig != nil
+	listenersMu.Unlock()
+
+	err = http.Serve(auth.WrapListener(lis, tlsConfig), mux)
 	log.Printf("usb2snes: exit listenHttp: %v\n", err)
 }
 
@@ -133,9 +168,13 @@ func clientWebsocketHandler(rw http.ResponseWriter, req *http.Request) {
 	}
 
 	clientName := conn.RemoteAddr().String()
+	// identifies the client to the device scheduler:
+	clientCtx := snes.WithClientID(context.Background(), clientName)
+	connectedClients.Inc()
 	defer func() {
 		log.Printf("usb2snes: %s: %s disconnected\n", clientName, conn.RemoteAddr())
 		conn.Close()
+		connectedClients.Dec()
 	}()
 
 	// setup general readers, writers and JSON encoders, decoders:
@@ -149,6 +188,7 @@ func clientWebsocketHandler(rw http.ResponseWriter, req *http.Request) {
 	var driver snes.Driver
 	var device snes.AutoCloseableDevice
 	var deviceMemoryMapping sni.MemoryMapping
+	authenticated := false
 
 	_ = driver
 
@@ -206,6 +246,7 @@ serverLoop:
 			log.Printf("usb2snes: %s: could not decode json request: %s\n", clientName, err)
 			break serverLoop
 		}
+		countOpcode(cmd.Opcode)
 
 		type response struct {
 			Results []string `json:"Results"`
@@ -233,26 +274,46 @@ serverLoop:
 			return true
 		}
 
+		if !authenticated && auth.Enabled() {
+			switch cmd.Opcode {
+			case "Authenticate", "Name", "AppVersion", "Close":
+			default:
+				log.Printf("usb2snes: %s: %s requires Authenticate first\n", clientName, cmd.Opcode)
+				break serverLoop
+			}
+		}
+
 		switch cmd.Opcode {
+		case "Authenticate":
+			if len(cmd.Operands) != 1 {
+				log.Printf("usb2snes: %s: %s missing required operand\n", clientName, cmd.Opcode)
+				break serverLoop
+			}
+
+			var app string
+			app, err = auth.Authenticate(cmd.Operands[0])
+			if err != nil {
+				log.Printf("usb2snes: %s: %s failed: %s\n", clientName, cmd.Opcode, err)
+				break serverLoop
+			}
+			authenticated = true
+
+			if app != "" {
+				log.Printf("usb2snes: %s: %s as app '%s'\n", clientName, cmd.Opcode, app)
+			}
+			break
 		case "DeviceList":
-			descriptors := make([]snes.DeviceDescriptor, 0, 10)
-			for _, driver := range snes.Drivers() {
-				if config.VerboseLogging {
-					log.Printf("usb2snes: %s: %s detecting devices from driver '%s'\n", clientName, cmd.Opcode, driver.Name)
-				}
-				d, err := driver.Driver.Detect()
-				if err != nil {
-					log.Printf("usb2snes: %s: %s error detecting from driver '%s': %s\n", clientName, cmd.Opcode, driver.Name, err)
-					continue
-				}
-				descriptors = append(descriptors, d...)
+			if config.VerboseLogging {
+				log.Printf("usb2snes: %s: %s detecting devices\n", clientName, cmd.Opcode)
+			}
+			descriptors, err := snes.DetectDevices()
+			if err != nil {
+				log.Printf("usb2snes: %s: %s error detecting devices: %s\n", clientName, cmd.Opcode, err)
 			}
 			if config.VerboseLogging {
 				log.Printf("usb2snes: %s: %s detection complete\n", clientName, cmd.Opcode)
 			}
 
-			go tray.UpdateDeviceList(descriptors)
-
 			results.Results = make([]string, 0, 10)
 			for _, descriptor := range descriptors {
 				results.Results = append(results.Results, descriptor.Uri.String())
@@ -269,6 +330,7 @@ serverLoop:
 			}
 
 			clientName = cmd.Operands[0]
+			clientCtx = snes.WithClientID(context.Background(), clientName)
 			log.Printf("usb2snes: %s: %s '%s'\n", conn.RemoteAddr(), cmd.Opcode, clientName)
 			break
 		case "AppVersion":
@@ -302,7 +364,7 @@ serverLoop:
 
 			//var confidence bool
 			//var outHeaderBytes []byte
-			deviceMemoryMapping, _, _, err = mapping.Detect(context.Background(), device, nil, nil)
+			deviceMemoryMapping, _, _, err = mapping.Detect(clientCtx, device, nil, nil)
 			if err != nil {
 				log.Printf("usb2snes: %s: could not detect memory mapping: %s\n", clientName, err)
 				break serverLoop
@@ -314,9 +376,9 @@ serverLoop:
 				break serverLoop
 			}
 
-			var fields []string
+			var fields []snes.FieldValue
 			fields, err = device.FetchFields(
-				context.Background(),
+				clientCtx,
 				snes.Field_DeviceVersion,
 				snes.Field_DeviceName,
 				snes.Field_RomFileName,
@@ -325,7 +387,7 @@ serverLoop:
 				log.Printf("usb2snes: %s: %s error: %v; falling back to default Info values\n", clientName, cmd.Opcode, err)
 				results.Results = []string{"1.9.0-usb-v9", "SD2SNES", "No Info"}
 			} else {
-				results.Results = []string{fields[0], fields[1], fields[2]}
+				results.Results = []string{fields[0].Value, fields[1].Value, fields[2].Value}
 			}
 
 			if !replyJson() {
@@ -394,7 +456,7 @@ serverLoop:
 
 			// issue the read request:
 			var rsps []snes.MemoryReadResponse
-			rsps, err = device.MultiReadMemory(context.Background(), reqs...)
+			rsps, err = device.MultiReadMemory(clientCtx, reqs...)
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
 				break serverLoop
@@ -507,7 +569,7 @@ serverLoop:
 
 			// issue the read request:
 			var rsps []snes.MemoryWriteResponse
-			rsps, err = device.MultiWriteMemory(context.Background(), reqs...)
+			rsps, err = device.MultiWriteMemory(clientCtx, reqs...)
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
 				break serverLoop
@@ -519,13 +581,77 @@ serverLoop:
 			_ = rsps
 			break
 
+		case "PutIPS":
+			if device == nil {
+				log.Printf("usb2snes: %s: %s requires Attach first\n", clientName, cmd.Opcode)
+				break serverLoop
+			}
+
+			// operands are a name for the patch, e.g. "hook", and the size of the IPS data that follows:
+			if len(cmd.Operands) < 2 {
+				log.Printf("usb2snes: %s: %s expected 2 operands, got %d\n", clientName, cmd.Opcode, len(cmd.Operands))
+				break serverLoop
+			}
+
+			var size64 uint64
+			size64, err = strconv.ParseUint(cmd.Operands[1], 16, 32)
+			if err != nil {
+				log.Printf("usb2snes: %s: %s: bad operand [%d]: '%s'\n", clientName, cmd.Opcode, 1, cmd.Operands[1])
+				break serverLoop
+			}
+
+			var addrMask uint32
+			space := strings.TrimSpace(strings.ToUpper(cmd.Space))
+			switch space {
+			case "SNES":
+				addrMask = 0
+				break
+			case "CMD":
+				// same CMD subspace hack as PutAddress:
+				addrMask = 0x01_000000
+				break
+			default:
+				log.Printf("usb2snes: %s: %s: unrecognized space '%s'\n", clientName, cmd.Opcode, space)
+				break serverLoop
+			}
+
+			data := make([]byte, size64)
+			_, err = io.ReadFull(&wsReader{r: r}, data)
+			if err != nil {
+				log.Printf("usb2snes: %s: %s read(): %s\n", clientName, cmd.Opcode, err)
+				break serverLoop
+			}
+
+			var ips *patch.IPS
+			ips, err = patch.ParseIPS(data)
+			if err != nil {
+				log.Printf("usb2snes: %s: %s: %s\n", clientName, cmd.Opcode, err)
+				break serverLoop
+			}
+
+			// IPS record offsets are addresses in the space:
+			reqs := ips.MemoryWriteRequests(sni.AddressSpace_FxPakPro, deviceMemoryMapping)
+			for i := range reqs {
+				reqs[i].RequestAddress.Address = reqs[i].RequestAddress.Address&0x00_FFFFFF | addrMask
+			}
+
+			_, err = device.MultiWriteMemory(clientCtx, reqs...)
+			if err != nil {
+				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+				break serverLoop
+			}
+			if config.VerboseLogging {
+				log.Printf("usb2snes: %s: %s REPLY: %d records written\n", clientName, cmd.Opcode, len(reqs))
+			}
+			break
+
 		case "Reset":
 			if device == nil {
 				log.Printf("usb2snes: %s: %s requires Attach first\n", clientName, cmd.Opcode)
 				break serverLoop
 			}
 
-			err = device.ResetSystem(context.Background())
+			err = device.ResetSystem(clientCtx)
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
 				break serverLoop
@@ -538,7 +664,7 @@ serverLoop:
 				break serverLoop
 			}
 
-			err = device.ResetToMenu(context.Background())
+			err = device.ResetToMenu(clientCtx)
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
 				break serverLoop
@@ -556,7 +682,7 @@ serverLoop:
 				break serverLoop
 			}
 
-			err = device.BootFile(context.Background(), cmd.Operands[0])
+			err = device.BootFile(clientCtx, cmd.Operands[0])
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
 				break serverLoop
@@ -575,7 +701,7 @@ serverLoop:
 			}
 
 			var entries []snes.DirEntry
-			entries, err = device.ReadDirectory(context.Background(), cmd.Operands[0])
+			entries, err = device.ReadDirectory(clientCtx, cmd.Operands[0])
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
 				break serverLoop
@@ -602,7 +728,7 @@ serverLoop:
 				break serverLoop
 			}
 
-			err = device.MakeDirectory(context.Background(), cmd.Operands[0])
+			err = device.MakeDirectory(clientCtx, cmd.Operands[0])
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
 				break serverLoop
@@ -620,7 +746,7 @@ serverLoop:
 				break serverLoop
 			}
 
-			err = device.RemoveFile(context.Background(), cmd.Operands[0])
+			err = device.RemoveFile(clientCtx, cmd.Operands[0])
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
 				break serverLoop
@@ -638,7 +764,7 @@ serverLoop:
 				break serverLoop
 			}
 
-			err = device.RenameFile(context.Background(), cmd.Operands[0], cmd.Operands[1])
+			err = device.RenameFile(clientCtx, cmd.Operands[0], cmd.Operands[1])
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
 				break serverLoop
@@ -676,7 +802,7 @@ serverLoop:
 			})
 
 			var n uint32
-			n, err = device.GetFile(context.Background(), cmd.Operands[0], wsw, sizeReceived, progress)
+			n, err = device.GetFile(clientCtx, cmd.Operands[0], wsw, sizeReceived, progress)
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
 				break serverLoop
@@ -709,16 +835,25 @@ serverLoop:
 			}
 			size := uint32(size64)
 
-			var progress snes.ProgressReportFunc = nil
-			if config.VerboseLogging {
-				progress = func(current uint32, total uint32) {
+			// hold a lease on the device for the duration of the upload so other clients cannot interrupt it:
+			var lease snes.Lease
+			lease, err = snes.AcquireLease(device, putFileLeaseDuration)
+			if err != nil {
+				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+				break serverLoop
+			}
+
+			progress := func(current uint32, total uint32) {
+				_, _ = snes.RenewLease(device, lease.ID, putFileLeaseDuration)
+				if config.VerboseLogging {
 					log.Printf("usb2snes: %s: %s: progress $%08x/$%08x\n", clientName, cmd.Opcode, current, total)
 				}
 			}
 
 			var n uint32
 			wsr := &wsReader{r}
-			n, err = device.PutFile(context.Background(), cmd.Operands[0], size, wsr, progress)
+			n, err = device.PutFile(snes.WithLeaseID(clientCtx, lease.ID), cmd.Operands[0], size, wsr, progress)
+			_ = snes.ReleaseLease(device, lease.ID)
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
 				break serverLoop
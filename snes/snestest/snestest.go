@@ -0,0 +1,62 @@
+// Package snestest provides a test ROM and address helpers shared by driver and service tests.
+package snestest
+
+import (
+	"context"
+	"encoding/hex"
+	"sni/protos/sni"
+	"sni/snes"
+	"testing"
+)
+
+// header is the ROM header of "THE LEGEND OF ZELDA" at $FFB0 with a valid checksum for a 128KiB LoROM ROM with
+// otherwise zeroed contents; it declares 8KiB of SRAM.
+const header = "018d2401e2306bffffffffffffffffff" +
+	"544845204c4547454e44204f46205a45" +
+	"4c4441202020020a03010100f2500daf" +
+	"ffffffff2c82ffff2c82c9800080d882" +
+	"ffffffff2c822c822c822c820080d882"
+
+// ROM returns zeroed ROM contents of the given size with the test header at headerOffset for the map mode.
+func ROM(size int, headerOffset int, mapMode byte) []byte {
+	contents := make([]byte, size)
+	if _, err := hex.Decode(contents[headerOffset:], []byte(header)); err != nil {
+		panic(err)
+	}
+	contents[headerOffset+0x25] = mapMode
+	return contents
+}
+
+// LoROM returns zeroed LoROM contents of the given size with the test header at $7FB0.
+func LoROM(size int) []byte {
+	return ROM(size, 0x7FB0, 0x20)
+}
+
+// PakAddress returns the address in FX Pak Pro space for a LoROM game.
+func PakAddress(address uint32) snes.AddressTuple {
+	return snes.AddressTuple{Address: address, AddressSpace: sni.AddressSpace_FxPakPro, MemoryMapping: sni.MemoryMapping_LoROM}
+}
+
+// BusAddress returns the address on the SNES A-bus for the memory mapping.
+func BusAddress(address uint32, mapping sni.MemoryMapping) snes.AddressTuple {
+	return snes.AddressTuple{Address: address, AddressSpace: sni.AddressSpace_SnesABus, MemoryMapping: mapping}
+}
+
+// ReadPak reads size bytes at the address in FX Pak Pro space.
+func ReadPak(ctx context.Context, d snes.DeviceMemory, address uint32, size int) ([]byte, error) {
+	rsps, err := d.MultiReadMemory(ctx, snes.MemoryReadRequest{RequestAddress: PakAddress(address), Size: size})
+	if err != nil {
+		return nil, err
+	}
+	return rsps[0].Data, nil
+}
+
+// MustReadPak reads size bytes at the address in FX Pak Pro space and fails the test on error.
+func MustReadPak(tb testing.TB, d snes.DeviceMemory, address uint32, size int) []byte {
+	tb.Helper()
+	data, err := ReadPak(context.Background(), d, address, size)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return data
+}
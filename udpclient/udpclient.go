@@ -17,6 +17,9 @@ type UDPClient struct {
 
 	muteLog bool
 
+	// mu guards c, isConnected and isClosed since a reading goroutine may close the client while it is used or
+	// closed elsewhere:
+	mu          sync.Mutex
 	isConnected bool
 	isClosed    bool
 
@@ -34,7 +37,11 @@ func MakeUDPClient(name string, c *UDPClient) *UDPClient {
 	return c
 }
 
-func (c *UDPClient) IsClosed() bool { return c.isClosed }
+func (c *UDPClient) IsClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.isClosed
+}
 
 func (c *UDPClient) MuteLog(muted bool) {
 	c.muteLog = muted
@@ -44,17 +51,28 @@ func (c *UDPClient) Address() *net.UDPAddr { return c.addr }
 
 var ErrTimeout = fmt.Errorf("timeout")
 
+// conn returns the connection to use for a single read or write; closing it unblocks any read or write in progress.
+func (c *UDPClient) conn() (*net.UDPConn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.isClosed || c.c == nil {
+		return nil, net.ErrClosed
+	}
+	return c.c, nil
+}
+
 func (c *UDPClient) WriteWithDeadline(m []byte, deadline time.Time) (err error) {
-	if c.isClosed {
-		return net.ErrClosed
+	var conn *net.UDPConn
+	if conn, err = c.conn(); err != nil {
+		return
 	}
 
-	err = c.c.SetWriteDeadline(deadline)
+	err = conn.SetWriteDeadline(deadline)
 	if err != nil {
 		return
 	}
 
-	_, err = c.c.Write(m)
+	_, err = conn.Write(m)
 	if err != nil {
 		if isTimeoutError(err) {
 			_ = c.Close()
@@ -68,19 +86,20 @@ func (c *UDPClient) WriteWithDeadline(m []byte, deadline time.Time) (err error)
 }
 
 func (c *UDPClient) ReadWithDeadline(deadline time.Time) (b []byte, err error) {
-	if c.isClosed {
-		return nil, net.ErrClosed
+	var conn *net.UDPConn
+	if conn, err = c.conn(); err != nil {
+		return
 	}
 
 	// wait for a packet from UDP socket:
-	err = c.c.SetReadDeadline(deadline)
+	err = conn.SetReadDeadline(deadline)
 	if err != nil {
 		return
 	}
 
 	var n int
 	b = make([]byte, 65536)
-	n, _, err = c.c.ReadFromUDP(b)
+	n, _, err = conn.ReadFromUDP(b)
 	if err != nil {
 		b = nil
 		if isTimeoutError(err) {
@@ -97,7 +116,7 @@ func (c *UDPClient) ReadWithDeadline(deadline time.Time) (b []byte, err error) {
 }
 
 func (c *UDPClient) WriteThenRead(m []byte, deadline time.Time) (rsp []byte, err error) {
-	if c.isClosed {
+	if c.IsClosed() {
 		return nil, net.ErrClosed
 	}
 
@@ -125,10 +144,27 @@ func (c *UDPClient) Unlock() {
 	c.seqLock.Unlock()
 }
 
-func (c *UDPClient) SetReadDeadline(t time.Time) error  { return c.c.SetReadDeadline(t) }
-func (c *UDPClient) SetWriteDeadline(t time.Time) error { return c.c.SetWriteDeadline(t) }
+func (c *UDPClient) SetReadDeadline(t time.Time) error {
+	conn, err := c.conn()
+	if err != nil {
+		return err
+	}
+	return conn.SetReadDeadline(t)
+}
 
-func (c *UDPClient) IsConnected() bool { return c.isConnected }
+func (c *UDPClient) SetWriteDeadline(t time.Time) error {
+	conn, err := c.conn()
+	if err != nil {
+		return err
+	}
+	return conn.SetWriteDeadline(t)
+}
+
+func (c *UDPClient) IsConnected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.isConnected
+}
 
 func (c *UDPClient) log(fmt string, args ...interface{}) {
 	if c.muteLog {
@@ -140,6 +176,9 @@ func (c *UDPClient) log(fmt string, args ...interface{}) {
 func (c *UDPClient) Connect(addr *net.UDPAddr) (err error) {
 	c.log("%s: connect to server '%s'\n", c.name, addr)
 
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if c.isConnected {
 		return fmt.Errorf("%s: already connected", c.name)
 	}
@@ -160,7 +199,7 @@ func (c *UDPClient) Connect(addr *net.UDPAddr) (err error) {
 func (c *UDPClient) Disconnect() {
 	c.log("%s: disconnect from server '%s'\n", c.name, c.addr)
 
-	if !c.isConnected {
+	if !c.IsConnected() {
 		return
 	}
 
@@ -174,6 +213,9 @@ func (c *UDPClient) Disconnect() {
 }
 
 func (c *UDPClient) Close() (err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if !c.isConnected {
 		return
 	}
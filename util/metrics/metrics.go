@@ -0,0 +1,281 @@
+// Package metrics implements counters, gauges and histograms exposed in the Prometheus text exposition format.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultLatencyBuckets are histogram bucket upper bounds suitable for request latencies in seconds
+var DefaultLatencyBuckets = []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+type metric interface {
+	name() string
+	write(w io.Writer)
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = make(map[string]metric)
+)
+
+func register(m metric) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, dup := registry[m.name()]; dup {
+		panic("metrics: duplicate metric " + m.name())
+	}
+	registry[m.name()] = m
+}
+
+// WriteAll writes all registered metrics sorted by name.
+func WriteAll(w io.Writer) {
+	registryMu.Lock()
+	metrics := make([]metric, 0, len(registry))
+	for _, m := range registry {
+		metrics = append(metrics, m)
+	}
+	registryMu.Unlock()
+
+	sort.Slice(metrics, func(i, j int) bool { return metrics[i].name() < metrics[j].name() })
+	for _, m := range metrics {
+		m.write(w)
+	}
+}
+
+// Handler serves all registered metrics.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		WriteAll(w)
+	})
+}
+
+// vec holds the per label values state shared by all metric types
+type vec struct {
+	metricName string
+	help       string
+	typ        string
+	labels     []string
+
+	mu     sync.Mutex
+	values map[string]interface{}
+	keys   map[string][]string
+}
+
+func (v *vec) init(name, help, typ string, labels []string) {
+	v.metricName = name
+	v.help = help
+	v.typ = typ
+	v.labels = labels
+	v.values = make(map[string]interface{})
+	v.keys = make(map[string][]string)
+}
+
+func (v *vec) name() string { return v.metricName }
+
+// get returns the value for the label values creating it with create if needed; v.mu must be held.
+func (v *vec) get(labelValues []string, create func() interface{}) interface{} {
+	if len(labelValues) != len(v.labels) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values; got %d", v.metricName, len(v.labels), len(labelValues)))
+	}
+	key := strings.Join(labelValues, "\xff")
+	value, ok := v.values[key]
+	if !ok {
+		value = create()
+		v.values[key] = value
+		v.keys[key] = append([]string(nil), labelValues...)
+	}
+	return value
+}
+
+// sortedKeys returns the keys of all values in a stable order; v.mu must be held.
+func (v *vec) sortedKeys() []string {
+	keys := make([]string, 0, len(v.values))
+	for key := range v.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (v *vec) writeHeader(w io.Writer) {
+	_, _ = fmt.Fprintf(w, "# HELP %s %s\n", v.metricName, v.help)
+	_, _ = fmt.Fprintf(w, "# TYPE %s %s\n", v.metricName, v.typ)
+}
+
+// labelString formats the labels for the key with any extra label appended.
+func (v *vec) labelString(key string, extraName, extraValue string) string {
+	values := v.keys[key]
+	if len(values) == 0 && extraName == "" {
+		return ""
+	}
+
+	sb := strings.Builder{}
+	sb.WriteByte('{')
+	for i, name := range v.labels {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(name)
+		sb.WriteString("=")
+		sb.WriteString(quoteLabelValue(values[i]))
+	}
+	if extraName != "" {
+		if len(values) > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(extraName)
+		sb.WriteString("=")
+		sb.WriteString(quoteLabelValue(extraValue))
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func quoteLabelValue(value string) string {
+	return `"` + labelValueEscaper.Replace(value) + `"`
+}
+
+func formatFloat(f float64) string {
+	if math.IsInf(f, +1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// CounterVec is a set of monotonically increasing counters partitioned by label values.
+type CounterVec struct {
+	vec
+}
+
+// NewCounterVec creates and registers a counter.
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	c := &CounterVec{}
+	c.init(name, help, "counter", labels)
+	register(c)
+	return c
+}
+
+// Add adds delta, which must not be negative, to the counter for the label values.
+func (c *CounterVec) Add(delta float64, labelValues ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	*c.get(labelValues, func() interface{} { return new(float64) }).(*float64) += delta
+}
+
+// Inc increments the counter for the label values.
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+func (c *CounterVec) write(w io.Writer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.writeHeader(w)
+	for _, key := range c.sortedKeys() {
+		_, _ = fmt.Fprintf(w, "%s%s %s\n", c.metricName, c.labelString(key, "", ""), formatFloat(*c.values[key].(*float64)))
+	}
+}
+
+// GaugeVec is a set of values that may go up and down partitioned by label values.
+type GaugeVec struct {
+	vec
+}
+
+// NewGaugeVec creates and registers a gauge.
+func NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	g := &GaugeVec{}
+	g.init(name, help, "gauge", labels)
+	register(g)
+	return g
+}
+
+// Add adds delta to the gauge for the label values.
+func (g *GaugeVec) Add(delta float64, labelValues ...string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	*g.get(labelValues, func() interface{} { return new(float64) }).(*float64) += delta
+}
+
+// Set sets the gauge for the label values.
+func (g *GaugeVec) Set(value float64, labelValues ...string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	*g.get(labelValues, func() interface{} { return new(float64) }).(*float64) = value
+}
+
+// Inc increments the gauge for the label values.
+func (g *GaugeVec) Inc(labelValues ...string) { g.Add(1, labelValues...) }
+
+// Dec decrements the gauge for the label values.
+func (g *GaugeVec) Dec(labelValues ...string) { g.Add(-1, labelValues...) }
+
+func (g *GaugeVec) write(w io.Writer) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.writeHeader(w)
+	for _, key := range g.sortedKeys() {
+		_, _ = fmt.Fprintf(w, "%s%s %s\n", g.metricName, g.labelString(key, "", ""), formatFloat(*g.values[key].(*float64)))
+	}
+}
+
+// HistogramVec is a set of histograms partitioned by label values.
+type HistogramVec struct {
+	vec
+	buckets []float64
+}
+
+type histogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// NewHistogramVec creates and registers a histogram with the given bucket upper bounds in increasing order.
+func NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	h := &HistogramVec{buckets: buckets}
+	h.init(name, help, "histogram", labels)
+	register(h)
+	return h
+}
+
+// Observe records a value in the histogram for the label values.
+func (h *HistogramVec) Observe(value float64, labelValues ...string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	v := h.get(labelValues, func() interface{} {
+		return &histogram{counts: make([]uint64, len(h.buckets))}
+	}).(*histogram)
+
+	for i, upper := range h.buckets {
+		if value <= upper {
+			v.counts[i]++
+		}
+	}
+	v.count++
+	v.sum += value
+}
+
+func (h *HistogramVec) write(w io.Writer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.writeHeader(w)
+	for _, key := range h.sortedKeys() {
+		v := h.values[key].(*histogram)
+		for i, upper := range h.buckets {
+			_, _ = fmt.Fprintf(w, "%s_bucket%s %d\n", h.metricName, h.labelString(key, "le", formatFloat(upper)), v.counts[i])
+		}
+		_, _ = fmt.Fprintf(w, "%s_bucket%s %d\n", h.metricName, h.labelString(key, "le", "+Inf"), v.count)
+		_, _ = fmt.Fprintf(w, "%s_sum%s %s\n", h.metricName, h.labelString(key, "", ""), formatFloat(v.sum))
+		_, _ = fmt.Fprintf(w, "%s_count%s %d\n", h.metricName, h.labelString(key, "", ""), v.count)
+	}
+}
@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	c := &CounterVec{}
+	c.init("test_total", "A counter.", "counter", []string{"kind"})
+	c.Inc("a\"b")
+	c.Add(2, "c")
+
+	g := &GaugeVec{}
+	g.init("test_gauge", "A gauge.", "gauge", nil)
+	g.Inc()
+	g.Inc()
+	g.Dec()
+
+	h := &HistogramVec{buckets: []float64{0.1, 1}}
+	h.init("test_seconds", "A histogram.", "histogram", []string{"method"})
+	h.Observe(0.05, "m")
+	h.Observe(0.5, "m")
+	h.Observe(5, "m")
+
+	tests := []struct {
+		m    metric
+		want string
+	}{
+		{c, "# HELP test_total A counter.\n# TYPE test_total counter\ntest_total{kind=\"a\\\"b\"} 1\ntest_total{kind=\"c\"} 2\n"},
+		{g, "# HELP test_gauge A gauge.\n# TYPE test_gauge gauge\ntest_gauge 1\n"},
+		{h, "# HELP test_seconds A histogram.\n# TYPE test_seconds histogram\n" +
+			"test_seconds_bucket{method=\"m\",le=\"0.1\"} 1\n" +
+			"test_seconds_bucket{method=\"m\",le=\"1\"} 2\n" +
+			"test_seconds_bucket{method=\"m\",le=\"+Inf\"} 3\n" +
+			"test_seconds_sum{method=\"m\"} 5.55\n" +
+			"test_seconds_count{method=\"m\"} 3\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.m.name(), func(t *testing.T) {
+			b := &bytes.Buffer{}
+			tt.m.write(b)
+			if got := b.String(); got != tt.want {
+				t.Errorf("write() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}